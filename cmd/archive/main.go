@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/config"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+)
+
+// archive exports a season, or the whole database, as a zip of JSON documents
+// and uploaded logos, and restores such a zip into an empty database:
+//
+//	archive export [-season id] [-o file]
+//	archive restore file
+//
+// Run it from the directory holding uploads. Restored rows get new IDs; run
+// the ratings command afterwards to rebuild team ratings.
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: archive export [-season id] [-o file] | archive restore file")
+		os.Exit(2)
+	}
+
+	exportFlags := flag.NewFlagSet("export", flag.ExitOnError)
+	seasonID := exportFlags.String("season", "", "season to export; the whole database when empty")
+	output := exportFlags.String("o", "", "archive file to write; named after the date when empty")
+	restoreFlags := flag.NewFlagSet("restore", flag.ExitOnError)
+
+	command := os.Args[1]
+	switch command {
+	case "export":
+		exportFlags.Parse(os.Args[2:])
+	case "restore":
+		restoreFlags.Parse(os.Args[2:])
+		if restoreFlags.NArg() != 1 {
+			fmt.Fprintln(os.Stderr, "usage: archive restore file")
+			os.Exit(2)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", command)
+		os.Exit(2)
+	}
+
+	viperConfig := config.NewViper()
+	log := config.NewLogger(viperConfig)
+	db := config.NewDatabase(viperConfig, log)
+	producer := config.NewKafkaProducer(viperConfig, log)
+	defer producer.Close()
+
+	archiveUseCase := usecase.NewArchiveUseCase(
+		repository.NewArchiveRepo(log),
+		repository.NewSeasonsRepo(db, log),
+		repository.NewCompetitionsRepo(db, log),
+		repository.NewVenuesRepo(db, log),
+		repository.NewTeamsRepo(db, log),
+		repository.NewPlayersRepo(db, log),
+		repository.NewMatchesRepo(db, log),
+		repository.NewGoalsRepo(db, log),
+		messaging.NewLogProducer(producer, log),
+		db,
+		log,
+	)
+
+	if command == "export" {
+		path := *output
+		if path == "" {
+			path = fmt.Sprintf("archive-%s.zip", time.Now().Format("20060102"))
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", path, err)
+		}
+		err = archiveUseCase.Export(context.Background(), &model.ArchiveExportRequest{SeasonID: *seasonID}, file)
+		if err == nil {
+			err = file.Close()
+		}
+		if err != nil {
+			file.Close()
+			os.Remove(path)
+			log.Fatalf("Failed to export archive: %v", err)
+		}
+		log.Infof("Archive written to %s", path)
+		return
+	}
+
+	path := restoreFlags.Arg(0)
+	file, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", path, err)
+	}
+	res, err := archiveUseCase.Restore(context.Background(), &model.ArchiveRestoreRequest{File: file})
+	if err != nil {
+		log.Errorf("Failed to restore %s: %v", path, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			for _, detail := range appErr.Details {
+				log.Errorf("  %s: %s", detail.Field, detail.Message)
+			}
+		}
+		os.Exit(1)
+	}
+
+	for _, document := range []string{"seasons", "competitions", "competition_teams", "venues", "teams", "players", "matches", "goals"} {
+		log.Infof("%s: %d restored, %d skipped", document, res.Restored[document], res.Skipped[document])
+	}
+	log.Infof("%s restored with %d logos", path, res.Logos)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/config"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+)
+
+// importer loads past results from football-data.co.uk CSV or openfootball
+// JSON files:
+//
+//	importer [-commit] [-create-teams] [-competition id] [-timezone tz] [-format f] file...
+//
+// Without -commit it only reports how each file would be imported. Run the
+// ratings command afterwards to include the imported matches in team ratings.
+func main() {
+	commit := flag.Bool("commit", false, "import the files instead of only checking them")
+	createTeams := flag.Bool("create-teams", false, "create placeholder teams for names that match no existing team")
+	competitionID := flag.String("competition", "", "competition the matches belong to")
+	timezone := flag.String("timezone", "UTC", "timezone of the kickoff times in the files")
+	format := flag.String("format", "", "football-data or openfootball; detected from each file when empty")
+	flag.Parse()
+
+	viperConfig := config.NewViper()
+	log := config.NewLogger(viperConfig)
+	db := config.NewDatabase(viperConfig, log)
+	redisClient := config.NewRedisClient(viperConfig, log)
+	producer := config.NewKafkaProducer(viperConfig, log)
+	defer producer.Close()
+
+	importUseCase := usecase.NewImportUseCase(
+		repository.NewTeamsRepo(db, log),
+		repository.NewPlayersRepo(db, log),
+		repository.NewVenuesRepo(db, log),
+		repository.NewMatchesRepo(db, log),
+		repository.NewGoalsRepo(db, log),
+		repository.NewCompetitionsRepo(db, log),
+		redisClient,
+		messaging.NewLogProducer(producer, log),
+		db,
+		log,
+	)
+
+	mode := "dry_run"
+	if *commit {
+		mode = "commit"
+	}
+
+	failed := false
+	for _, path := range flag.Args() {
+		file, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", path, err)
+		}
+
+		res, err := importUseCase.Results(context.Background(), &model.ResultsImportRequest{
+			Mode:          mode,
+			File:          file,
+			Format:        *format,
+			CompetitionID: *competitionID,
+			Timezone:      *timezone,
+			CreateTeams:   *createTeams,
+		})
+		if err != nil {
+			failed = true
+			log.Errorf("Failed to import %s: %v", path, err)
+			if appErr, ok := common.IsAppError(err); ok {
+				for _, detail := range appErr.Details {
+					log.Errorf("  %s: %s", detail.Field, detail.Message)
+				}
+			}
+			continue
+		}
+
+		for _, team := range res.Teams {
+			if team.Match != "exact" {
+				log.Infof("%s: team %q is %s", path, team.Name, team.Match)
+			}
+			if team.Registered {
+				log.Infof("%s: team %q is registered in the competition", path, team.Name)
+			}
+		}
+		for _, row := range res.Rows {
+			for _, rowErr := range row.Errors {
+				log.Warnf("%s: row %d: %s: %s", path, row.Row, rowErr.Field, rowErr.Message)
+			}
+		}
+		log.Infof("%s (%s, %s): %d matches, %d valid, %d invalid, %d already stored, %d imported with %d goals",
+			path, res.Format, res.Mode, res.Total, res.Valid, res.Invalid, res.Existing, res.Imported, res.Goals)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
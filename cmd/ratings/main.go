@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Fadlihardiyanto/football-api/internal/config"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+)
+
+// ratings recomputes every team rating from the completed matches. Run it
+// after changing the RATING_* settings.
+func main() {
+	viperConfig := config.NewViper()
+	log := config.NewLogger(viperConfig)
+	db := config.NewDatabase(viperConfig, log)
+	ratingConfig := config.NewRatingConfig(viperConfig, log)
+
+	ratingsUseCase := usecase.NewRatingsUseCase(
+		repository.NewRatingsRepo(db, log),
+		repository.NewTeamsRepo(db, log),
+		repository.NewSeasonsRepo(db, log),
+		ratingConfig,
+		db,
+		log,
+	)
+
+	matches, err := ratingsUseCase.Recompute(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to recompute team ratings: %v", err)
+	}
+	log.Infof("Team ratings recomputed from %d matches", matches)
+}
@@ -33,6 +33,12 @@ func main() {
 	redisClient := config.NewRedisClient(viperConfig, log)
 	fmt.Println("Redis client created")
 
+	schedulingConfig := config.NewSchedulingConfig(viperConfig)
+
+	ratingConfig := config.NewRatingConfig(viperConfig, log)
+
+	predictionConfig := config.NewPredictionConfig(viperConfig)
+
 	config.Bootstrap(&config.BootstrapConfig{
 		DB:          db,
 		App:         app,
@@ -42,6 +48,9 @@ func main() {
 		Producer:    producer,
 		JWTConfig:   jwtConfig,
 		RedisClient: redisClient,
+		Scheduling:  schedulingConfig,
+		Rating:      ratingConfig,
+		Prediction:  predictionConfig,
 	})
 
 	appPort := viperConfig.GetInt("APP_PORT")
@@ -9,6 +9,8 @@ import (
 
 	"github.com/Fadlihardiyanto/football-api/internal/config"
 	"github.com/Fadlihardiyanto/football-api/internal/delivery/messaging"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -22,6 +24,7 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	go RunLogFileConsumer(logger, viperConfig, ctx)
+	go RunPredictionScheduler(logger, viperConfig, ctx)
 
 	terminateSignals := make(chan os.Signal, 1)
 	signal.Notify(terminateSignals, syscall.SIGINT, syscall.SIGTERM)
@@ -49,3 +52,64 @@ func RunLogFileConsumer(logger *logrus.Logger, viperConfig *viper.Viper, ctx con
 	handler := messaging.NewLogFileConsumer(logDir, logger)
 	messaging.ConsumeTopic(ctx, logConsumer, "log-event", logger, handler.Consume)
 }
+
+// RunPredictionScheduler recomputes the match predictions every night at
+// PREDICTION_RUN_HOUR, and every PREDICTION_KICKOFF_CHECK_MINUTES predicts the
+// scheduled matches created since, so each match has a prediction before it
+// kicks off.
+func RunPredictionScheduler(logger *logrus.Logger, viperConfig *viper.Viper, ctx context.Context) {
+	db := config.NewDatabase(viperConfig, logger)
+	predictionConfig := config.NewPredictionConfig(viperConfig)
+	predictionsUseCase := usecase.NewPredictionsUseCase(
+		repository.NewPredictionsRepo(db, logger),
+		repository.NewMatchesRepo(db, logger),
+		repository.NewSeasonsRepo(db, logger),
+		predictionConfig,
+		db,
+		logger,
+	)
+
+	loc, err := time.LoadLocation(predictionConfig.Timezone)
+	if err != nil {
+		logger.Warnf("Invalid timezone %s, scheduling predictions in UTC: %v", predictionConfig.Timezone, err)
+		loc = time.UTC
+	}
+
+	interval := predictionConfig.KickoffCheck
+	if interval <= 0 {
+		logger.Warnf("Invalid kickoff check interval %s, checking every 15 minutes", interval)
+		interval = 15 * time.Minute
+	}
+	kickoffCheck := time.NewTicker(interval)
+	defer kickoffCheck.Stop()
+
+	for {
+		now := time.Now().In(loc)
+		next := time.Date(now.Year(), now.Month(), now.Day(), predictionConfig.RunHour, 0, 0, 0, loc)
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+		logger.Infof("Next prediction run at %s", next.Format(time.RFC3339))
+
+		timer := time.NewTimer(time.Until(next))
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				logger.Info("Stopping prediction scheduler")
+				return
+			case <-kickoffCheck.C:
+				if _, err := predictionsUseCase.PredictUnpredicted(ctx); err != nil {
+					logger.Errorf("Failed to predict new matches: %v", err)
+				}
+			case <-timer.C:
+				break wait
+			}
+		}
+
+		if _, err := predictionsUseCase.RecomputeUpcoming(ctx); err != nil {
+			logger.Errorf("Failed to recompute predictions: %v", err)
+		}
+	}
+}
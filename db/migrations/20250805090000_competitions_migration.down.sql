@@ -0,0 +1,3 @@
+ALTER TABLE matches DROP COLUMN IF EXISTS competition_id;
+DROP TABLE IF EXISTS competition_teams;
+DROP TABLE IF EXISTS competitions;
@@ -0,0 +1,22 @@
+CREATE TABLE competitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    age_category VARCHAR(20),
+    eligible_born_on_or_after DATE NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE TABLE competition_teams (
+    competition_id UUID NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (competition_id, team_id)
+);
+
+ALTER TABLE matches ADD COLUMN competition_id UUID NULL REFERENCES competitions(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_competitions_deleted_at ON competitions(deleted_at);
+CREATE INDEX idx_competition_teams_team_id ON competition_teams(team_id);
+CREATE INDEX idx_matches_competition_id ON matches(competition_id);
@@ -0,0 +1 @@
+ALTER TABLE players DROP COLUMN IF EXISTS date_of_birth;
@@ -0,0 +1 @@
+ALTER TABLE players ADD COLUMN date_of_birth DATE NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE match_lineups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    is_starter BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX unique_match_lineup_player_not_deleted
+ON match_lineups(match_id, player_id)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_match_lineups_match_id ON match_lineups(match_id);
+CREATE INDEX idx_match_lineups_player_id ON match_lineups(player_id);
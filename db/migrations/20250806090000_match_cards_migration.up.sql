@@ -0,0 +1,14 @@
+CREATE TABLE match_cards (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    card_type VARCHAR(10) NOT NULL CHECK (card_type IN ('yellow', 'red')),
+    minute SMALLINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_match_cards_match_id ON match_cards(match_id);
+CREATE INDEX idx_match_cards_player_id ON match_cards(player_id);
+CREATE INDEX idx_match_cards_deleted_at ON match_cards(deleted_at);
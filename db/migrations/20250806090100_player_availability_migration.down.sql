@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS player_suspensions;
+DROP TABLE IF EXISTS player_injuries;
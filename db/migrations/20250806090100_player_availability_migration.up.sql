@@ -0,0 +1,29 @@
+CREATE TABLE player_injuries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    injury_type VARCHAR(100) NOT NULL,
+    start_date DATE NOT NULL,
+    expected_return DATE NULL,
+    notes TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CHECK (expected_return IS NULL OR expected_return >= start_date)
+);
+
+CREATE TABLE player_suspensions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    competition_id UUID NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    match_id UUID NULL REFERENCES matches(id) ON DELETE SET NULL,
+    reason VARCHAR(255) NOT NULL,
+    matches_remaining INTEGER NOT NULL CHECK (matches_remaining >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_player_injuries_player_id ON player_injuries(player_id);
+CREATE INDEX idx_player_injuries_deleted_at ON player_injuries(deleted_at);
+CREATE INDEX idx_player_suspensions_player_id ON player_suspensions(player_id);
+CREATE INDEX idx_player_suspensions_deleted_at ON player_suspensions(deleted_at);
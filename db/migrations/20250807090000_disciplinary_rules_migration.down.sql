@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_matches_competition_id_matchday;
+ALTER TABLE matches DROP COLUMN IF EXISTS matchday;
+DROP TABLE IF EXISTS competition_disciplinary_rules;
@@ -0,0 +1,15 @@
+CREATE TABLE competition_disciplinary_rules (
+    competition_id UUID PRIMARY KEY REFERENCES competitions(id) ON DELETE CASCADE,
+    yellow_cards_per_ban SMALLINT NOT NULL DEFAULT 5 CHECK (yellow_cards_per_ban > 0),
+    yellow_accumulation_ban SMALLINT NOT NULL DEFAULT 1 CHECK (yellow_accumulation_ban >= 0),
+    straight_red_ban SMALLINT NOT NULL DEFAULT 1 CHECK (straight_red_ban >= 0),
+    yellow_reset_after_matchday SMALLINT NULL CHECK (yellow_reset_after_matchday > 0),
+    yellow_card_points SMALLINT NOT NULL DEFAULT 1 CHECK (yellow_card_points >= 0),
+    red_card_points SMALLINT NOT NULL DEFAULT 3 CHECK (red_card_points >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE matches ADD COLUMN matchday SMALLINT NULL CHECK (matchday > 0);
+
+CREATE INDEX idx_matches_competition_id_matchday ON matches(competition_id, matchday);
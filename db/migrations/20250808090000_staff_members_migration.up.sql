@@ -0,0 +1,17 @@
+CREATE TABLE staff_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    role VARCHAR(30) NOT NULL CHECK (role IN ('head_coach', 'assistant_coach', 'goalkeeper_coach', 'physio')),
+    nationality VARCHAR(100),
+    start_date DATE NOT NULL,
+    end_date DATE NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX idx_staff_members_team_id ON staff_members(team_id);
+CREATE INDEX idx_staff_members_role ON staff_members(role);
+CREATE INDEX idx_staff_members_deleted_at ON staff_members(deleted_at);
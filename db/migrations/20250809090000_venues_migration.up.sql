@@ -0,0 +1,19 @@
+CREATE TABLE venues (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity >= 0),
+    surface_type VARCHAR(20) NOT NULL CHECK (surface_type IN ('natural_grass', 'artificial_turf', 'hybrid')),
+    latitude NUMERIC(9, 6) NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude NUMERIC(9, 6) NULL CHECK (longitude BETWEEN -180 AND 180),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+ALTER TABLE teams ADD COLUMN home_venue_id UUID NULL REFERENCES venues(id) ON DELETE SET NULL;
+ALTER TABLE matches ADD COLUMN venue_id UUID NULL REFERENCES venues(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_venues_deleted_at ON venues(deleted_at);
+CREATE INDEX idx_teams_home_venue_id ON teams(home_venue_id);
+CREATE INDEX idx_matches_venue_id ON matches(venue_id);
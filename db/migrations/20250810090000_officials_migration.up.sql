@@ -0,0 +1,37 @@
+CREATE TABLE officials (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    nationality VARCHAR(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE TABLE official_affiliations (
+    official_id UUID NOT NULL REFERENCES officials(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (official_id, team_id)
+);
+
+CREATE TABLE match_officials (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    official_id UUID NOT NULL REFERENCES officials(id) ON DELETE CASCADE,
+    role VARCHAR(30) NOT NULL CHECK (role IN ('referee', 'assistant_referee_1', 'assistant_referee_2', 'fourth_official')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX unique_match_official_role_not_deleted
+ON match_officials(match_id, role)
+WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX unique_match_official_not_deleted
+ON match_officials(match_id, official_id)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_officials_deleted_at ON officials(deleted_at);
+CREATE INDEX idx_official_affiliations_team_id ON official_affiliations(team_id);
+CREATE INDEX idx_match_officials_official_id ON match_officials(official_id);
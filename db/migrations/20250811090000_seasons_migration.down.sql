@@ -0,0 +1,2 @@
+ALTER TABLE competitions DROP COLUMN IF EXISTS season_id;
+DROP TABLE IF EXISTS seasons;
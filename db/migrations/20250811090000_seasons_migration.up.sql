@@ -0,0 +1,15 @@
+CREATE TABLE seasons (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CHECK (end_date >= start_date)
+);
+
+ALTER TABLE competitions ADD COLUMN season_id UUID NULL REFERENCES seasons(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_seasons_deleted_at ON seasons(deleted_at);
+CREATE INDEX idx_competitions_season_id ON competitions(season_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_matches_away_team_id_match_date;
+DROP INDEX IF EXISTS idx_matches_home_team_id_match_date;
+DROP TABLE IF EXISTS venue_unavailabilities;
+DROP TABLE IF EXISTS competition_blackout_dates;
+DROP TABLE IF EXISTS competition_scheduling_rules;
@@ -0,0 +1,31 @@
+CREATE TABLE competition_scheduling_rules (
+    competition_id UUID PRIMARY KEY REFERENCES competitions(id) ON DELETE CASCADE,
+    min_rest_hours SMALLINT NOT NULL DEFAULT 48 CHECK (min_rest_hours >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE competition_blackout_dates (
+    competition_id UUID NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    blackout_date DATE NOT NULL,
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (competition_id, blackout_date)
+);
+
+CREATE TABLE venue_unavailabilities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    venue_id UUID NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    competition_id UUID NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_venue_unavailabilities_venue_id_dates ON venue_unavailabilities(venue_id, start_date, end_date) WHERE deleted_at IS NULL;
+CREATE INDEX idx_matches_home_team_id_match_date ON matches(home_team_id, match_date);
+CREATE INDEX idx_matches_away_team_id_match_date ON matches(away_team_id, match_date);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS match_reschedules;
+
+UPDATE matches SET status = 'scheduled' WHERE status = 'postponed';
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_status_check;
+ALTER TABLE matches ADD CONSTRAINT matches_status_check CHECK (status IN ('scheduled', 'completed', 'cancelled'));
@@ -0,0 +1,18 @@
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_status_check;
+ALTER TABLE matches ADD CONSTRAINT matches_status_check CHECK (status IN ('scheduled', 'completed', 'cancelled', 'postponed'));
+
+CREATE TABLE match_reschedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    reason VARCHAR(255) NOT NULL,
+    original_date DATE NOT NULL,
+    original_time TIME NOT NULL,
+    new_date DATE NULL,
+    new_time TIME NULL,
+    postponed_at TIMESTAMP NULL,
+    rescheduled_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_match_reschedules_match_id ON match_reschedules(match_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_matches_kickoff_at;
+
+ALTER TABLE matches DROP COLUMN IF EXISTS timezone;
+ALTER TABLE matches DROP COLUMN IF EXISTS kickoff_at;
+
+ALTER TABLE venues DROP COLUMN IF EXISTS timezone;
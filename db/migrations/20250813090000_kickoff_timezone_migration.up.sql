@@ -0,0 +1,21 @@
+ALTER TABLE venues ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- existing venues start in Asia/Jakarta, the default DEFAULT_TIMEZONE; venues
+-- in other zones are corrected through PUT /venues/:id, which also moves the
+-- kickoffs of their matches
+
+ALTER TABLE matches ADD COLUMN kickoff_at TIMESTAMPTZ;
+ALTER TABLE matches ADD COLUMN timezone VARCHAR(64);
+
+-- existing match dates and times are local to the venue the match is played at
+UPDATE matches m SET timezone = COALESCE(
+    (SELECT v.timezone FROM venues v WHERE v.id = COALESCE(m.venue_id, (SELECT t.home_venue_id FROM teams t WHERE t.id = m.home_team_id))),
+    'Asia/Jakarta'
+);
+UPDATE matches SET kickoff_at = (match_date + match_time) AT TIME ZONE timezone;
+
+ALTER TABLE matches ALTER COLUMN kickoff_at SET NOT NULL;
+ALTER TABLE matches ALTER COLUMN timezone SET NOT NULL;
+ALTER TABLE matches ALTER COLUMN timezone SET DEFAULT 'Asia/Jakarta';
+
+CREATE INDEX idx_matches_kickoff_at ON matches(kickoff_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_goals_assist_player_id;
+
+ALTER TABLE goals DROP COLUMN IF EXISTS assist_player_id;
@@ -0,0 +1,3 @@
+ALTER TABLE goals ADD COLUMN assist_player_id UUID NULL REFERENCES players(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_goals_assist_player_id ON goals(assist_player_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS team_ratings;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS rating;
@@ -0,0 +1,17 @@
+ALTER TABLE teams ADD COLUMN rating DOUBLE PRECISION NULL;
+
+CREATE TABLE team_ratings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    opponent_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    rating_before DOUBLE PRECISION NOT NULL,
+    rating_after DOUBLE PRECISION NOT NULL,
+    expected_score DOUBLE PRECISION NOT NULL,
+    played_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (team_id, match_id)
+);
+
+CREATE INDEX idx_team_ratings_team_id_played_at ON team_ratings(team_id, played_at);
+CREATE INDEX idx_team_ratings_match_id ON team_ratings(match_id);
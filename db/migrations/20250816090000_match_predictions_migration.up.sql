@@ -0,0 +1,13 @@
+CREATE TABLE match_predictions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL UNIQUE REFERENCES matches(id) ON DELETE CASCADE,
+    home_expected_goals DOUBLE PRECISION NOT NULL,
+    away_expected_goals DOUBLE PRECISION NOT NULL,
+    home_win DOUBLE PRECISION NOT NULL,
+    draw DOUBLE PRECISION NOT NULL,
+    away_win DOUBLE PRECISION NOT NULL,
+    scorelines JSONB NOT NULL DEFAULT '[]',
+    training_matches INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
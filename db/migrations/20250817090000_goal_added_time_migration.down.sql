@@ -0,0 +1 @@
+ALTER TABLE goals DROP COLUMN IF EXISTS added_time;
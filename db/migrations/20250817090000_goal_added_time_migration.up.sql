@@ -0,0 +1,2 @@
+-- minutes of stoppage time, e.g. a goal at 45+2 has goal_time 45 and added_time 2
+ALTER TABLE goals ADD COLUMN added_time SMALLINT NULL CHECK (added_time >= 0);
@@ -0,0 +1 @@
+ALTER TABLE matches ADD COLUMN attendance INT NULL CHECK (attendance >= 0);
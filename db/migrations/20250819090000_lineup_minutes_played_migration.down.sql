@@ -0,0 +1 @@
+ALTER TABLE match_lineups DROP COLUMN IF EXISTS minutes_played;
@@ -0,0 +1,2 @@
+-- minutes on the pitch; NULL until the lineup is resubmitted after the match
+ALTER TABLE match_lineups ADD COLUMN minutes_played SMALLINT NULL CHECK (minutes_played BETWEEN 0 AND 130);
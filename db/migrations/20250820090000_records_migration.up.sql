@@ -0,0 +1,22 @@
+CREATE TABLE records (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    type VARCHAR(30) NOT NULL CHECK (type IN ('hat_trick', 'goal_milestone', 'biggest_win', 'unbeaten_run', 'fastest_goal')),
+    record_key VARCHAR(255) NOT NULL UNIQUE,
+    competition_id UUID NULL REFERENCES competitions(id) ON DELETE SET NULL,
+    team_id UUID NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID NULL REFERENCES players(id) ON DELETE CASCADE,
+    match_id UUID NULL REFERENCES matches(id) ON DELETE CASCADE,
+    goal_id UUID NULL REFERENCES goals(id) ON DELETE CASCADE,
+    value INT NOT NULL,
+    description TEXT NOT NULL,
+    context JSONB NOT NULL DEFAULT '{}',
+    achieved_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_records_competition_id ON records(competition_id);
+CREATE INDEX idx_records_team_id ON records(team_id);
+CREATE INDEX idx_records_player_id ON records(player_id);
+CREATE INDEX idx_records_achieved_at ON records(achieved_at);
@@ -0,0 +1,2 @@
+COMMENT ON COLUMN matches.match_date IS NULL;
+COMMENT ON COLUMN matches.match_time IS NULL;
@@ -0,0 +1,3 @@
+-- kickoff_at is the stored kickoff; the local date and time are derived from it
+COMMENT ON COLUMN matches.match_date IS 'Deprecated: local date of kickoff_at in timezone';
+COMMENT ON COLUMN matches.match_time IS 'Deprecated: local time of kickoff_at in timezone';
@@ -0,0 +1 @@
+ALTER TABLE competition_disciplinary_rules DROP COLUMN IF EXISTS second_yellow_ban;
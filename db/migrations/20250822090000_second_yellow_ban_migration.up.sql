@@ -0,0 +1,2 @@
+-- matches banned for a dismissal after two yellow cards in the same match
+ALTER TABLE competition_disciplinary_rules ADD COLUMN second_yellow_ban SMALLINT NOT NULL DEFAULT 1 CHECK (second_yellow_ban >= 0);
@@ -0,0 +1,3 @@
+-- teams created by a results import with only a name; cleared once the team's
+-- founding year and headquarters are filled in
+ALTER TABLE teams ADD COLUMN placeholder BOOLEAN NOT NULL DEFAULT FALSE;
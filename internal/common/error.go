@@ -257,6 +257,8 @@ func getValidationMessage(fe validator.FieldError) string {
 		return fmt.Sprintf("%s must be one of [%s]", fe.Field(), fe.Param())
 	case "unique":
 		return fmt.Sprintf("%s must contain unique values", fe.Field())
+	case "datetime":
+		return fmt.Sprintf("%s must match the format %s", fe.Field(), fe.Param())
 	case "dive":
 		return fmt.Sprintf("%s contains invalid nested values", fe.Field())
 	default:
@@ -0,0 +1,185 @@
+package common
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+const (
+	CSVContentType  = "text/csv; charset=utf-8"
+	XLSXContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	ZipContentType  = "application/zip"
+)
+
+// ExportWriter writes a table one row at a time. Cells may be strings,
+// numbers, bools or pointers to them; nil pointers become empty cells.
+type ExportWriter interface {
+	Write(cells ...any) error
+	Close() error
+}
+
+// NewExportWriter returns a writer for the format, which must be
+// ExportFormatCSV or ExportFormatXLSX.
+func NewExportWriter(format string, w io.Writer) ExportWriter {
+	if format == ExportFormatXLSX {
+		return &xlsxWriter{out: w}
+	}
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+// ExportContentType returns the content type of a download by its file
+// extension.
+func ExportContentType(format string) string {
+	switch format {
+	case ExportFormatXLSX:
+		return XLSXContentType
+	case "zip":
+		return ZipContentType
+	}
+	return CSVContentType
+}
+
+// exportCell formats a cell and reports whether it is a number.
+func exportCell(cell any) (string, bool) {
+	v := reflect.ValueOf(cell)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return "", false
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), false
+	case reflect.String:
+		return v.String(), false
+	}
+	return fmt.Sprint(v.Interface()), false
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(cells ...any) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		text, numeric := exportCell(cell)
+		// spreadsheets run text starting with these as a formula
+		if !numeric && text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+			text = "'" + text
+		}
+		record[i] = text
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// xlsxWriter writes a single-sheet workbook. Strings are stored inline rather
+// than in a shared string table so rows can be streamed straight into the
+// sheet without holding the table in memory.
+type xlsxWriter struct {
+	out   io.Writer
+	zip   *zip.Writer
+	sheet io.Writer
+	row   bytes.Buffer
+}
+
+var xlsxParts = []struct{ name, body string }{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Export" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+}
+
+// start writes the fixed parts of the package and opens the sheet.
+func (x *xlsxWriter) start() error {
+	x.zip = zip.NewWriter(x.out)
+	for _, part := range xlsxParts {
+		w, err := x.zip.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, part.body); err != nil {
+			return err
+		}
+	}
+
+	sheet, err := x.zip.CreateHeader(&zip.FileHeader{Name: "xl/worksheets/sheet1.xml", Method: zip.Deflate})
+	if err != nil {
+		return err
+	}
+	x.sheet = sheet
+	_, err = io.WriteString(x.sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return err
+}
+
+func (x *xlsxWriter) Write(cells ...any) error {
+	if x.zip == nil {
+		if err := x.start(); err != nil {
+			return err
+		}
+	}
+
+	x.row.Reset()
+	x.row.WriteString("<row>")
+	for _, cell := range cells {
+		text, numeric := exportCell(cell)
+		switch {
+		case numeric:
+			fmt.Fprintf(&x.row, "<c><v>%s</v></c>", text)
+		case text == "":
+			x.row.WriteString("<c/>")
+		default:
+			x.row.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+			if err := xml.EscapeText(&x.row, []byte(text)); err != nil {
+				return err
+			}
+			x.row.WriteString("</t></is></c>")
+		}
+	}
+	x.row.WriteString("</row>")
+	_, err := x.sheet.Write(x.row.Bytes())
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	if x.zip == nil {
+		if err := x.start(); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(x.sheet, "</sheetData></worksheet>"); err != nil {
+		return err
+	}
+	return x.zip.Close()
+}
@@ -0,0 +1,113 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// A4 page size in PDF points.
+const (
+	PDFPageWidth  = 595.28
+	PDFPageHeight = 841.89
+)
+
+// PDFDocument is a minimal PDF 1.4 writer for text and rules using the
+// standard Helvetica fonts, so nothing has to be embedded. It writes no
+// timestamps or IDs, so the same pages always produce the same bytes.
+type PDFDocument struct {
+	pages []*PDFPage
+}
+
+// PDFPage collects the content stream of one page. Coordinates are in points
+// from the bottom-left corner.
+type PDFPage struct {
+	content bytes.Buffer
+}
+
+func NewPDFDocument() *PDFDocument {
+	return &PDFDocument{}
+}
+
+func (d *PDFDocument) AddPage() *PDFPage {
+	page := &PDFPage{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+func pdfNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// pdfString encodes text as a literal string. Runes outside Latin-1 have no
+// glyph in the standard fonts' encoding and are replaced with '?'.
+func pdfString(text string) string {
+	var b bytes.Buffer
+	b.WriteByte('(')
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r < 0x20 || (r >= 0x7f && r < 0xa0) || r > 0xff:
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// Text writes a line of text with its baseline at y.
+func (p *PDFPage) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td %s Tj ET\n", font, pdfNumber(size), pdfNumber(x), pdfNumber(y), pdfString(text))
+}
+
+// Line draws a straight rule.
+func (p *PDFPage) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", pdfNumber(width), pdfNumber(x1), pdfNumber(y1), pdfNumber(x2), pdfNumber(y2))
+}
+
+// Bytes renders the document.
+func (d *PDFDocument) Bytes() []byte {
+	var b bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, b.Len())
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	b.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// objects 1-4 are the catalog, page tree and fonts, then each page is
+	// followed by its content stream
+	kids := bytes.Buffer{}
+	for i := range d.pages {
+		if i > 0 {
+			kids.WriteByte(' ')
+		}
+		fmt.Fprintf(&kids, "%d 0 R", 5+i*2)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", kids.String(), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfNumber(PDFPageWidth), pdfNumber(PDFPageHeight), 6+i*2))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return b.Bytes()
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	// embed the zone database, the runtime image does not ship tzdata
+	_ "time/tzdata"
+)
+
+// TimezoneKey holds the *time.Location the client asked times to be rendered in.
+const TimezoneKey = "timezone"
+
+// LocationFromContext returns the requested timezone, or nil when the client
+// did not ask for one.
+func LocationFromContext(ctx context.Context) *time.Location {
+	loc, _ := ctx.Value(TimezoneKey).(*time.Location)
+	return loc
+}
+
+// ParseMatchTime parses a kickoff time given with or without seconds.
+func ParseMatchTime(input string) (time.Time, error) {
+	t, err := time.Parse("15:04:05", input)
+	if err != nil {
+		return time.Parse("15:04", input)
+	}
+	return t, nil
+}
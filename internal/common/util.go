@@ -35,13 +35,21 @@ func ConvertStringToDate(input string) time.Time {
 	return t
 }
 
-func ConvertStringToTimeOnly(input string) time.Time {
+func ConvertStringToDatePointer(input string) *time.Time {
 	if input == "" {
-		return time.Time{}
+		return nil
 	}
-	t, err := time.Parse("15:04:05", input)
+	t, err := time.Parse("2006-01-02", input)
 	if err != nil {
-		return time.Time{}
+		return nil
 	}
-	return t
+	return &t
+}
+
+func ToDateStringPointer(t *time.Time) *string {
+	if t != nil {
+		str := t.Format("2006-01-02")
+		return &str
+	}
+	return nil
 }
@@ -29,6 +29,9 @@ type BootstrapConfig struct {
 	RedisClient *redis.Client
 	JWTConfig   *model.JWTConfig
 	RateLimiter gin.HandlerFunc
+	Scheduling  *model.SchedulingConfig
+	Rating      *model.RatingConfig
+	Prediction  *model.PredictionConfig
 }
 
 func Bootstrap(config *BootstrapConfig) {
@@ -38,16 +41,55 @@ func Bootstrap(config *BootstrapConfig) {
 	playersRepo := repository.NewPlayersRepo(config.DB, config.Log)
 	matchesRepo := repository.NewMatchesRepo(config.DB, config.Log)
 	goalsRepo := repository.NewGoalsRepo(config.DB, config.Log)
+	competitionsRepo := repository.NewCompetitionsRepo(config.DB, config.Log)
+	lineupsRepo := repository.NewLineupsRepo(config.DB, config.Log)
+	cardsRepo := repository.NewCardsRepo(config.DB, config.Log)
+	injuriesRepo := repository.NewInjuriesRepo(config.DB, config.Log)
+	suspensionsRepo := repository.NewSuspensionsRepo(config.DB, config.Log)
+	disciplinaryRulesRepo := repository.NewDisciplinaryRulesRepo(config.DB, config.Log)
+	staffRepo := repository.NewStaffRepo(config.DB, config.Log)
+	venuesRepo := repository.NewVenuesRepo(config.DB, config.Log)
+	officialsRepo := repository.NewOfficialsRepo(config.DB, config.Log)
+	seasonsRepo := repository.NewSeasonsRepo(config.DB, config.Log)
+	schedulingRepo := repository.NewSchedulingRepo(config.DB, config.Log)
+	leaderboardsRepo := repository.NewLeaderboardsRepo(config.DB, config.Log)
+	ratingsRepo := repository.NewRatingsRepo(config.DB, config.Log)
+	predictionsRepo := repository.NewPredictionsRepo(config.DB, config.Log)
+	recordsRepo := repository.NewRecordsRepo(config.DB, config.Log)
+	archiveRepo := repository.NewArchiveRepo(config.Log)
 
 	// Initialize producer
 	logProducer := messaging.NewLogProducer(config.Producer, config.Log)
+	matchEventProducer := messaging.NewMatchEventProducer(config.Producer, config.Log)
+	recordEventProducer := messaging.NewRecordEventProducer(config.Producer, config.Log)
 
 	// Initialize use cases
 	authUseCase := usecase.NewAuthUseCase(userRepo, logProducer, config.DB, config.Log, config.JWTConfig, config.RedisClient)
-	teamsUseCase := usecase.NewTeamsUseCase(teamRepo, logProducer, config.DB, config.Log)
-	playersUseCase := usecase.NewPlayersUseCase(playersRepo, teamRepo, logProducer, config.DB, config.Log)
-	matchesUseCase := usecase.NewMatchesUseCase(matchesRepo, logProducer, config.DB, config.Log)
-	goalsUseCase := usecase.NewGoalsUseCase(goalsRepo, matchesRepo, playersRepo, logProducer, config.DB, config.Log)
+	teamsUseCase := usecase.NewTeamsUseCase(teamRepo, staffRepo, venuesRepo, logProducer, config.DB, config.Log)
+	playersUseCase := usecase.NewPlayersUseCase(playersRepo, teamRepo, competitionsRepo, logProducer, config.DB, config.Log)
+	matchesUseCase := usecase.NewMatchesUseCase(matchesRepo, competitionsRepo, cardsRepo, lineupsRepo, suspensionsRepo, disciplinaryRulesRepo, staffRepo, venuesRepo, teamRepo, officialsRepo, schedulingRepo, leaderboardsRepo, config.RedisClient, ratingsRepo, recordsRepo, config.Scheduling, config.Rating, logProducer, matchEventProducer, recordEventProducer, config.DB, config.Log)
+	goalsUseCase := usecase.NewGoalsUseCase(goalsRepo, matchesRepo, playersRepo, competitionsRepo, leaderboardsRepo, recordsRepo, config.RedisClient, logProducer, recordEventProducer, config.DB, config.Log)
+	competitionsUseCase := usecase.NewCompetitionsUseCase(competitionsRepo, teamRepo, matchesRepo, cardsRepo, disciplinaryRulesRepo, seasonsRepo, logProducer, config.DB, config.Log)
+	lineupsUseCase := usecase.NewLineupsUseCase(lineupsRepo, matchesRepo, playersRepo, injuriesRepo, suspensionsRepo, logProducer, config.DB, config.Log)
+	cardsUseCase := usecase.NewCardsUseCase(cardsRepo, matchesRepo, playersRepo, leaderboardsRepo, config.RedisClient, logProducer, config.DB, config.Log)
+	injuriesUseCase := usecase.NewInjuriesUseCase(injuriesRepo, playersRepo, logProducer, config.DB, config.Log)
+	venuesUseCase := usecase.NewVenuesUseCase(venuesRepo, logProducer, config.DB, config.Log)
+	staffUseCase := usecase.NewStaffUseCase(staffRepo, teamRepo, logProducer, config.DB, config.Log)
+	officialsUseCase := usecase.NewOfficialsUseCase(officialsRepo, matchesRepo, teamRepo, logProducer, config.DB, config.Log)
+	seasonsUseCase := usecase.NewSeasonsUseCase(seasonsRepo, logProducer, config.DB, config.Log)
+	calendarUseCase := usecase.NewCalendarUseCase(matchesRepo, teamRepo, seasonsRepo, config.DB, config.Log)
+	headToHeadUseCase := usecase.NewHeadToHeadUseCase(matchesRepo, teamRepo, config.DB, config.Log)
+	formUseCase := usecase.NewFormUseCase(matchesRepo, teamRepo, config.DB, config.Log)
+	leaderboardsUseCase := usecase.NewLeaderboardsUseCase(leaderboardsRepo, seasonsRepo, config.RedisClient, config.DB, config.Log)
+	ratingsUseCase := usecase.NewRatingsUseCase(ratingsRepo, teamRepo, seasonsRepo, config.Rating, config.DB, config.Log)
+	predictionsUseCase := usecase.NewPredictionsUseCase(predictionsRepo, matchesRepo, seasonsRepo, config.Prediction, config.DB, config.Log)
+	analyticsUseCase := usecase.NewAnalyticsUseCase(goalsRepo, matchesRepo, lineupsRepo, teamRepo, seasonsRepo, config.DB, config.Log)
+	recordsUseCase := usecase.NewRecordsUseCase(recordsRepo, config.DB, config.Log)
+	importUseCase := usecase.NewImportUseCase(teamRepo, playersRepo, venuesRepo, matchesRepo, goalsRepo, competitionsRepo, config.RedisClient, logProducer, config.DB, config.Log)
+	archiveUseCase := usecase.NewArchiveUseCase(archiveRepo, seasonsRepo, competitionsRepo, venuesRepo, teamRepo, playersRepo, matchesRepo, goalsRepo, logProducer, config.DB, config.Log)
+	schedulingUseCase := usecase.NewSchedulingUseCase(schedulingRepo, competitionsRepo, venuesRepo, seasonsRepo, matchesRepo, teamRepo, config.Scheduling, logProducer, config.DB, config.Log)
+	disciplinaryUseCase := usecase.NewDisciplinaryUseCase(disciplinaryRulesRepo, competitionsRepo, cardsRepo, logProducer, config.DB, config.Log)
+	suspensionsUseCase := usecase.NewSuspensionsUseCase(suspensionsRepo, injuriesRepo, playersRepo, competitionsRepo, logProducer, config.DB, config.Log)
 
 	// Initialize controllers
 	authController := http.NewAuthController(authUseCase, config.Log)
@@ -55,20 +97,64 @@ func Bootstrap(config *BootstrapConfig) {
 	playersController := http.NewPlayersController(playersUseCase, config.Log)
 	matchesController := http.NewMatchesController(matchesUseCase, config.Log)
 	goalsController := http.NewGoalsController(goalsUseCase, config.Log)
+	competitionsController := http.NewCompetitionsController(competitionsUseCase, config.Log)
+	lineupsController := http.NewLineupsController(lineupsUseCase, config.Log)
+	cardsController := http.NewCardsController(cardsUseCase, config.Log)
+	injuriesController := http.NewInjuriesController(injuriesUseCase, config.Log)
+	suspensionsController := http.NewSuspensionsController(suspensionsUseCase, config.Log)
+	disciplinaryController := http.NewDisciplinaryController(disciplinaryUseCase, config.Log)
+	staffController := http.NewStaffController(staffUseCase, config.Log)
+	venuesController := http.NewVenuesController(venuesUseCase, config.Log)
+	officialsController := http.NewOfficialsController(officialsUseCase, config.Log)
+	seasonsController := http.NewSeasonsController(seasonsUseCase, config.Log)
+	schedulingController := http.NewSchedulingController(schedulingUseCase, config.Log)
+	calendarController := http.NewCalendarController(calendarUseCase, config.Log)
+	headToHeadController := http.NewHeadToHeadController(headToHeadUseCase, config.Log)
+	formController := http.NewFormController(formUseCase, config.Log)
+	leaderboardsController := http.NewLeaderboardsController(leaderboardsUseCase, config.Log)
+	ratingsController := http.NewRatingsController(ratingsUseCase, config.Log)
+	predictionsController := http.NewPredictionsController(predictionsUseCase, config.Log)
+	analyticsController := http.NewAnalyticsController(analyticsUseCase, config.Log)
+	recordsController := http.NewRecordsController(recordsUseCase, config.Log)
+	importController := http.NewImportController(importUseCase, config.Log)
+	archiveController := http.NewArchiveController(archiveUseCase, config.Log)
 
 	// Set up middlewares
 	authMiddleware := middleware.AuthMiddleware(authUseCase)
 	rateLimiterMiddleware := middleware.NewRateLimiterMiddleware(config.Viper)
+	timezoneMiddleware := middleware.TimezoneMiddleware()
 
 	routeConfig := route.RouteConfig{
-		App:                   config.App,
-		AuthController:        authController,
-		TeamsController:       teamsController,
-		PlayerController:      playersController,
-		MatchesController:     matchesController,
-		GoalsController:       goalsController,
-		AuthMiddleware:        authMiddleware,
-		RateLimiterMiddleware: rateLimiterMiddleware,
+		App:                    config.App,
+		AuthController:         authController,
+		TeamsController:        teamsController,
+		PlayerController:       playersController,
+		MatchesController:      matchesController,
+		GoalsController:        goalsController,
+		CompetitionsController: competitionsController,
+		LineupsController:      lineupsController,
+		CardsController:        cardsController,
+		InjuriesController:     injuriesController,
+		SuspensionsController:  suspensionsController,
+		DisciplinaryController: disciplinaryController,
+		StaffController:        staffController,
+		VenuesController:       venuesController,
+		OfficialsController:    officialsController,
+		SeasonsController:      seasonsController,
+		SchedulingController:   schedulingController,
+		CalendarController:     calendarController,
+		HeadToHeadController:   headToHeadController,
+		FormController:         formController,
+		LeaderboardsController: leaderboardsController,
+		RatingsController:      ratingsController,
+		PredictionsController:  predictionsController,
+		AnalyticsController:    analyticsController,
+		RecordsController:      recordsController,
+		ImportController:       importController,
+		ArchiveController:      archiveController,
+		AuthMiddleware:         authMiddleware,
+		RateLimiterMiddleware:  rateLimiterMiddleware,
+		TimezoneMiddleware:     timezoneMiddleware,
 	}
 	routeConfig.Setup()
 
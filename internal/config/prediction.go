@@ -0,0 +1,23 @@
+package config
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+
+	"github.com/spf13/viper"
+)
+
+func NewPredictionConfig(v *viper.Viper) *model.PredictionConfig {
+	v.SetDefault("PREDICTION_HALF_LIFE_DAYS", 365)
+	v.SetDefault("PREDICTION_RUN_HOUR", 2)
+	v.SetDefault("PREDICTION_KICKOFF_CHECK_MINUTES", 15)
+	v.SetDefault("DEFAULT_TIMEZONE", "Asia/Jakarta")
+
+	return &model.PredictionConfig{
+		HalfLife:     time.Duration(v.GetInt("PREDICTION_HALF_LIFE_DAYS")) * 24 * time.Hour,
+		RunHour:      v.GetInt("PREDICTION_RUN_HOUR"),
+		Timezone:     v.GetString("DEFAULT_TIMEZONE"),
+		KickoffCheck: time.Duration(v.GetInt("PREDICTION_KICKOFF_CHECK_MINUTES")) * time.Minute,
+	}
+}
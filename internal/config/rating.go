@@ -0,0 +1,34 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/viper"
+)
+
+func NewRatingConfig(v *viper.Viper, log *logrus.Logger) *model.RatingConfig {
+	v.SetDefault("RATING_INITIAL", 1500)
+	v.SetDefault("RATING_K_FACTOR", 20)
+	v.SetDefault("RATING_HOME_ADVANTAGE", 100)
+	v.SetDefault("RATING_GOAL_DIFF_MULTIPLIERS", "1,1,1.5,1.75")
+
+	var multipliers []float64
+	for _, value := range strings.Split(v.GetString("RATING_GOAL_DIFF_MULTIPLIERS"), ",") {
+		multiplier, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			log.Fatalf("Invalid RATING_GOAL_DIFF_MULTIPLIERS value %q: %v", value, err)
+		}
+		multipliers = append(multipliers, multiplier)
+	}
+
+	return &model.RatingConfig{
+		InitialRating:       v.GetFloat64("RATING_INITIAL"),
+		KFactor:             v.GetFloat64("RATING_K_FACTOR"),
+		HomeAdvantage:       v.GetFloat64("RATING_HOME_ADVANTAGE"),
+		GoalDiffMultipliers: multipliers,
+	}
+}
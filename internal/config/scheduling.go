@@ -0,0 +1,21 @@
+package config
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+
+	"github.com/spf13/viper"
+)
+
+func NewSchedulingConfig(v *viper.Viper) *model.SchedulingConfig {
+	v.SetDefault("VENUE_BOOKING_BUFFER_MINUTES", 180)
+	v.SetDefault("SCHEDULING_MIN_REST_HOURS", 48)
+	v.SetDefault("DEFAULT_TIMEZONE", "Asia/Jakarta")
+
+	return &model.SchedulingConfig{
+		VenueBuffer:         time.Duration(v.GetInt("VENUE_BOOKING_BUFFER_MINUTES")) * time.Minute,
+		DefaultMinRestHours: v.GetInt("SCHEDULING_MIN_REST_HOURS"),
+		DefaultTimezone:     v.GetString("DEFAULT_TIMEZONE"),
+	}
+}
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type AnalyticsController struct {
+	AnalyticsUseCase usecase.AnalyticsUseCase
+	Log              *logrus.Logger
+}
+
+func NewAnalyticsController(analyticsUseCase usecase.AnalyticsUseCase, log *logrus.Logger) *AnalyticsController {
+	return &AnalyticsController{
+		AnalyticsUseCase: analyticsUseCase,
+		Log:              log,
+	}
+}
+
+// GoalTiming responds with JSON, or with CSV when format=csv is given.
+func (c *AnalyticsController) GoalTiming(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid format parameter").WithDetail("format", format),
+		))
+		return
+	}
+
+	req := model.GoalTimingRequest{TeamID: id, SeasonID: ctx.Query("season_id")}
+	res, err := c.AnalyticsUseCase.GoalTiming(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to build goal timing for team %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	if format == "csv" {
+		ctx.Header("Content-Disposition", `attachment; filename="goal-timing.csv"`)
+		ctx.Data(http.StatusOK, common.CSVContentType, []byte(converter.ToGoalTimingCSV(res)))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Goal timing retrieved successfully"))
+}
+
+func (c *AnalyticsController) SeasonSummary(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	seasonID := ctx.Param("seasonId")
+	if seasonID == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Season ID is required"),
+		))
+		return
+	}
+
+	req := model.TeamSeasonSummaryRequest{TeamID: id, SeasonID: seasonID}
+	res, err := c.AnalyticsUseCase.SeasonSummary(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to build season summary for team %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Season summary retrieved successfully"))
+}
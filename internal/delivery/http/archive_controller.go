@@ -0,0 +1,39 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type ArchiveController struct {
+	ArchiveUseCase usecase.ArchiveUseCase
+	Log            *logrus.Logger
+}
+
+func NewArchiveController(archiveUseCase usecase.ArchiveUseCase, log *logrus.Logger) *ArchiveController {
+	return &ArchiveController{
+		ArchiveUseCase: archiveUseCase,
+		Log:            log,
+	}
+}
+
+// Export downloads the season given by the season_id query parameter, or the
+// whole database without it, as a zip archive.
+func (c *ArchiveController) Export(ctx *gin.Context) {
+	req := model.ArchiveExportRequest{
+		SeasonID: ctx.Query("season_id"),
+	}
+
+	filename := "league-archive"
+	if req.SeasonID != "" {
+		filename = fmt.Sprintf("season-%s-archive", req.SeasonID)
+	}
+	out := &exportResponse{ctx: ctx, filename: filename, format: "zip"}
+	if err := c.ArchiveUseCase.Export(ctx, &req, out); err != nil {
+		exportFailed(ctx, c.Log, out, err)
+	}
+}
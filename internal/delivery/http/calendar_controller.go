@@ -0,0 +1,77 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+type CalendarController struct {
+	CalendarUseCase usecase.CalendarUseCase
+	Log             *logrus.Logger
+}
+
+func NewCalendarController(calendarUseCase usecase.CalendarUseCase, log *logrus.Logger) *CalendarController {
+	return &CalendarController{
+		CalendarUseCase: calendarUseCase,
+		Log:             log,
+	}
+}
+
+func (c *CalendarController) TeamFixtures(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	calendar, err := c.CalendarUseCase.TeamFixtures(ctx, &model.CalendarRequestTeamFixtures{TeamID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to build fixtures calendar for team %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.Header("Content-Disposition", `inline; filename="fixtures.ics"`)
+	ctx.Data(http.StatusOK, calendarContentType, []byte(calendar))
+}
+
+func (c *CalendarController) SeasonFixtures(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Season ID is required"),
+		))
+		return
+	}
+
+	calendar, err := c.CalendarUseCase.SeasonFixtures(ctx, &model.CalendarRequestSeasonFixtures{SeasonID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to build fixtures calendar for season %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.Header("Content-Disposition", `inline; filename="fixtures.ics"`)
+	ctx.Data(http.StatusOK, calendarContentType, []byte(calendar))
+}
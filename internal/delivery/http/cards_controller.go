@@ -0,0 +1,124 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type CardsController struct {
+	CardsUseCase usecase.CardsUseCase
+	Log          *logrus.Logger
+}
+
+func NewCardsController(cardsUseCase usecase.CardsUseCase, log *logrus.Logger) *CardsController {
+	return &CardsController{
+		CardsUseCase: cardsUseCase,
+		Log:          log,
+	}
+}
+
+func (c *CardsController) FindByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Card ID is required"),
+		))
+		return
+	}
+
+	card, err := c.CardsUseCase.FindByID(ctx, &model.CardRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find card by ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(card, "Card found"))
+}
+
+func (c *CardsController) FindByMatchID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	cards, err := c.CardsUseCase.FindByMatchID(ctx, &model.CardRequestFindByMatchID{MatchID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find cards for match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(cards, "Cards found"))
+}
+
+func (c *CardsController) Create(ctx *gin.Context) {
+	var req model.CardRequestCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	res, err := c.CardsUseCase.Create(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to create card: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Card created successfully"))
+}
+
+func (c *CardsController) SoftDelete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Card ID is required"),
+		))
+		return
+	}
+
+	res, err := c.CardsUseCase.SoftDelete(ctx, &model.CardRequestSoftDelete{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to soft delete card with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Card soft deleted successfully"))
+}
@@ -0,0 +1,260 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type CompetitionsController struct {
+	CompetitionsUseCase usecase.CompetitionsUseCase
+	Log                 *logrus.Logger
+}
+
+func NewCompetitionsController(competitionsUseCase usecase.CompetitionsUseCase, log *logrus.Logger) *CompetitionsController {
+	return &CompetitionsController{
+		CompetitionsUseCase: competitionsUseCase,
+		Log:                 log,
+	}
+}
+
+func (c *CompetitionsController) FindAll(ctx *gin.Context) {
+	competitions, err := c.CompetitionsUseCase.FindAll(ctx)
+	if err != nil {
+		c.Log.Errorf("Failed to find all competitions: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(competitions, "Competitions found"))
+}
+
+func (c *CompetitionsController) FindByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	competition, err := c.CompetitionsUseCase.FindByID(ctx, &model.CompetitionRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find competition by ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(competition, "Competition found"))
+}
+
+func (c *CompetitionsController) Create(ctx *gin.Context) {
+	var req model.CompetitionRequestCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	res, err := c.CompetitionsUseCase.Create(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to create competition: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Competition created successfully"))
+}
+
+func (c *CompetitionsController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	var req model.CompetitionRequestUpdate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.ID = id
+
+	res, err := c.CompetitionsUseCase.Update(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to update competition with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Competition updated successfully"))
+}
+
+func (c *CompetitionsController) SoftDelete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	res, err := c.CompetitionsUseCase.SoftDelete(ctx, &model.CompetitionRequestSoftDelete{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to soft delete competition with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Competition soft deleted successfully"))
+}
+
+func (c *CompetitionsController) RegisterTeam(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	var req model.CompetitionRequestRegisterTeam
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.ID = id
+
+	res, err := c.CompetitionsUseCase.RegisterTeam(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to register team in competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Team registered successfully"))
+}
+
+func (c *CompetitionsController) FindIneligiblePlayers(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	res, err := c.CompetitionsUseCase.FindIneligiblePlayers(ctx, &model.CompetitionRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find ineligible players for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Ineligible players found"))
+}
+
+func (c *CompetitionsController) GetStandings(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	format, appErr := exportFormat(ctx)
+	if appErr != nil {
+		ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		return
+	}
+
+	res, err := c.CompetitionsUseCase.GetStandings(ctx, &model.CompetitionRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to get standings for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	if format != "" {
+		writeExport(ctx, c.Log, "standings", format, func(w common.ExportWriter) error {
+			if err := w.Write(converter.StandingExportHeader...); err != nil {
+				return err
+			}
+			for _, standing := range res {
+				if err := w.Write(converter.ToStandingExportRow(&standing)...); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Standings retrieved successfully"))
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type DisciplinaryController struct {
+	DisciplinaryUseCase usecase.DisciplinaryUseCase
+	Log                 *logrus.Logger
+}
+
+func NewDisciplinaryController(disciplinaryUseCase usecase.DisciplinaryUseCase, log *logrus.Logger) *DisciplinaryController {
+	return &DisciplinaryController{
+		DisciplinaryUseCase: disciplinaryUseCase,
+		Log:                 log,
+	}
+}
+
+func (c *DisciplinaryController) FindRules(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	res, err := c.DisciplinaryUseCase.FindRules(ctx, &model.DisciplinaryRuleRequestFindByCompetitionID{CompetitionID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find disciplinary rules for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Disciplinary rules found"))
+}
+
+func (c *DisciplinaryController) UpdateRules(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	var req model.DisciplinaryRuleRequestUpdate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.CompetitionID = id
+
+	res, err := c.DisciplinaryUseCase.UpdateRules(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to update disciplinary rules for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Disciplinary rules updated successfully"))
+}
+
+func (c *DisciplinaryController) GetFairPlayTable(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	res, err := c.DisciplinaryUseCase.GetFairPlayTable(ctx, &model.DisciplinaryRuleRequestFindByCompetitionID{CompetitionID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to get fair-play table for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Fair-play table retrieved successfully"))
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// exportFormat reads the format query parameter, falling back to the Accept
+// header. An empty result means the client wants JSON.
+func exportFormat(ctx *gin.Context) (string, *common.AppError) {
+	switch format := ctx.Query("format"); format {
+	case "json":
+		return "", nil
+	case common.ExportFormatCSV, common.ExportFormatXLSX:
+		return format, nil
+	case "":
+	default:
+		return "", common.ErrInvalidInput("Invalid format parameter").WithDetail("format", format)
+	}
+
+	accept := ctx.GetHeader("Accept")
+	switch {
+	case strings.Contains(accept, "text/csv"):
+		return common.ExportFormatCSV, nil
+	case strings.Contains(accept, common.XLSXContentType):
+		return common.ExportFormatXLSX, nil
+	}
+	return "", nil
+}
+
+// exportResponse sends the download headers with the first bytes of the
+// body, so an error raised before any row is written can still be answered
+// with JSON.
+type exportResponse struct {
+	ctx      *gin.Context
+	filename string
+	format   string
+	started  bool
+}
+
+func (r *exportResponse) Write(p []byte) (int, error) {
+	if !r.started {
+		r.started = true
+		r.ctx.Header("Content-Type", common.ExportContentType(r.format))
+		r.ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, r.filename, r.format))
+		r.ctx.Status(http.StatusOK)
+	}
+	return r.ctx.Writer.Write(p)
+}
+
+// writeExport streams the rows written by write as a CSV or XLSX download.
+func writeExport(ctx *gin.Context, log *logrus.Logger, filename, format string, write func(w common.ExportWriter) error) {
+	out := &exportResponse{ctx: ctx, filename: filename, format: format}
+	w := common.NewExportWriter(format, out)
+	err := write(w)
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		exportFailed(ctx, log, out, err)
+	}
+}
+
+// exportFailed answers a failed download with the error, or cuts the
+// response short when part of the file was already sent.
+func exportFailed(ctx *gin.Context, log *logrus.Logger, out *exportResponse, err error) {
+	log.Errorf("Failed to export %s: %v", out.filename, err)
+	if out.started {
+		// part of the file is already on the wire
+		ctx.Abort()
+		return
+	}
+	if appErr, ok := common.IsAppError(err); ok {
+		ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+	} else {
+		ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+			common.ErrInternalServer("Unknown error"),
+		))
+	}
+}
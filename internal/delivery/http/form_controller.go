@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type FormController struct {
+	FormUseCase usecase.FormUseCase
+	Log         *logrus.Logger
+}
+
+func NewFormController(formUseCase usecase.FormUseCase, log *logrus.Logger) *FormController {
+	return &FormController{
+		FormUseCase: formUseCase,
+		Log:         log,
+	}
+}
+
+func (c *FormController) Find(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	req := model.TeamFormRequest{TeamID: id}
+	if last := ctx.Query("last"); last != "" {
+		n, err := strconv.Atoi(last)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+				common.ErrInvalidInput("Invalid last parameter").WithDetail("last", last),
+			))
+			return
+		}
+		req.Last = n
+	}
+
+	res, err := c.FormUseCase.Find(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to find form of team %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Team form retrieved successfully"))
+}
@@ -22,7 +22,21 @@ func NewGoalsController(goalsUseCase usecase.GoalsUseCase, log *logrus.Logger) *
 	}
 }
 
+// FindAll responds with JSON, or streams a CSV or XLSX export when one is
+// asked for through the format parameter or the Accept header.
 func (c *GoalsController) FindAll(ctx *gin.Context) {
+	format, appErr := exportFormat(ctx)
+	if appErr != nil {
+		ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		return
+	}
+	if format != "" {
+		writeExport(ctx, c.Log, "goals", format, func(w common.ExportWriter) error {
+			return c.GoalsUseCase.Export(ctx, w)
+		})
+		return
+	}
+
 	goals, err := c.GoalsUseCase.FindAll(ctx)
 	if err != nil {
 		c.Log.Errorf("Failed to find all goals: %v", err)
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type HeadToHeadController struct {
+	HeadToHeadUseCase usecase.HeadToHeadUseCase
+	Log               *logrus.Logger
+}
+
+func NewHeadToHeadController(headToHeadUseCase usecase.HeadToHeadUseCase, log *logrus.Logger) *HeadToHeadController {
+	return &HeadToHeadController{
+		HeadToHeadUseCase: headToHeadUseCase,
+		Log:               log,
+	}
+}
+
+func (c *HeadToHeadController) Find(ctx *gin.Context) {
+	id := ctx.Param("id")
+	otherID := ctx.Param("otherId")
+	if id == "" || otherID == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	res, err := c.HeadToHeadUseCase.Find(ctx, &model.HeadToHeadRequest{TeamID: id, OtherTeamID: otherID})
+	if err != nil {
+		c.Log.Errorf("Failed to find head-to-head of teams %s and %s: %v", id, otherID, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Head-to-head retrieved successfully"))
+}
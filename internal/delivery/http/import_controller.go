@@ -0,0 +1,144 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// maxImportFileSize is the largest CSV file an import accepts.
+const maxImportFileSize = 5 * 1024 * 1024
+
+type ImportController struct {
+	ImportUseCase usecase.ImportUseCase
+	Log           *logrus.Logger
+}
+
+func NewImportController(importUseCase usecase.ImportUseCase, log *logrus.Logger) *ImportController {
+	return &ImportController{
+		ImportUseCase: importUseCase,
+		Log:           log,
+	}
+}
+
+// importFile reads the uploaded file from a multipart "file" field or from
+// the raw body.
+func (c *ImportController) importFile(ctx *gin.Context) ([]byte, bool) {
+	body := io.Reader(ctx.Request.Body)
+	if strings.HasPrefix(ctx.ContentType(), "multipart/") {
+		header, err := ctx.FormFile("file")
+		if err != nil {
+			c.Log.Errorf("Failed to get import file: %v", err)
+			ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+				common.ErrInvalidInput("Import file is required"),
+			))
+			return nil, false
+		}
+		file, err := header.Open()
+		if err != nil {
+			c.Log.Errorf("Failed to open import file: %v", err)
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Failed to read import file"),
+			))
+			return nil, false
+		}
+		defer file.Close()
+		body = file
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, maxImportFileSize+1))
+	if err != nil {
+		c.Log.Errorf("Failed to read import file: %v", err)
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Failed to read import file"),
+		))
+		return nil, false
+	}
+	if len(data) > maxImportFileSize {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Import file is too large"),
+		))
+		return nil, false
+	}
+
+	return data, true
+}
+
+// respond answers an import. Imports run as a dry run unless mode=commit.
+func (c *ImportController) respond(ctx *gin.Context, mode string, res any, err error) {
+	if err != nil {
+		c.Log.Errorf("Failed to import: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	if mode == "commit" {
+		ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Import completed successfully"))
+		return
+	}
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Import checked successfully"))
+}
+
+func (c *ImportController) Teams(ctx *gin.Context) {
+	file, ok := c.importFile(ctx)
+	if !ok {
+		return
+	}
+
+	req := model.ImportRequest{Mode: ctx.DefaultQuery("mode", "dry_run"), File: file}
+	res, err := c.ImportUseCase.Teams(ctx, &req)
+	c.respond(ctx, req.Mode, res, err)
+}
+
+func (c *ImportController) Players(ctx *gin.Context) {
+	file, ok := c.importFile(ctx)
+	if !ok {
+		return
+	}
+
+	req := model.ImportRequest{Mode: ctx.DefaultQuery("mode", "dry_run"), File: file}
+	res, err := c.ImportUseCase.Players(ctx, &req)
+	c.respond(ctx, req.Mode, res, err)
+}
+
+// Results imports past results. The format is detected from the file unless
+// given, and kickoff times are read in the timezone parameter, UTC by
+// default.
+func (c *ImportController) Results(ctx *gin.Context) {
+	file, ok := c.importFile(ctx)
+	if !ok {
+		return
+	}
+
+	createTeams, err := strconv.ParseBool(ctx.DefaultQuery("create_teams", "false"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid create_teams parameter").WithDetail("create_teams", ctx.Query("create_teams")),
+		))
+		return
+	}
+
+	req := model.ResultsImportRequest{
+		Mode:          ctx.DefaultQuery("mode", "dry_run"),
+		File:          file,
+		Format:        ctx.Query("format"),
+		CompetitionID: ctx.Query("competition_id"),
+		Timezone:      ctx.DefaultQuery("timezone", "UTC"),
+		CreateTeams:   createTeams,
+	}
+	res, err := c.ImportUseCase.Results(ctx, &req)
+	c.respond(ctx, req.Mode, res, err)
+}
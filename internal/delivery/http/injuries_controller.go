@@ -0,0 +1,135 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type InjuriesController struct {
+	InjuriesUseCase usecase.InjuriesUseCase
+	Log             *logrus.Logger
+}
+
+func NewInjuriesController(injuriesUseCase usecase.InjuriesUseCase, log *logrus.Logger) *InjuriesController {
+	return &InjuriesController{
+		InjuriesUseCase: injuriesUseCase,
+		Log:             log,
+	}
+}
+
+func (c *InjuriesController) FindByPlayerID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Player ID is required"),
+		))
+		return
+	}
+
+	res, err := c.InjuriesUseCase.FindByPlayerID(ctx, &model.InjuryRequestFindByPlayerID{PlayerID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find injuries for player %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Injuries found"))
+}
+
+func (c *InjuriesController) Create(ctx *gin.Context) {
+	var req model.InjuryRequestCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	res, err := c.InjuriesUseCase.Create(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to create injury: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Injury created successfully"))
+}
+
+func (c *InjuriesController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Injury ID is required"),
+		))
+		return
+	}
+
+	var req model.InjuryRequestUpdate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.ID = id
+
+	res, err := c.InjuriesUseCase.Update(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to update injury with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Injury updated successfully"))
+}
+
+func (c *InjuriesController) SoftDelete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Injury ID is required"),
+		))
+		return
+	}
+
+	res, err := c.InjuriesUseCase.SoftDelete(ctx, &model.InjuryRequestSoftDelete{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to soft delete injury with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Injury soft deleted successfully"))
+}
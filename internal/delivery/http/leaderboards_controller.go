@@ -0,0 +1,89 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type LeaderboardsController struct {
+	LeaderboardsUseCase usecase.LeaderboardsUseCase
+	Log                 *logrus.Logger
+}
+
+func NewLeaderboardsController(leaderboardsUseCase usecase.LeaderboardsUseCase, log *logrus.Logger) *LeaderboardsController {
+	return &LeaderboardsController{
+		LeaderboardsUseCase: leaderboardsUseCase,
+		Log:                 log,
+	}
+}
+
+// Find responds with one page of the board as JSON, or streams the whole board
+// as a CSV or XLSX export; page and limit do not apply to exports.
+func (c *LeaderboardsController) Find(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Season ID is required"),
+		))
+		return
+	}
+
+	format, appErr := exportFormat(ctx)
+	if appErr != nil {
+		ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		return
+	}
+	if format != "" {
+		req := model.LeaderboardExportRequest{
+			SeasonID: id,
+			Board:    ctx.Param("board"),
+		}
+		writeExport(ctx, c.Log, "leaderboard-"+req.Board, format, func(w common.ExportWriter) error {
+			return c.LeaderboardsUseCase.Export(ctx, &req, w)
+		})
+		return
+	}
+
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid page parameter").WithDetail("page", ctx.Query("page")),
+		))
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid limit parameter").WithDetail("limit", ctx.Query("limit")),
+		))
+		return
+	}
+
+	req := model.LeaderboardRequest{
+		SeasonID: id,
+		Board:    ctx.Param("board"),
+		Page:     page,
+		Limit:    limit,
+	}
+
+	res, pagination, err := c.LeaderboardsUseCase.Find(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to find %s leaderboard for season %s: %v", req.Board, id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponseWithMeta(res, "Leaderboard retrieved successfully", &model.Meta{Pagination: pagination}))
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type LineupsController struct {
+	LineupsUseCase usecase.LineupsUseCase
+	Log            *logrus.Logger
+}
+
+func NewLineupsController(lineupsUseCase usecase.LineupsUseCase, log *logrus.Logger) *LineupsController {
+	return &LineupsController{
+		LineupsUseCase: lineupsUseCase,
+		Log:            log,
+	}
+}
+
+func (c *LineupsController) FindByMatchID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	res, err := c.LineupsUseCase.FindByMatchID(ctx, &model.LineupRequestFindByMatchID{MatchID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find lineups for match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Lineups found"))
+}
+
+func (c *LineupsController) Submit(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	var req model.LineupRequestSubmit
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.MatchID = id
+
+	res, err := c.LineupsUseCase.Submit(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to submit lineup for match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Lineup submitted successfully"))
+}
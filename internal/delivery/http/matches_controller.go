@@ -1,10 +1,12 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/Fadlihardiyanto/football-api/internal/common"
 	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
 	"github.com/Fadlihardiyanto/football-api/internal/usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -22,7 +24,21 @@ func NewMatchesController(matchesUseCase usecase.MatchesUseCase, log *logrus.Log
 	}
 }
 
+// FindAll responds with JSON, or streams a CSV or XLSX export when one is
+// asked for through the format parameter or the Accept header.
 func (c *MatchesController) FindAll(ctx *gin.Context) {
+	format, appErr := exportFormat(ctx)
+	if appErr != nil {
+		ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		return
+	}
+	if format != "" {
+		writeExport(ctx, c.Log, "matches", format, func(w common.ExportWriter) error {
+			return c.MatchesUseCase.Export(ctx, w)
+		})
+		return
+	}
+
 	matches, err := c.MatchesUseCase.FindAll(ctx)
 	if err != nil {
 		c.Log.Errorf("Failed to find all matches: %v", err)
@@ -186,6 +202,60 @@ func (c *MatchesController) GetMatchReport(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Match report retrieved successfully"))
 }
 
+// matchReport loads the report behind the printable match sheets, writing
+// the error response itself when it fails.
+func (c *MatchesController) matchReport(ctx *gin.Context) (*model.MatchReportResponse, bool) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return nil, false
+	}
+
+	res, err := c.MatchesUseCase.GetMatchReport(ctx, &model.MatchRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to get match report for ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return nil, false
+	}
+	return res, true
+}
+
+func (c *MatchesController) GetMatchReportHTML(ctx *gin.Context) {
+	res, ok := c.matchReport(ctx)
+	if !ok {
+		return
+	}
+
+	sheet, err := converter.ToMatchSheetHTML(res)
+	if err != nil {
+		c.Log.Errorf("Failed to render match sheet for ID %s: %v", res.ID, err)
+		ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+			common.ErrInternalServer("Failed to render match report"),
+		))
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", sheet)
+}
+
+func (c *MatchesController) GetMatchReportPDF(ctx *gin.Context) {
+	res, ok := c.matchReport(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`inline; filename="match-report-%s.pdf"`, res.ID))
+	ctx.Data(http.StatusOK, "application/pdf", converter.ToMatchSheetPDF(res))
+}
+
 func (c *MatchesController) FinishMatch(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
@@ -221,3 +291,136 @@ func (c *MatchesController) FinishMatch(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Match finished successfully"))
 }
+
+func (c *MatchesController) GenerateFixtures(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	var req model.FixtureRequestGenerate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.CompetitionID = id
+
+	res, err := c.MatchesUseCase.GenerateFixtures(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to generate fixtures for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Fixtures generated successfully"))
+}
+
+func (c *MatchesController) Postpone(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	var req model.MatchRequestPostpone
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.ID = id
+
+	res, err := c.MatchesUseCase.Postpone(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to postpone match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Match postponed successfully"))
+}
+
+func (c *MatchesController) Reschedule(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	var req model.MatchRequestReschedule
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.ID = id
+
+	res, err := c.MatchesUseCase.Reschedule(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to reschedule match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Match rescheduled successfully"))
+}
+
+func (c *MatchesController) FindReschedules(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	res, err := c.MatchesUseCase.FindReschedules(ctx, &model.MatchRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find reschedules for match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Match reschedules retrieved successfully"))
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/gin-gonic/gin"
+)
+
+// TimezoneMiddleware resolves the timezone times are rendered in from the tz
+// query parameter or the Accept-Timezone header. Without either, matches are
+// rendered in their venue's timezone.
+func TimezoneMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		name := ctx.Query("tz")
+		if name == "" {
+			name = ctx.GetHeader("Accept-Timezone")
+		}
+		if name == "" {
+			ctx.Next()
+			return
+		}
+
+		loc, err := time.LoadLocation(name)
+		if err != nil || name == "Local" {
+			ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+				common.ErrInvalidInput("Invalid timezone").WithDetail("tz", name),
+			))
+			ctx.Abort()
+			return
+		}
+
+		ctx.Set(common.TimezoneKey, loc)
+		ctx.Next()
+	}
+}
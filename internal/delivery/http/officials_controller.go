@@ -0,0 +1,264 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type OfficialsController struct {
+	OfficialsUseCase usecase.OfficialsUseCase
+	Log              *logrus.Logger
+}
+
+func NewOfficialsController(officialsUseCase usecase.OfficialsUseCase, log *logrus.Logger) *OfficialsController {
+	return &OfficialsController{
+		OfficialsUseCase: officialsUseCase,
+		Log:              log,
+	}
+}
+
+func (c *OfficialsController) FindAll(ctx *gin.Context) {
+	officials, err := c.OfficialsUseCase.FindAll(ctx)
+	if err != nil {
+		c.Log.Errorf("Failed to find all officials: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(officials, "Officials found"))
+}
+
+func (c *OfficialsController) FindByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Official ID is required"),
+		))
+		return
+	}
+
+	official, err := c.OfficialsUseCase.FindByID(ctx, &model.OfficialRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find official by ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(official, "Official found"))
+}
+
+func (c *OfficialsController) Create(ctx *gin.Context) {
+	var req model.OfficialRequestCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	res, err := c.OfficialsUseCase.Create(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to create official: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Official created successfully"))
+}
+
+func (c *OfficialsController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Official ID is required"),
+		))
+		return
+	}
+
+	var req model.OfficialRequestUpdate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.ID = id
+
+	res, err := c.OfficialsUseCase.Update(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to update official with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Official updated successfully"))
+}
+
+func (c *OfficialsController) SoftDelete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Official ID is required"),
+		))
+		return
+	}
+
+	res, err := c.OfficialsUseCase.SoftDelete(ctx, &model.OfficialRequestSoftDelete{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to soft delete official with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Official soft deleted successfully"))
+}
+
+func (c *OfficialsController) FindMatches(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Official ID is required"),
+		))
+		return
+	}
+
+	res, err := c.OfficialsUseCase.FindMatches(ctx, &model.OfficialRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find matches for official %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Official's matches found"))
+}
+
+func (c *OfficialsController) FindByMatchID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	res, err := c.OfficialsUseCase.FindByMatchID(ctx, &model.MatchOfficialRequestFindByMatchID{MatchID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find officials for match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Match officials found"))
+}
+
+func (c *OfficialsController) Assign(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	var req model.MatchOfficialRequestAssign
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.MatchID = id
+
+	res, err := c.OfficialsUseCase.Assign(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to assign official to match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Official assigned successfully"))
+}
+
+func (c *OfficialsController) Unassign(ctx *gin.Context) {
+	id := ctx.Param("id")
+	assignmentID := ctx.Param("assignmentId")
+	if id == "" || assignmentID == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID and assignment ID are required"),
+		))
+		return
+	}
+
+	res, err := c.OfficialsUseCase.Unassign(ctx, &model.MatchOfficialRequestUnassign{ID: assignmentID, MatchID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to remove official assignment %s: %v", assignmentID, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Official removed from match successfully"))
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/Fadlihardiyanto/football-api/internal/common"
 	"github.com/Fadlihardiyanto/football-api/internal/model"
@@ -22,7 +23,21 @@ func NewPlayersController(playersUseCase usecase.PlayersUseCase, log *logrus.Log
 	}
 }
 
+// FindAll responds with JSON, or streams a CSV or XLSX export when one is
+// asked for through the format parameter or the Accept header.
 func (c *PlayersController) FindAll(ctx *gin.Context) {
+	format, appErr := exportFormat(ctx)
+	if appErr != nil {
+		ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		return
+	}
+	if format != "" {
+		writeExport(ctx, c.Log, "players", format, func(w common.ExportWriter) error {
+			return c.PlayersUseCase.Export(ctx, w)
+		})
+		return
+	}
+
 	players, err := c.PlayersUseCase.FindAll(ctx)
 	if err != nil {
 		c.Log.Errorf("Failed to find all players: %v", err)
@@ -39,6 +54,35 @@ func (c *PlayersController) FindAll(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, model.NewSuccessResponse(players, "Players found"))
 }
 
+// Compare responds with side-by-side statistics for the comma-separated ids.
+func (c *PlayersController) Compare(ctx *gin.Context) {
+	req := model.PlayerComparisonRequest{
+		CompetitionID: ctx.Query("competition_id"),
+		From:          ctx.Query("from"),
+		To:            ctx.Query("to"),
+	}
+	for _, id := range strings.Split(ctx.Query("ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			req.PlayerIDs = append(req.PlayerIDs, id)
+		}
+	}
+
+	res, err := c.PlayersUseCase.Compare(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to compare players %v: %v", req.PlayerIDs, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Players compared successfully"))
+}
+
 func (c *PlayersController) FindByID(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type PredictionsController struct {
+	PredictionsUseCase usecase.PredictionsUseCase
+	Log                *logrus.Logger
+}
+
+func NewPredictionsController(predictionsUseCase usecase.PredictionsUseCase, log *logrus.Logger) *PredictionsController {
+	return &PredictionsController{
+		PredictionsUseCase: predictionsUseCase,
+		Log:                log,
+	}
+}
+
+func (c *PredictionsController) FindByMatchID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Match ID is required"),
+		))
+		return
+	}
+
+	req := model.MatchRequestFindByID{ID: id}
+	res, err := c.PredictionsUseCase.FindByMatchID(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to find prediction for match %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Match prediction retrieved successfully"))
+}
+
+func (c *PredictionsController) Calibration(ctx *gin.Context) {
+	req := model.PredictionCalibrationRequest{SeasonID: ctx.Query("season_id")}
+	res, err := c.PredictionsUseCase.Calibration(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to build prediction calibration report: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Prediction calibration retrieved successfully"))
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type RatingsController struct {
+	RatingsUseCase usecase.RatingsUseCase
+	Log            *logrus.Logger
+}
+
+func NewRatingsController(ratingsUseCase usecase.RatingsUseCase, log *logrus.Logger) *RatingsController {
+	return &RatingsController{
+		RatingsUseCase: ratingsUseCase,
+		Log:            log,
+	}
+}
+
+func (c *RatingsController) FindAll(ctx *gin.Context) {
+	res, err := c.RatingsUseCase.FindAll(ctx)
+	if err != nil {
+		c.Log.Errorf("Failed to find team ratings: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Team ratings retrieved successfully"))
+}
+
+func (c *RatingsController) FindHistory(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	req := model.TeamRatingRequest{TeamID: id}
+	res, err := c.RatingsUseCase.FindHistory(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to find rating history for team %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Rating history retrieved successfully"))
+}
+
+func (c *RatingsController) FindSeasonComparison(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Season ID is required"),
+		))
+		return
+	}
+
+	req := model.SeasonRatingsRequest{SeasonID: id}
+	res, err := c.RatingsUseCase.FindSeasonComparison(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to compare ratings for season %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Season ratings retrieved successfully"))
+}
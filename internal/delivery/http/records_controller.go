@@ -0,0 +1,92 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type RecordsController struct {
+	RecordsUseCase usecase.RecordsUseCase
+	Log            *logrus.Logger
+}
+
+func NewRecordsController(recordsUseCase usecase.RecordsUseCase, log *logrus.Logger) *RecordsController {
+	return &RecordsController{
+		RecordsUseCase: recordsUseCase,
+		Log:            log,
+	}
+}
+
+// FindAll lists records, optionally filtered by type, competition_id, team_id
+// and player_id.
+func (c *RecordsController) FindAll(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid page parameter").WithDetail("page", ctx.Query("page")),
+		))
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid limit parameter").WithDetail("limit", ctx.Query("limit")),
+		))
+		return
+	}
+
+	req := model.RecordRequestFindAll{
+		Type:          ctx.Query("type"),
+		CompetitionID: ctx.Query("competition_id"),
+		TeamID:        ctx.Query("team_id"),
+		PlayerID:      ctx.Query("player_id"),
+		Page:          page,
+		Limit:         limit,
+	}
+
+	res, pagination, err := c.RecordsUseCase.FindAll(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to find records: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponseWithMeta(res, "Records retrieved successfully", &model.Meta{Pagination: pagination}))
+}
+
+func (c *RecordsController) FindByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Record ID is required"),
+		))
+		return
+	}
+
+	res, err := c.RecordsUseCase.FindByID(ctx, &model.RecordRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find record by ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Record retrieved successfully"))
+}
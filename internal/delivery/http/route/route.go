@@ -9,18 +9,41 @@ import (
 
 // RouteConfig holds Gin engine and controllers
 type RouteConfig struct {
-	App                   *gin.Engine
-	AuthController        *httpdelivery.AuthController
-	TeamsController       *httpdelivery.TeamsController
-	PlayerController      *httpdelivery.PlayersController
-	MatchesController     *httpdelivery.MatchesController
-	GoalsController       *httpdelivery.GoalsController
-	AuthMiddleware        gin.HandlerFunc
-	RateLimiterMiddleware gin.HandlerFunc
+	App                    *gin.Engine
+	AuthController         *httpdelivery.AuthController
+	TeamsController        *httpdelivery.TeamsController
+	PlayerController       *httpdelivery.PlayersController
+	MatchesController      *httpdelivery.MatchesController
+	GoalsController        *httpdelivery.GoalsController
+	CompetitionsController *httpdelivery.CompetitionsController
+	LineupsController      *httpdelivery.LineupsController
+	CardsController        *httpdelivery.CardsController
+	InjuriesController     *httpdelivery.InjuriesController
+	SuspensionsController  *httpdelivery.SuspensionsController
+	DisciplinaryController *httpdelivery.DisciplinaryController
+	StaffController        *httpdelivery.StaffController
+	VenuesController       *httpdelivery.VenuesController
+	OfficialsController    *httpdelivery.OfficialsController
+	SeasonsController      *httpdelivery.SeasonsController
+	SchedulingController   *httpdelivery.SchedulingController
+	CalendarController     *httpdelivery.CalendarController
+	HeadToHeadController   *httpdelivery.HeadToHeadController
+	FormController         *httpdelivery.FormController
+	LeaderboardsController *httpdelivery.LeaderboardsController
+	RatingsController      *httpdelivery.RatingsController
+	PredictionsController  *httpdelivery.PredictionsController
+	AnalyticsController    *httpdelivery.AnalyticsController
+	RecordsController      *httpdelivery.RecordsController
+	ImportController       *httpdelivery.ImportController
+	ArchiveController      *httpdelivery.ArchiveController
+	AuthMiddleware         gin.HandlerFunc
+	RateLimiterMiddleware  gin.HandlerFunc
+	TimezoneMiddleware     gin.HandlerFunc
 }
 
 func (c *RouteConfig) Setup() {
 	c.App.Use(c.RateLimiterMiddleware)
+	c.App.Use(c.TimezoneMiddleware)
 
 	api := c.App.Group("/api/v1")
 
@@ -47,6 +70,10 @@ func (c *RouteConfig) SetupGuestRoutes(api *gin.RouterGroup) {
 	auth.POST("/register", c.AuthController.Register)
 	auth.POST("/refresh", c.AuthController.Refresh)
 	auth.POST("/logout", c.AuthController.Logout)
+
+	// calendar subscriptions cannot send a bearer token
+	api.GET("/teams/:id/fixtures.ics", c.CalendarController.TeamFixtures)
+	api.GET("/seasons/:id/fixtures.ics", c.CalendarController.SeasonFixtures)
 }
 
 func (c *RouteConfig) SetupAuthRoutes(api *gin.RouterGroup) {
@@ -58,13 +85,26 @@ func (c *RouteConfig) SetupAuthRoutes(api *gin.RouterGroup) {
 	teams.PUT("/:id", c.TeamsController.Update)
 	teams.DELETE("/:id", c.TeamsController.SoftDelete)
 	teams.POST("/:id/upload-logo", c.TeamsController.UploadLogo)
+	teams.GET("/:id/staff", c.StaffController.FindByTeamID)
+	teams.POST("/:id/staff", c.StaffController.Create)
+	teams.PUT("/:id/staff/:staffId", c.StaffController.Update)
+	teams.DELETE("/:id/staff/:staffId", c.StaffController.SoftDelete)
+	teams.GET("/:id/head-to-head/:otherId", c.HeadToHeadController.Find)
+	teams.GET("/:id/form", c.FormController.Find)
+	teams.GET("/:id/ratings", c.RatingsController.FindHistory)
+	teams.GET("/:id/analytics/goal-timing", c.AnalyticsController.GoalTiming)
+	teams.GET("/:id/seasons/:seasonId/summary", c.AnalyticsController.SeasonSummary)
 
 	players := api.Group("/players")
 	players.GET("/", c.PlayerController.FindAll)
+	players.GET("/compare", c.PlayerController.Compare)
 	players.GET("/:id", c.PlayerController.FindByID)
 	players.POST("/", c.PlayerController.Create)
 	players.PUT("/:id", c.PlayerController.Update)
 	players.DELETE("/:id", c.PlayerController.SoftDelete)
+	players.GET("/:id/injuries", c.InjuriesController.FindByPlayerID)
+	players.GET("/:id/suspensions", c.SuspensionsController.FindByPlayerID)
+	players.GET("/:id/availability", c.SuspensionsController.GetAvailability)
 
 	matches := api.Group("/matches")
 	matches.GET("/", c.MatchesController.FindAll)
@@ -73,7 +113,19 @@ func (c *RouteConfig) SetupAuthRoutes(api *gin.RouterGroup) {
 	matches.PUT("/:id", c.MatchesController.Update)
 	matches.DELETE("/:id", c.MatchesController.SoftDelete)
 	matches.GET("/:id/report", c.MatchesController.GetMatchReport)
+	matches.GET("/:id/report.html", c.MatchesController.GetMatchReportHTML)
+	matches.GET("/:id/report.pdf", c.MatchesController.GetMatchReportPDF)
 	matches.POST("/:id/finish", c.MatchesController.FinishMatch)
+	matches.POST("/:id/postpone", c.MatchesController.Postpone)
+	matches.POST("/:id/reschedule", c.MatchesController.Reschedule)
+	matches.GET("/:id/reschedules", c.MatchesController.FindReschedules)
+	matches.GET("/:id/prediction", c.PredictionsController.FindByMatchID)
+	matches.GET("/:id/lineups", c.LineupsController.FindByMatchID)
+	matches.POST("/:id/lineups", c.LineupsController.Submit)
+	matches.GET("/:id/cards", c.CardsController.FindByMatchID)
+	matches.GET("/:id/officials", c.OfficialsController.FindByMatchID)
+	matches.POST("/:id/officials", c.OfficialsController.Assign)
+	matches.DELETE("/:id/officials/:assignmentId", c.OfficialsController.Unassign)
 
 	goals := api.Group("/goals")
 	goals.GET("/", c.GoalsController.FindAll)
@@ -81,4 +133,80 @@ func (c *RouteConfig) SetupAuthRoutes(api *gin.RouterGroup) {
 	goals.POST("/", c.GoalsController.Create)
 	goals.PUT("/:id", c.GoalsController.Update)
 	goals.DELETE("/:id", c.GoalsController.SoftDelete)
+
+	venues := api.Group("/venues")
+	venues.GET("/", c.VenuesController.FindAll)
+	venues.GET("/:id", c.VenuesController.FindByID)
+	venues.POST("/", c.VenuesController.Create)
+	venues.PUT("/:id", c.VenuesController.Update)
+	venues.DELETE("/:id", c.VenuesController.SoftDelete)
+	venues.GET("/:id/unavailability", c.SchedulingController.FindVenueUnavailabilities)
+	venues.POST("/:id/unavailability", c.SchedulingController.CreateVenueUnavailability)
+	venues.DELETE("/:id/unavailability/:unavailabilityId", c.SchedulingController.SoftDeleteVenueUnavailability)
+
+	officials := api.Group("/officials")
+	officials.GET("/", c.OfficialsController.FindAll)
+	officials.GET("/:id", c.OfficialsController.FindByID)
+	officials.POST("/", c.OfficialsController.Create)
+	officials.PUT("/:id", c.OfficialsController.Update)
+	officials.DELETE("/:id", c.OfficialsController.SoftDelete)
+	officials.GET("/:id/matches", c.OfficialsController.FindMatches)
+
+	cards := api.Group("/cards")
+	cards.GET("/:id", c.CardsController.FindByID)
+	cards.POST("/", c.CardsController.Create)
+	cards.DELETE("/:id", c.CardsController.SoftDelete)
+
+	injuries := api.Group("/injuries")
+	injuries.POST("/", c.InjuriesController.Create)
+	injuries.PUT("/:id", c.InjuriesController.Update)
+	injuries.DELETE("/:id", c.InjuriesController.SoftDelete)
+
+	suspensions := api.Group("/suspensions")
+	suspensions.POST("/", c.SuspensionsController.Create)
+	suspensions.DELETE("/:id", c.SuspensionsController.SoftDelete)
+
+	seasons := api.Group("/seasons")
+	seasons.GET("/", c.SeasonsController.FindAll)
+	seasons.GET("/:id", c.SeasonsController.FindByID)
+	seasons.POST("/", c.SeasonsController.Create)
+	seasons.PUT("/:id", c.SeasonsController.Update)
+	seasons.DELETE("/:id", c.SeasonsController.SoftDelete)
+	seasons.GET("/:id/conflicts", c.SchedulingController.GetSeasonConflicts)
+	seasons.GET("/:id/leaderboards/:board", c.LeaderboardsController.Find)
+	seasons.GET("/:id/ratings", c.RatingsController.FindSeasonComparison)
+
+	ratings := api.Group("/ratings")
+	ratings.GET("/", c.RatingsController.FindAll)
+
+	predictions := api.Group("/predictions")
+	predictions.GET("/calibration", c.PredictionsController.Calibration)
+
+	records := api.Group("/records")
+	records.GET("/", c.RecordsController.FindAll)
+	records.GET("/:id", c.RecordsController.FindByID)
+
+	imports := api.Group("/import")
+	imports.POST("/teams", c.ImportController.Teams)
+	imports.POST("/players", c.ImportController.Players)
+	imports.POST("/results", c.ImportController.Results)
+
+	admin := api.Group("/admin")
+	admin.GET("/archive", c.ArchiveController.Export)
+
+	competitions := api.Group("/competitions")
+	competitions.GET("/", c.CompetitionsController.FindAll)
+	competitions.GET("/:id", c.CompetitionsController.FindByID)
+	competitions.POST("/", c.CompetitionsController.Create)
+	competitions.PUT("/:id", c.CompetitionsController.Update)
+	competitions.DELETE("/:id", c.CompetitionsController.SoftDelete)
+	competitions.POST("/:id/teams", c.CompetitionsController.RegisterTeam)
+	competitions.GET("/:id/ineligible-players", c.CompetitionsController.FindIneligiblePlayers)
+	competitions.GET("/:id/standings", c.CompetitionsController.GetStandings)
+	competitions.GET("/:id/disciplinary-rules", c.DisciplinaryController.FindRules)
+	competitions.PUT("/:id/disciplinary-rules", c.DisciplinaryController.UpdateRules)
+	competitions.GET("/:id/fair-play", c.DisciplinaryController.GetFairPlayTable)
+	competitions.GET("/:id/scheduling-rules", c.SchedulingController.FindRules)
+	competitions.PUT("/:id/scheduling-rules", c.SchedulingController.UpdateRules)
+	competitions.POST("/:id/fixtures", c.MatchesController.GenerateFixtures)
 }
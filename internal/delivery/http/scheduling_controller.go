@@ -0,0 +1,196 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type SchedulingController struct {
+	SchedulingUseCase usecase.SchedulingUseCase
+	Log               *logrus.Logger
+}
+
+func NewSchedulingController(schedulingUseCase usecase.SchedulingUseCase, log *logrus.Logger) *SchedulingController {
+	return &SchedulingController{
+		SchedulingUseCase: schedulingUseCase,
+		Log:               log,
+	}
+}
+
+func (c *SchedulingController) FindRules(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	res, err := c.SchedulingUseCase.FindRules(ctx, &model.SchedulingRuleRequestFindByCompetitionID{CompetitionID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find scheduling rules for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Scheduling rules found"))
+}
+
+func (c *SchedulingController) UpdateRules(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Competition ID is required"),
+		))
+		return
+	}
+
+	var req model.SchedulingRuleRequestUpdate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.CompetitionID = id
+
+	res, err := c.SchedulingUseCase.UpdateRules(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to update scheduling rules for competition %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Scheduling rules updated successfully"))
+}
+
+func (c *SchedulingController) FindVenueUnavailabilities(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Venue ID is required"),
+		))
+		return
+	}
+
+	res, err := c.SchedulingUseCase.FindVenueUnavailabilities(ctx, &model.VenueUnavailabilityRequestFindByVenueID{VenueID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find unavailability for venue %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Venue unavailability found"))
+}
+
+func (c *SchedulingController) CreateVenueUnavailability(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Venue ID is required"),
+		))
+		return
+	}
+
+	var req model.VenueUnavailabilityRequestCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.VenueID = id
+
+	res, err := c.SchedulingUseCase.CreateVenueUnavailability(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to create unavailability for venue %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Venue unavailability created successfully"))
+}
+
+func (c *SchedulingController) SoftDeleteVenueUnavailability(ctx *gin.Context) {
+	id := ctx.Param("id")
+	unavailabilityID := ctx.Param("unavailabilityId")
+	if id == "" || unavailabilityID == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Venue ID and unavailability ID are required"),
+		))
+		return
+	}
+
+	res, err := c.SchedulingUseCase.SoftDeleteVenueUnavailability(ctx, &model.VenueUnavailabilityRequestSoftDelete{ID: unavailabilityID, VenueID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to delete venue unavailability %s: %v", unavailabilityID, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Venue unavailability soft deleted successfully"))
+}
+
+func (c *SchedulingController) GetSeasonConflicts(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Season ID is required"),
+		))
+		return
+	}
+
+	res, err := c.SchedulingUseCase.GetSeasonConflicts(ctx, &model.SeasonRequestFindByID{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to get scheduling conflicts for season %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Scheduling conflicts retrieved successfully"))
+}
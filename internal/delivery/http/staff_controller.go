@@ -0,0 +1,148 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type StaffController struct {
+	StaffUseCase usecase.StaffUseCase
+	Log          *logrus.Logger
+}
+
+func NewStaffController(staffUseCase usecase.StaffUseCase, log *logrus.Logger) *StaffController {
+	return &StaffController{
+		StaffUseCase: staffUseCase,
+		Log:          log,
+	}
+}
+
+func (c *StaffController) FindByTeamID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	res, err := c.StaffUseCase.FindByTeamID(ctx, &model.StaffRequestFindByTeamID{TeamID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find staff for team %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Staff found"))
+}
+
+func (c *StaffController) Create(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID is required"),
+		))
+		return
+	}
+
+	var req model.StaffRequestCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.TeamID = id
+
+	res, err := c.StaffUseCase.Create(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to create staff member for team %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Staff member created successfully"))
+}
+
+func (c *StaffController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	staffID := ctx.Param("staffId")
+	if id == "" || staffID == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID and staff ID are required"),
+		))
+		return
+	}
+
+	var req model.StaffRequestUpdate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	req.ID = staffID
+	req.TeamID = id
+
+	res, err := c.StaffUseCase.Update(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to update staff member with ID %s: %v", staffID, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Staff member updated successfully"))
+}
+
+func (c *StaffController) SoftDelete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	staffID := ctx.Param("staffId")
+	if id == "" || staffID == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Team ID and staff ID are required"),
+		))
+		return
+	}
+
+	res, err := c.StaffUseCase.SoftDelete(ctx, &model.StaffRequestSoftDelete{ID: staffID, TeamID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to soft delete staff member with ID %s: %v", staffID, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Staff member soft deleted successfully"))
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type SuspensionsController struct {
+	SuspensionsUseCase usecase.SuspensionsUseCase
+	Log                *logrus.Logger
+}
+
+func NewSuspensionsController(suspensionsUseCase usecase.SuspensionsUseCase, log *logrus.Logger) *SuspensionsController {
+	return &SuspensionsController{
+		SuspensionsUseCase: suspensionsUseCase,
+		Log:                log,
+	}
+}
+
+func (c *SuspensionsController) FindByPlayerID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Player ID is required"),
+		))
+		return
+	}
+
+	res, err := c.SuspensionsUseCase.FindByPlayerID(ctx, &model.SuspensionRequestFindByPlayerID{PlayerID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to find suspensions for player %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Suspensions found"))
+}
+
+func (c *SuspensionsController) Create(ctx *gin.Context) {
+	var req model.SuspensionRequestCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Invalid JSON format"),
+		))
+		return
+	}
+
+	res, err := c.SuspensionsUseCase.Create(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to create suspension: %v", err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, model.NewSuccessResponse(res, "Suspension created successfully"))
+}
+
+func (c *SuspensionsController) SoftDelete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Suspension ID is required"),
+		))
+		return
+	}
+
+	res, err := c.SuspensionsUseCase.SoftDelete(ctx, &model.SuspensionRequestSoftDelete{ID: id})
+	if err != nil {
+		c.Log.Errorf("Failed to soft delete suspension with ID %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Suspension soft deleted successfully"))
+}
+
+func (c *SuspensionsController) GetAvailability(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, common.NewStandardErrorResponse(
+			common.ErrInvalidInput("Player ID is required"),
+		))
+		return
+	}
+
+	req := model.AvailabilityRequest{
+		PlayerID:      id,
+		Date:          ctx.Query("date"),
+		CompetitionID: ctx.Query("competition_id"),
+	}
+
+	res, err := c.SuspensionsUseCase.GetAvailability(ctx, &req)
+	if err != nil {
+		c.Log.Errorf("Failed to get availability for player %s: %v", id, err)
+		if appErr, ok := common.IsAppError(err); ok {
+			ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		} else {
+			ctx.JSON(http.StatusInternalServerError, common.NewStandardErrorResponse(
+				common.ErrInternalServer("Unknown error"),
+			))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.NewSuccessResponse(res, "Player availability retrieved successfully"))
+}
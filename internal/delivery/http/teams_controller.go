@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/Fadlihardiyanto/football-api/internal/common"
 	"github.com/Fadlihardiyanto/football-api/internal/model"
@@ -26,8 +27,32 @@ func NewTeamsController(teamsUseCase usecase.TeamsUseCase, log *logrus.Logger) *
 	}
 }
 
+// hasInclude reports whether the comma-separated include query lists name.
+func hasInclude(ctx *gin.Context, name string) bool {
+	for _, include := range strings.Split(ctx.Query("include"), ",") {
+		if strings.TrimSpace(include) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FindAll responds with JSON, or streams a CSV or XLSX export when one is
+// asked for through the format parameter or the Accept header.
 func (c *TeamsController) FindAll(ctx *gin.Context) {
-	teams, err := c.TeamsUseCase.FindAll(ctx)
+	format, appErr := exportFormat(ctx)
+	if appErr != nil {
+		ctx.JSON(appErr.HTTPCode, common.NewStandardErrorResponse(appErr))
+		return
+	}
+	if format != "" {
+		writeExport(ctx, c.Log, "teams", format, func(w common.ExportWriter) error {
+			return c.TeamsUseCase.Export(ctx, w)
+		})
+		return
+	}
+
+	teams, err := c.TeamsUseCase.FindAll(ctx, &model.TeamRequestFindAll{IncludeStaff: hasInclude(ctx, "staff")})
 	if err != nil {
 		c.Log.Errorf("Failed to find all teams: %v", err)
 		if appErr, ok := common.IsAppError(err); ok {
@@ -52,7 +77,7 @@ func (c *TeamsController) FindByID(ctx *gin.Context) {
 		return
 	}
 
-	team, err := c.TeamsUseCase.FindByID(ctx, &model.TeamRequestFindByID{ID: id})
+	team, err := c.TeamsUseCase.FindByID(ctx, &model.TeamRequestFindByID{ID: id, IncludeStaff: hasInclude(ctx, "staff")})
 	if err != nil {
 		c.Log.Errorf("Failed to find team by ID %s: %v", id, err)
 		if appErr, ok := common.IsAppError(err); ok {
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+)
+
+type Competition struct {
+	ID                    string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name                  string     `gorm:"column:name;size:255;not null"`
+	AgeCategory           string     `gorm:"column:age_category;size:20"`
+	EligibleBornOnOrAfter *time.Time `gorm:"column:eligible_born_on_or_after;type:date"`
+	SeasonID              *string    `gorm:"column:season_id;type:uuid"`
+	CreatedAt             time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt             time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt             *time.Time `gorm:"column:deleted_at"`
+	Teams                 []Team     `gorm:"many2many:competition_teams;joinForeignKey:CompetitionID;joinReferences:TeamID"`
+	Season                *Season    `gorm:"foreignKey:SeasonID;references:ID"`
+}
+
+type CompetitionTeam struct {
+	CompetitionID string    `gorm:"column:competition_id;primaryKey;type:uuid"`
+	TeamID        string    `gorm:"column:team_id;primaryKey;type:uuid"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+}
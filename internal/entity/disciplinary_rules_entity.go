@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+)
+
+// CompetitionDisciplinaryRule has no gorm defaults so that a rule of zero is
+// stored as zero; defaultDisciplinaryRule holds the defaults.
+type CompetitionDisciplinaryRule struct {
+	CompetitionID            string    `gorm:"column:competition_id;primaryKey;type:uuid"`
+	YellowCardsPerBan        int       `gorm:"column:yellow_cards_per_ban;not null"`
+	YellowAccumulationBan    int       `gorm:"column:yellow_accumulation_ban;not null"`
+	StraightRedBan           int       `gorm:"column:straight_red_ban;not null"`
+	SecondYellowBan          int       `gorm:"column:second_yellow_ban;not null"`
+	YellowResetAfterMatchday *int      `gorm:"column:yellow_reset_after_matchday"`
+	YellowCardPoints         int       `gorm:"column:yellow_card_points;not null"`
+	RedCardPoints            int       `gorm:"column:red_card_points;not null"`
+	CreatedAt                time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt                time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+)
+
+type PlayerInjury struct {
+	ID             string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	PlayerID       string     `gorm:"column:player_id;type:uuid;not null"`
+	InjuryType     string     `gorm:"column:injury_type;size:100;not null"`
+	StartDate      time.Time  `gorm:"column:start_date;type:date;not null"`
+	ExpectedReturn *time.Time `gorm:"column:expected_return;type:date"`
+	Notes          string     `gorm:"column:notes;type:text"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt      *time.Time `gorm:"column:deleted_at"`
+	Player         *Player    `gorm:"foreignKey:PlayerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+)
+
+type MatchLineup struct {
+	ID            string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	MatchID       string     `gorm:"column:match_id;type:uuid;not null"`
+	TeamID        string     `gorm:"column:team_id;type:uuid;not null"`
+	PlayerID      string     `gorm:"column:player_id;type:uuid;not null"`
+	IsStarter     bool       `gorm:"column:is_starter;not null;default:true"`
+	MinutesPlayed *int16     `gorm:"column:minutes_played;type:smallint"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt     *time.Time `gorm:"column:deleted_at"`
+	Player        *Player    `gorm:"foreignKey:PlayerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MatchPrediction is the latest prediction made for a match. It is kept once
+// the match is played so predictions can be checked against results.
+type MatchPrediction struct {
+	ID                string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	MatchID           string     `gorm:"column:match_id;type:uuid;not null;uniqueIndex"`
+	HomeExpectedGoals float64    `gorm:"column:home_expected_goals;not null"`
+	AwayExpectedGoals float64    `gorm:"column:away_expected_goals;not null"`
+	HomeWin           float64    `gorm:"column:home_win;not null"`
+	Draw              float64    `gorm:"column:draw;not null"`
+	AwayWin           float64    `gorm:"column:away_win;not null"`
+	Scorelines        Scorelines `gorm:"column:scorelines;type:jsonb;not null"`
+	TrainingMatches   int        `gorm:"column:training_matches;not null"`
+	CreatedAt         time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt         time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	Match             *Match     `gorm:"foreignKey:MatchID;references:ID"`
+}
+
+type Scoreline struct {
+	Home        int     `json:"home"`
+	Away        int     `json:"away"`
+	Probability float64 `json:"probability"`
+}
+
+// Scorelines is stored as a JSON array.
+type Scorelines []Scoreline
+
+func (s *Scorelines) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	}
+	return fmt.Errorf("cannot scan %T into Scorelines", value)
+}
+
+func (s Scorelines) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
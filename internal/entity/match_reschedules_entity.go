@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+)
+
+// MatchReschedule records one change of a match's kickoff. A postponement
+// starts without a new kickoff until the match is rescheduled.
+type MatchReschedule struct {
+	ID            string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	MatchID       string     `gorm:"column:match_id;type:uuid;not null"`
+	Reason        string     `gorm:"column:reason;size:255;not null"`
+	OriginalDate  time.Time  `gorm:"column:original_date;type:date;not null"`
+	OriginalTime  string     `gorm:"column:original_time;type:time;not null"`
+	NewDate       *time.Time `gorm:"column:new_date;type:date"`
+	NewTime       *string    `gorm:"column:new_time;type:time"`
+	PostponedAt   *time.Time `gorm:"column:postponed_at"`
+	RescheduledAt *time.Time `gorm:"column:rescheduled_at"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -4,18 +4,31 @@ import (
 	"time"
 )
 
+// Match keeps its kickoff as KickoffAt, the single stored instant, with the
+// Timezone of the venue it is played at. MatchDate and MatchTime are
+// deprecated: they hold the wall clock of KickoffAt in Timezone, are derived
+// whenever the kickoff is set and remain only for date-based queries.
 type Match struct {
-	ID         string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
-	MatchDate  time.Time  `gorm:"column:match_date;type:date;not null"`
-	MatchTime  string     `gorm:"column:match_time;type:time;not null"`
-	HomeTeamID string     `gorm:"column:home_team_id;type:uuid;not null"`
-	AwayTeamID string     `gorm:"column:away_team_id;type:uuid;not null"`
-	HomeScore  *int       `gorm:"column:home_score"`
-	AwayScore  *int       `gorm:"column:away_score"`
-	Status     string     `gorm:"column:status;type:varchar(20);default:scheduled"`
-	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt  time.Time  `gorm:"column:updated_at;autoUpdateTime"`
-	DeletedAt  *time.Time `gorm:"column:deleted_at"`
-	HomeTeam   Team       `gorm:"foreignKey:HomeTeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	AwayTeam   Team       `gorm:"foreignKey:AwayTeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ID            string            `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	MatchDate     time.Time         `gorm:"column:match_date;type:date;not null"`
+	MatchTime     string            `gorm:"column:match_time;type:time;not null"`
+	KickoffAt     time.Time         `gorm:"column:kickoff_at;type:timestamptz;not null"`
+	Timezone      string            `gorm:"column:timezone;size:64;not null;default:Asia/Jakarta"`
+	HomeTeamID    string            `gorm:"column:home_team_id;type:uuid;not null"`
+	AwayTeamID    string            `gorm:"column:away_team_id;type:uuid;not null"`
+	HomeScore     *int              `gorm:"column:home_score"`
+	AwayScore     *int              `gorm:"column:away_score"`
+	Status        string            `gorm:"column:status;type:varchar(20);default:scheduled"`
+	CompetitionID *string           `gorm:"column:competition_id;type:uuid"`
+	Matchday      *int              `gorm:"column:matchday"`
+	VenueID       *string           `gorm:"column:venue_id;type:uuid"`
+	Attendance    *int              `gorm:"column:attendance"`
+	CreatedAt     time.Time         `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time         `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt     *time.Time        `gorm:"column:deleted_at"`
+	HomeTeam      Team              `gorm:"foreignKey:HomeTeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	AwayTeam      Team              `gorm:"foreignKey:AwayTeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Competition   *Competition      `gorm:"foreignKey:CompetitionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Venue         *Venue            `gorm:"foreignKey:VenueID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Reschedules   []MatchReschedule `gorm:"foreignKey:MatchID;references:ID"`
 }
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+)
+
+type Official struct {
+	ID          string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name        string     `gorm:"column:name;size:255;not null"`
+	Nationality string     `gorm:"column:nationality;size:100"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt   *time.Time `gorm:"column:deleted_at"`
+	Teams       []Team     `gorm:"many2many:official_affiliations;joinForeignKey:OfficialID;joinReferences:TeamID"`
+}
+
+type OfficialAffiliation struct {
+	OfficialID string    `gorm:"column:official_id;primaryKey;type:uuid"`
+	TeamID     string    `gorm:"column:team_id;primaryKey;type:uuid"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+type MatchOfficial struct {
+	ID         string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	MatchID    string     `gorm:"column:match_id;type:uuid;not null"`
+	OfficialID string     `gorm:"column:official_id;type:uuid;not null"`
+	Role       string     `gorm:"column:role;size:30;not null"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt  *time.Time `gorm:"column:deleted_at"`
+	Match      *Match     `gorm:"foreignKey:MatchID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Official   *Official  `gorm:"foreignKey:OfficialID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
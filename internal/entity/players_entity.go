@@ -12,6 +12,7 @@ type Player struct {
 	Weight       float64    `gorm:"column:weight;type:decimal(5,2);not null"`
 	Position     string     `gorm:"column:position;type:varchar(20);not null;check:position IN ('penyerang','gelandang','bertahan','penjaga_gawang')"`
 	JerseyNumber int        `gorm:"column:jersey_number;not null"`
+	DateOfBirth  *time.Time `gorm:"column:date_of_birth;type:date"`
 	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime"`
 	DeletedAt    *time.Time `gorm:"column:deleted_at"`
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	RecordHatTrick      = "hat_trick"
+	RecordGoalMilestone = "goal_milestone"
+	RecordBiggestWin    = "biggest_win"
+	RecordUnbeatenRun   = "unbeaten_run"
+	RecordFastestGoal   = "fastest_goal"
+)
+
+// Record is a milestone detected while goals and results are recorded. Key
+// identifies the achievement so that detecting it again updates the record,
+// e.g. a hat-trick turning into a four-goal haul.
+type Record struct {
+	ID            string        `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	Type          string        `gorm:"column:type;type:varchar(30);not null"`
+	Key           string        `gorm:"column:record_key;type:varchar(255);not null;uniqueIndex"`
+	CompetitionID *string       `gorm:"column:competition_id;type:uuid"`
+	TeamID        *string       `gorm:"column:team_id;type:uuid"`
+	PlayerID      *string       `gorm:"column:player_id;type:uuid"`
+	MatchID       *string       `gorm:"column:match_id;type:uuid"`
+	GoalID        *string       `gorm:"column:goal_id;type:uuid"`
+	Value         int           `gorm:"column:value;not null"`
+	Description   string        `gorm:"column:description;type:text;not null"`
+	Context       RecordContext `gorm:"column:context;type:jsonb;not null"`
+	AchievedAt    time.Time     `gorm:"column:achieved_at;type:timestamptz;not null"`
+	CreatedAt     time.Time     `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time     `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt     *time.Time    `gorm:"column:deleted_at"`
+	Competition   *Competition  `gorm:"foreignKey:CompetitionID;references:ID"`
+	Team          *Team         `gorm:"foreignKey:TeamID;references:ID"`
+	Player        *Player       `gorm:"foreignKey:PlayerID;references:ID"`
+}
+
+// RecordContext holds the details behind a record, stored as a JSON object.
+type RecordContext map[string]interface{}
+
+func (c *RecordContext) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	}
+	return fmt.Errorf("cannot scan %T into RecordContext", value)
+}
+
+func (c RecordContext) Value() (driver.Value, error) {
+	if c == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
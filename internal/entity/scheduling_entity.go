@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+)
+
+type CompetitionSchedulingRule struct {
+	CompetitionID string    `gorm:"column:competition_id;primaryKey;type:uuid"`
+	MinRestHours  int       `gorm:"column:min_rest_hours;not null"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+type CompetitionBlackoutDate struct {
+	CompetitionID string    `gorm:"column:competition_id;primaryKey;type:uuid"`
+	BlackoutDate  time.Time `gorm:"column:blackout_date;primaryKey;type:date"`
+	Reason        string    `gorm:"column:reason;size:255"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+type VenueUnavailability struct {
+	ID            string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	VenueID       string     `gorm:"column:venue_id;type:uuid;not null"`
+	CompetitionID *string    `gorm:"column:competition_id;type:uuid"`
+	StartDate     time.Time  `gorm:"column:start_date;type:date;not null"`
+	EndDate       time.Time  `gorm:"column:end_date;type:date;not null"`
+	Reason        string     `gorm:"column:reason;size:255"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt     *time.Time `gorm:"column:deleted_at"`
+}
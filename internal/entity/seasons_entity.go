@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"time"
+)
+
+type Season struct {
+	ID           string        `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name         string        `gorm:"column:name;size:100;not null"`
+	StartDate    time.Time     `gorm:"column:start_date;type:date;not null"`
+	EndDate      time.Time     `gorm:"column:end_date;type:date;not null"`
+	CreatedAt    time.Time     `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt    time.Time     `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt    *time.Time    `gorm:"column:deleted_at"`
+	Competitions []Competition `gorm:"foreignKey:SeasonID;references:ID"`
+}
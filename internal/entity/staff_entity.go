@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+)
+
+type StaffMember struct {
+	ID          string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	TeamID      string     `gorm:"column:team_id;type:uuid;not null"`
+	Name        string     `gorm:"column:name;size:255;not null"`
+	Role        string     `gorm:"column:role;size:30;not null"`
+	Nationality string     `gorm:"column:nationality;size:100"`
+	StartDate   time.Time  `gorm:"column:start_date;type:date;not null"`
+	EndDate     *time.Time `gorm:"column:end_date;type:date"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt   *time.Time `gorm:"column:deleted_at"`
+	Team        *Team      `gorm:"foreignKey:TeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+)
+
+type PlayerSuspension struct {
+	ID               string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	PlayerID         string     `gorm:"column:player_id;type:uuid;not null"`
+	CompetitionID    *string    `gorm:"column:competition_id;type:uuid"`
+	MatchID          *string    `gorm:"column:match_id;type:uuid"`
+	Reason           string     `gorm:"column:reason;size:255;not null"`
+	MatchesRemaining int        `gorm:"column:matches_remaining;not null"`
+	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt        *time.Time `gorm:"column:deleted_at"`
+	Player           *Player    `gorm:"foreignKey:PlayerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+)
+
+// TeamRating is a team's rating change from one finished match.
+type TeamRating struct {
+	ID            string    `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	TeamID        string    `gorm:"column:team_id;type:uuid;not null"`
+	MatchID       string    `gorm:"column:match_id;type:uuid;not null"`
+	OpponentID    string    `gorm:"column:opponent_id;type:uuid;not null"`
+	RatingBefore  float64   `gorm:"column:rating_before;not null"`
+	RatingAfter   float64   `gorm:"column:rating_after;not null"`
+	ExpectedScore float64   `gorm:"column:expected_score;not null"`
+	PlayedAt      time.Time `gorm:"column:played_at;type:timestamptz;not null"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+	Opponent      *Team     `gorm:"foreignKey:OpponentID;references:ID"`
+}
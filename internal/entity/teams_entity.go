@@ -5,14 +5,19 @@ import (
 )
 
 type Team struct {
-	ID                  string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
-	Name                string     `gorm:"column:name;size:255;not null"`
-	Logo                string     `gorm:"column:logo;size:255"`
-	FoundedYear         int        `gorm:"column:founded_year;not null"`
-	HeadquartersAddress string     `gorm:"column:headquarters_address;type:text;not null"`
-	HeadquartersCity    string     `gorm:"column:headquarters_city;size:100;not null"`
-	CreatedAt           time.Time  `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt           time.Time  `gorm:"column:updated_at;autoUpdateTime"`
-	DeletedAt           *time.Time `gorm:"column:deleted_at"`
-	Players             []Player   `gorm:"foreignKey:TeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ID                  string        `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name                string        `gorm:"column:name;size:255;not null"`
+	Logo                string        `gorm:"column:logo;size:255"`
+	FoundedYear         int           `gorm:"column:founded_year;not null"`
+	HeadquartersAddress string        `gorm:"column:headquarters_address;type:text;not null"`
+	HeadquartersCity    string        `gorm:"column:headquarters_city;size:100;not null"`
+	HomeVenueID         *string       `gorm:"column:home_venue_id;type:uuid"`
+	Rating              *float64      `gorm:"column:rating"`
+	Placeholder         bool          `gorm:"column:placeholder;not null"`
+	CreatedAt           time.Time     `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt           time.Time     `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt           *time.Time    `gorm:"column:deleted_at"`
+	Players             []Player      `gorm:"foreignKey:TeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Staff               []StaffMember `gorm:"foreignKey:TeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	HomeVenue           *Venue        `gorm:"foreignKey:HomeVenueID;references:ID"`
 }
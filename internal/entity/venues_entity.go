@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+)
+
+type Venue struct {
+	ID          string     `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name        string     `gorm:"column:name;size:255;not null"`
+	City        string     `gorm:"column:city;size:100;not null"`
+	Capacity    int        `gorm:"column:capacity;not null"`
+	SurfaceType string     `gorm:"column:surface_type;size:20;not null"`
+	Latitude    *float64   `gorm:"column:latitude;type:numeric(9,6)"`
+	Longitude   *float64   `gorm:"column:longitude;type:numeric(9,6)"`
+	Timezone    string     `gorm:"column:timezone;size:64;not null;default:Asia/Jakarta"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	DeletedAt   *time.Time `gorm:"column:deleted_at"`
+}
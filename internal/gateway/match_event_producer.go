@@ -0,0 +1,21 @@
+package messaging
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sirupsen/logrus"
+)
+
+type MatchEventProducer struct {
+	Producer[*model.MatchEvent]
+}
+
+func NewMatchEventProducer(producer *kafka.Producer, log *logrus.Logger) *MatchEventProducer {
+	return &MatchEventProducer{
+		Producer: Producer[*model.MatchEvent]{
+			Producer: producer,
+			Topic:    "match-event",
+			Log:      log,
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package messaging
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sirupsen/logrus"
+)
+
+type RecordEventProducer struct {
+	Producer[*model.RecordEvent]
+}
+
+func NewRecordEventProducer(producer *kafka.Producer, log *logrus.Logger) *RecordEventProducer {
+	return &RecordEventProducer{
+		Producer: Producer[*model.RecordEvent]{
+			Producer: producer,
+			Topic:    "record-event",
+			Log:      log,
+		},
+	}
+}
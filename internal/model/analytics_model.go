@@ -0,0 +1,107 @@
+package model
+
+type GoalTimingResponse struct {
+	Team     TeamShort           `json:"team"`
+	SeasonID *string             `json:"season_id"`
+	Overall  GoalTimingBreakdown `json:"overall"`
+	Seasons  []SeasonGoalTiming  `json:"seasons"`
+}
+
+// SeasonGoalTiming is the breakdown of one season. Matches outside a
+// competition have no season.
+type SeasonGoalTiming struct {
+	SeasonID   *string             `json:"season_id"`
+	SeasonName *string             `json:"season_name"`
+	Breakdown  GoalTimingBreakdown `json:"breakdown"`
+}
+
+// GoalTimingBreakdown counts the goals scored and conceded in 15-minute
+// buckets, by half and in stoppage time, with how matches went after the
+// first goal. Matches counts the matches with at least one recorded goal.
+type GoalTimingBreakdown struct {
+	Matches      int                `json:"matches"`
+	Buckets      []GoalTimingBucket `json:"buckets"`
+	Halves       []GoalTimingBucket `json:"halves"`
+	StoppageTime []GoalTimingBucket `json:"stoppage_time"`
+	FirstGoal    FirstGoalStats     `json:"first_goal"`
+	Comebacks    ComebackStats      `json:"comebacks"`
+}
+
+type GoalTimingBucket struct {
+	Period   string `json:"period"`
+	Scored   int    `json:"scored"`
+	Conceded int    `json:"conceded"`
+}
+
+type FirstGoalStats struct {
+	ScoredFirst          int     `json:"scored_first"`
+	ScoredFirstWins      int     `json:"scored_first_wins"`
+	ScoredFirstDraws     int     `json:"scored_first_draws"`
+	ScoredFirstLosses    int     `json:"scored_first_losses"`
+	ScoredFirstWinRate   float64 `json:"scored_first_win_rate"`
+	ConcededFirst        int     `json:"conceded_first"`
+	ConcededFirstWins    int     `json:"conceded_first_wins"`
+	ConcededFirstDraws   int     `json:"conceded_first_draws"`
+	ConcededFirstLosses  int     `json:"conceded_first_losses"`
+	ConcededFirstWinRate float64 `json:"conceded_first_win_rate"`
+}
+
+// ComebackStats counts matches the team trailed in but did not lose, and
+// matches it led in but did not win.
+type ComebackStats struct {
+	Wins       int `json:"wins"`
+	Draws      int `json:"draws"`
+	LeadsLost  int `json:"leads_lost"`
+	LeadsDrawn int `json:"leads_drawn"`
+}
+
+type GoalTimingRequest struct {
+	TeamID   string `json:"team_id" validate:"required,uuid"`
+	SeasonID string `json:"season_id" validate:"omitempty,uuid"`
+}
+
+type TeamSeasonSummaryResponse struct {
+	Team                  TeamShort           `json:"team"`
+	SeasonID              string              `json:"season_id"`
+	SeasonName            string              `json:"season_name"`
+	Overall               SeasonRecord        `json:"overall"`
+	Home                  SeasonRecord        `json:"home"`
+	Away                  SeasonRecord        `json:"away"`
+	CleanSheets           int                 `json:"clean_sheets"`
+	FailedToScore         int                 `json:"failed_to_score"`
+	BiggestWin            *FormResult         `json:"biggest_win"`
+	BiggestLoss           *FormResult         `json:"biggest_loss"`
+	TopScorers            []SeasonScorer      `json:"top_scorers"`
+	MostUsedPlayers       []PlayerAppearances `json:"most_used_players"`
+	AverageAttendance     *float64            `json:"average_attendance"`
+	AverageHomeAttendance *float64            `json:"average_home_attendance"`
+}
+
+type SeasonRecord struct {
+	Played         int `json:"played"`
+	Wins           int `json:"wins"`
+	Draws          int `json:"draws"`
+	Losses         int `json:"losses"`
+	GoalsFor       int `json:"goals_for"`
+	GoalsAgainst   int `json:"goals_against"`
+	GoalDifference int `json:"goal_difference"`
+	Points         int `json:"points"`
+}
+
+type SeasonScorer struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Goals      int    `json:"goals"`
+}
+
+type PlayerAppearances struct {
+	PlayerID    string `json:"player_id"`
+	PlayerName  string `json:"player_name"`
+	Appearances int    `json:"appearances"`
+	Starts      int    `json:"starts"`
+}
+
+type TeamSeasonSummaryRequest struct {
+	TeamID   string `json:"team_id" validate:"required,uuid"`
+	SeasonID string `json:"season_id" validate:"required,uuid"`
+}
@@ -0,0 +1,129 @@
+package model
+
+import "time"
+
+// ArchiveVersion is the layout version written to archive manifests. Restore
+// refuses archives of any other version.
+const ArchiveVersion = 1
+
+// ArchiveExportRequest selects what an archive holds: one season, or the
+// whole database when SeasonID is empty.
+type ArchiveExportRequest struct {
+	SeasonID string `json:"season_id" validate:"omitempty,uuid"`
+}
+
+// ArchiveRestoreRequest carries a zip written by an archive export.
+type ArchiveRestoreRequest struct {
+	File []byte `json:"-" validate:"required"`
+}
+
+// ArchiveRestoreResponse counts the rows restored from each document and the
+// rows skipped because something they refer to is not in the archive.
+type ArchiveRestoreResponse struct {
+	Version  int            `json:"version"`
+	Season   *ArchiveSeason `json:"season,omitempty"`
+	Restored map[string]int `json:"restored"`
+	Skipped  map[string]int `json:"skipped"`
+	Logos    int            `json:"logos"`
+}
+
+// ArchiveManifest is the manifest.json document of an archive. Season is set
+// when the archive holds a single season.
+type ArchiveManifest struct {
+	Version   int            `json:"version"`
+	CreatedAt time.Time      `json:"created_at"`
+	Season    *ArchiveSeason `json:"season,omitempty"`
+	Counts    map[string]int `json:"counts"`
+	Logos     int            `json:"logos"`
+}
+
+// The archive documents keep the IDs of the exporting database so rows can
+// refer to each other; restore gives every row a new ID.
+
+type ArchiveSeason struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ArchiveCompetition struct {
+	ID                    string     `json:"id"`
+	Name                  string     `json:"name"`
+	AgeCategory           string     `json:"age_category"`
+	EligibleBornOnOrAfter *time.Time `json:"eligible_born_on_or_after"`
+	SeasonID              *string    `json:"season_id"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
+type ArchiveCompetitionTeam struct {
+	CompetitionID string `json:"competition_id"`
+	TeamID        string `json:"team_id"`
+}
+
+type ArchiveVenue struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	City        string    `json:"city"`
+	Capacity    int       `json:"capacity"`
+	SurfaceType string    `json:"surface_type"`
+	Latitude    *float64  `json:"latitude"`
+	Longitude   *float64  `json:"longitude"`
+	Timezone    string    `json:"timezone"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ArchiveTeam.Logo is the path of the logo file inside the archive when the
+// logo was uploaded, otherwise the stored value.
+type ArchiveTeam struct {
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	Logo                string    `json:"logo"`
+	FoundedYear         int       `json:"founded_year"`
+	HeadquartersAddress string    `json:"headquarters_address"`
+	HeadquartersCity    string    `json:"headquarters_city"`
+	HomeVenueID         *string   `json:"home_venue_id"`
+	Placeholder         bool      `json:"placeholder,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+type ArchivePlayer struct {
+	ID           string     `json:"id"`
+	TeamID       string     `json:"team_id"`
+	Name         string     `json:"name"`
+	Height       float64    `json:"height"`
+	Weight       float64    `json:"weight"`
+	Position     string     `json:"position"`
+	JerseyNumber int        `json:"jersey_number"`
+	DateOfBirth  *time.Time `json:"date_of_birth"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type ArchiveMatch struct {
+	ID            string    `json:"id"`
+	MatchDate     time.Time `json:"match_date"`
+	MatchTime     string    `json:"match_time"`
+	KickoffAt     time.Time `json:"kickoff_at"`
+	Timezone      string    `json:"timezone"`
+	HomeTeamID    string    `json:"home_team_id"`
+	AwayTeamID    string    `json:"away_team_id"`
+	HomeScore     *int      `json:"home_score"`
+	AwayScore     *int      `json:"away_score"`
+	Status        string    `json:"status"`
+	CompetitionID *string   `json:"competition_id"`
+	Matchday      *int      `json:"matchday"`
+	VenueID       *string   `json:"venue_id"`
+	Attendance    *int      `json:"attendance"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ArchiveGoal struct {
+	ID             string    `json:"id"`
+	MatchID        string    `json:"match_id"`
+	PlayerID       string    `json:"player_id"`
+	AssistPlayerID *string   `json:"assist_player_id"`
+	GoalTime       int16     `json:"goal_time"`
+	AddedTime      *int16    `json:"added_time"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package model
+
+type CalendarRequestTeamFixtures struct {
+	TeamID string `json:"team_id" validate:"required,uuid"`
+}
+
+type CalendarRequestSeasonFixtures struct {
+	SeasonID string `json:"season_id" validate:"required,uuid"`
+}
@@ -0,0 +1,32 @@
+package model
+
+type CardResponse struct {
+	ID        string          `json:"id"`
+	MatchID   string          `json:"match_id"`
+	PlayerID  string          `json:"player_id"`
+	CardType  string          `json:"card_type"`
+	Minute    int16           `json:"minute"`
+	CreatedAt string          `json:"created_at"`
+	UpdatedAt string          `json:"updated_at"`
+	DeletedAt *string         `json:"deleted_at,omitempty"`
+	Player    *PlayerResponse `json:"player,omitempty"`
+}
+
+type CardRequestCreate struct {
+	MatchID  string `json:"match_id" validate:"required,uuid"`
+	PlayerID string `json:"player_id" validate:"required,uuid"`
+	CardType string `json:"card_type" validate:"required,oneof=yellow red"`
+	Minute   int16  `json:"minute" validate:"min=0,max=120"`
+}
+
+type CardRequestFindByID struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type CardRequestFindByMatchID struct {
+	MatchID string `json:"match_id" validate:"required,uuid"`
+}
+
+type CardRequestSoftDelete struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
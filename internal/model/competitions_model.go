@@ -0,0 +1,51 @@
+package model
+
+type CompetitionResponse struct {
+	ID                    string          `json:"id"`
+	Name                  string          `json:"name"`
+	AgeCategory           string          `json:"age_category"`
+	EligibleBornOnOrAfter *string         `json:"eligible_born_on_or_after"`
+	SeasonID              *string         `json:"season_id"`
+	CreatedAt             string          `json:"created_at"`
+	UpdatedAt             string          `json:"updated_at"`
+	DeletedAt             *string         `json:"deleted_at,omitempty"`
+	Teams                 []*TeamResponse `json:"teams,omitempty"`
+}
+
+type CompetitionRequestCreate struct {
+	Name                  string `json:"name" validate:"required"`
+	AgeCategory           string `json:"age_category" validate:"omitempty,max=20"`
+	EligibleBornOnOrAfter string `json:"eligible_born_on_or_after" validate:"omitempty,datetime=2006-01-02"`
+	SeasonID              string `json:"season_id" validate:"omitempty,uuid"`
+}
+
+type CompetitionRequestUpdate struct {
+	ID                    string `json:"id" validate:"required,uuid"`
+	Name                  string `json:"name" validate:"omitempty"`
+	AgeCategory           string `json:"age_category" validate:"omitempty,max=20"`
+	EligibleBornOnOrAfter string `json:"eligible_born_on_or_after" validate:"omitempty,datetime=2006-01-02"`
+	SeasonID              string `json:"season_id" validate:"omitempty,uuid"`
+}
+
+type CompetitionRequestFindByID struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type CompetitionRequestSoftDelete struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type CompetitionRequestRegisterTeam struct {
+	ID     string `json:"id" validate:"required,uuid"`
+	TeamID string `json:"team_id" validate:"required,uuid"`
+}
+
+type IneligiblePlayerResponse struct {
+	PlayerID     string  `json:"player_id"`
+	PlayerName   string  `json:"player_name"`
+	TeamID       string  `json:"team_id"`
+	TeamName     string  `json:"team_name"`
+	JerseyNumber int     `json:"jersey_number"`
+	DateOfBirth  *string `json:"date_of_birth"`
+	Reason       string  `json:"reason"`
+}
@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+var goalTimingCSVHeader = []string{"scope", "season_id", "season_name", "group", "period", "scored", "conceded"}
+
+func writeGoalTimingRows(w *csv.Writer, scope, seasonID, seasonName string, breakdown model.GoalTimingBreakdown) {
+	groups := []struct {
+		name    string
+		buckets []model.GoalTimingBucket
+	}{
+		{"bucket", breakdown.Buckets},
+		{"half", breakdown.Halves},
+		{"stoppage_time", breakdown.StoppageTime},
+	}
+	for _, group := range groups {
+		for _, bucket := range group.buckets {
+			_ = w.Write([]string{scope, seasonID, seasonName, group.name, bucket.Period, strconv.Itoa(bucket.Scored), strconv.Itoa(bucket.Conceded)})
+		}
+	}
+}
+
+// ToGoalTimingCSV flattens the goal timing buckets into one row per period,
+// overall first and then per season.
+func ToGoalTimingCSV(response *model.GoalTimingResponse) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	_ = w.Write(goalTimingCSVHeader)
+
+	writeGoalTimingRows(w, "overall", "", "", response.Overall)
+	for _, season := range response.Seasons {
+		var id, name string
+		if season.SeasonID != nil {
+			id = *season.SeasonID
+		}
+		if season.SeasonName != nil {
+			name = *season.SeasonName
+		}
+		writeGoalTimingRows(w, "season", id, name, season.Breakdown)
+	}
+
+	w.Flush()
+	return b.String()
+}
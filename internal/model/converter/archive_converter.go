@@ -0,0 +1,199 @@
+package converter
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+// The To…FromArchive functions leave the ID empty so the database assigns a
+// new one, and copy references unchanged for the caller to remap.
+
+func ToArchiveSeason(season *entity.Season) model.ArchiveSeason {
+	return model.ArchiveSeason{
+		ID:        season.ID,
+		Name:      season.Name,
+		StartDate: season.StartDate,
+		EndDate:   season.EndDate,
+		CreatedAt: season.CreatedAt,
+	}
+}
+
+func ToSeasonFromArchive(season *model.ArchiveSeason) *entity.Season {
+	return &entity.Season{
+		Name:      season.Name,
+		StartDate: season.StartDate,
+		EndDate:   season.EndDate,
+		CreatedAt: season.CreatedAt,
+	}
+}
+
+func ToArchiveCompetition(competition *entity.Competition) model.ArchiveCompetition {
+	return model.ArchiveCompetition{
+		ID:                    competition.ID,
+		Name:                  competition.Name,
+		AgeCategory:           competition.AgeCategory,
+		EligibleBornOnOrAfter: competition.EligibleBornOnOrAfter,
+		SeasonID:              competition.SeasonID,
+		CreatedAt:             competition.CreatedAt,
+	}
+}
+
+func ToCompetitionFromArchive(competition *model.ArchiveCompetition) *entity.Competition {
+	return &entity.Competition{
+		Name:                  competition.Name,
+		AgeCategory:           competition.AgeCategory,
+		EligibleBornOnOrAfter: competition.EligibleBornOnOrAfter,
+		SeasonID:              competition.SeasonID,
+		CreatedAt:             competition.CreatedAt,
+	}
+}
+
+func ToArchiveCompetitionTeam(registration *entity.CompetitionTeam) model.ArchiveCompetitionTeam {
+	return model.ArchiveCompetitionTeam{
+		CompetitionID: registration.CompetitionID,
+		TeamID:        registration.TeamID,
+	}
+}
+
+func ToArchiveVenue(venue *entity.Venue) model.ArchiveVenue {
+	return model.ArchiveVenue{
+		ID:          venue.ID,
+		Name:        venue.Name,
+		City:        venue.City,
+		Capacity:    venue.Capacity,
+		SurfaceType: venue.SurfaceType,
+		Latitude:    venue.Latitude,
+		Longitude:   venue.Longitude,
+		Timezone:    venue.Timezone,
+		CreatedAt:   venue.CreatedAt,
+	}
+}
+
+func ToVenueFromArchive(venue *model.ArchiveVenue) *entity.Venue {
+	return &entity.Venue{
+		Name:        venue.Name,
+		City:        venue.City,
+		Capacity:    venue.Capacity,
+		SurfaceType: venue.SurfaceType,
+		Latitude:    venue.Latitude,
+		Longitude:   venue.Longitude,
+		Timezone:    venue.Timezone,
+		CreatedAt:   venue.CreatedAt,
+	}
+}
+
+func ToArchiveTeam(team *entity.Team) model.ArchiveTeam {
+	return model.ArchiveTeam{
+		ID:                  team.ID,
+		Name:                team.Name,
+		Logo:                team.Logo,
+		FoundedYear:         team.FoundedYear,
+		HeadquartersAddress: team.HeadquartersAddress,
+		HeadquartersCity:    team.HeadquartersCity,
+		HomeVenueID:         team.HomeVenueID,
+		Placeholder:         team.Placeholder,
+		CreatedAt:           team.CreatedAt,
+	}
+}
+
+func ToTeamFromArchive(team *model.ArchiveTeam) *entity.Team {
+	return &entity.Team{
+		Name:                team.Name,
+		Logo:                team.Logo,
+		FoundedYear:         team.FoundedYear,
+		HeadquartersAddress: team.HeadquartersAddress,
+		HeadquartersCity:    team.HeadquartersCity,
+		HomeVenueID:         team.HomeVenueID,
+		Placeholder:         team.Placeholder,
+		CreatedAt:           team.CreatedAt,
+	}
+}
+
+func ToArchivePlayer(player *entity.Player) model.ArchivePlayer {
+	return model.ArchivePlayer{
+		ID:           player.ID,
+		TeamID:       player.TeamID,
+		Name:         player.Name,
+		Height:       player.Height,
+		Weight:       player.Weight,
+		Position:     player.Position,
+		JerseyNumber: player.JerseyNumber,
+		DateOfBirth:  player.DateOfBirth,
+		CreatedAt:    player.CreatedAt,
+	}
+}
+
+func ToPlayerFromArchive(player *model.ArchivePlayer) *entity.Player {
+	return &entity.Player{
+		TeamID:       player.TeamID,
+		Name:         player.Name,
+		Height:       player.Height,
+		Weight:       player.Weight,
+		Position:     player.Position,
+		JerseyNumber: player.JerseyNumber,
+		DateOfBirth:  player.DateOfBirth,
+		CreatedAt:    player.CreatedAt,
+	}
+}
+
+func ToArchiveMatch(match *entity.Match) model.ArchiveMatch {
+	return model.ArchiveMatch{
+		ID:            match.ID,
+		MatchDate:     match.MatchDate,
+		MatchTime:     match.MatchTime,
+		KickoffAt:     match.KickoffAt,
+		Timezone:      match.Timezone,
+		HomeTeamID:    match.HomeTeamID,
+		AwayTeamID:    match.AwayTeamID,
+		HomeScore:     match.HomeScore,
+		AwayScore:     match.AwayScore,
+		Status:        match.Status,
+		CompetitionID: match.CompetitionID,
+		Matchday:      match.Matchday,
+		VenueID:       match.VenueID,
+		Attendance:    match.Attendance,
+		CreatedAt:     match.CreatedAt,
+	}
+}
+
+func ToMatchFromArchive(match *model.ArchiveMatch) *entity.Match {
+	return &entity.Match{
+		MatchDate:     match.MatchDate,
+		MatchTime:     match.MatchTime,
+		KickoffAt:     match.KickoffAt,
+		Timezone:      match.Timezone,
+		HomeTeamID:    match.HomeTeamID,
+		AwayTeamID:    match.AwayTeamID,
+		HomeScore:     match.HomeScore,
+		AwayScore:     match.AwayScore,
+		Status:        match.Status,
+		CompetitionID: match.CompetitionID,
+		Matchday:      match.Matchday,
+		VenueID:       match.VenueID,
+		Attendance:    match.Attendance,
+		CreatedAt:     match.CreatedAt,
+	}
+}
+
+func ToArchiveGoal(goal *entity.Goal) model.ArchiveGoal {
+	return model.ArchiveGoal{
+		ID:             goal.ID,
+		MatchID:        goal.MatchID,
+		PlayerID:       goal.PlayerID,
+		AssistPlayerID: goal.AssistPlayerID,
+		GoalTime:       goal.GoalTime,
+		AddedTime:      goal.AddedTime,
+		CreatedAt:      goal.CreatedAt,
+	}
+}
+
+func ToGoalFromArchive(goal *model.ArchiveGoal) *entity.Goal {
+	return &entity.Goal{
+		MatchID:        goal.MatchID,
+		PlayerID:       goal.PlayerID,
+		AssistPlayerID: goal.AssistPlayerID,
+		GoalTime:       goal.GoalTime,
+		AddedTime:      goal.AddedTime,
+		CreatedAt:      goal.CreatedAt,
+	}
+}
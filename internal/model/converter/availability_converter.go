@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToInjuryResponse(injury *entity.PlayerInjury) *model.InjuryResponse {
+	if injury == nil {
+		return nil
+	}
+
+	return &model.InjuryResponse{
+		ID:             injury.ID,
+		PlayerID:       injury.PlayerID,
+		InjuryType:     injury.InjuryType,
+		StartDate:      injury.StartDate.Format("2006-01-02"),
+		ExpectedReturn: common.ToDateStringPointer(injury.ExpectedReturn),
+		Notes:          injury.Notes,
+		CreatedAt:      injury.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      injury.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:      common.ToStringPointer(injury.DeletedAt),
+	}
+}
+
+func ToSuspensionResponse(suspension *entity.PlayerSuspension) *model.SuspensionResponse {
+	if suspension == nil {
+		return nil
+	}
+
+	return &model.SuspensionResponse{
+		ID:               suspension.ID,
+		PlayerID:         suspension.PlayerID,
+		CompetitionID:    suspension.CompetitionID,
+		MatchID:          suspension.MatchID,
+		Reason:           suspension.Reason,
+		MatchesRemaining: suspension.MatchesRemaining,
+		CreatedAt:        suspension.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        suspension.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:        common.ToStringPointer(suspension.DeletedAt),
+	}
+}
+
+func ToAvailabilityResponse(playerID string, date time.Time, reasons []string, injuries []entity.PlayerInjury, suspensions []entity.PlayerSuspension) *model.AvailabilityResponse {
+	response := &model.AvailabilityResponse{
+		PlayerID:    playerID,
+		Date:        date.Format("2006-01-02"),
+		Available:   len(reasons) == 0,
+		Reasons:     []string{},
+		Injuries:    []model.InjuryResponse{},
+		Suspensions: []model.SuspensionResponse{},
+	}
+	response.Reasons = append(response.Reasons, reasons...)
+	for _, injury := range injuries {
+		response.Injuries = append(response.Injuries, *ToInjuryResponse(&injury))
+	}
+	for _, suspension := range suspensions {
+		response.Suspensions = append(response.Suspensions, *ToSuspensionResponse(&suspension))
+	}
+
+	return response
+}
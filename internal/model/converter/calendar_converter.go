@@ -0,0 +1,138 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+)
+
+const (
+	icalTimeFormat = "20060102T150405Z"
+	// icalLineLimit is the longest content line allowed by RFC 5545, in octets.
+	icalLineLimit = 75
+	// matchDuration is the length of a calendar event for a match.
+	matchDuration = 2 * time.Hour
+)
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// ToFixturesCalendar renders the matches as an RFC 5545 calendar. Every match
+// keeps the same UID so calendar clients update events in place, and SEQUENCE
+// grows with each postponement, reschedule or cancellation.
+func ToFixturesCalendar(name string, matches []entity.Match) string {
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//Football API//Fixtures//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	writeICalLine(&b, "X-WR-CALNAME:"+icalTextEscaper.Replace(name))
+
+	for _, match := range matches {
+		writeICalEvent(&b, &match)
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+func writeICalEvent(b *strings.Builder, match *entity.Match) {
+	summary := fmt.Sprintf("%s vs %s", match.HomeTeam.Name, match.AwayTeam.Name)
+	if match.Status == "completed" && match.HomeScore != nil && match.AwayScore != nil {
+		summary = fmt.Sprintf("%s %d-%d %s", match.HomeTeam.Name, *match.HomeScore, *match.AwayScore, match.AwayTeam.Name)
+	}
+
+	writeICalLine(b, "BEGIN:VEVENT")
+	writeICalLine(b, fmt.Sprintf("UID:%s@football-api", match.ID))
+	writeICalLine(b, "DTSTAMP:"+match.UpdatedAt.UTC().Format(icalTimeFormat))
+	writeICalLine(b, "LAST-MODIFIED:"+match.UpdatedAt.UTC().Format(icalTimeFormat))
+	writeICalLine(b, "DTSTART:"+match.KickoffAt.UTC().Format(icalTimeFormat))
+	writeICalLine(b, "DTEND:"+match.KickoffAt.Add(matchDuration).UTC().Format(icalTimeFormat))
+	writeICalLine(b, fmt.Sprintf("SEQUENCE:%d", matchSequence(match)))
+	writeICalLine(b, "SUMMARY:"+icalTextEscaper.Replace(summary))
+	if location := matchLocation(match); location != "" {
+		writeICalLine(b, "LOCATION:"+icalTextEscaper.Replace(location))
+	}
+	if description := matchDescription(match); description != "" {
+		writeICalLine(b, "DESCRIPTION:"+icalTextEscaper.Replace(description))
+	}
+	writeICalLine(b, "STATUS:"+matchEventStatus(match.Status))
+	writeICalLine(b, "END:VEVENT")
+}
+
+// matchSequence counts the revisions of the match that calendar clients must
+// pick up: every postponement, every new kickoff and a cancellation.
+func matchSequence(match *entity.Match) int {
+	sequence := 0
+	for _, reschedule := range match.Reschedules {
+		if reschedule.PostponedAt != nil {
+			sequence++
+		}
+		if reschedule.RescheduledAt != nil {
+			sequence++
+		}
+	}
+	if match.Status == "canceled" || match.Status == "cancelled" {
+		sequence++
+	}
+	return sequence
+}
+
+// matchLocation is the match venue, falling back to the home team's venue.
+func matchLocation(match *entity.Match) string {
+	venue := match.Venue
+	if venue == nil {
+		venue = match.HomeTeam.HomeVenue
+	}
+	if venue == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s, %s", venue.Name, venue.City)
+}
+
+func matchDescription(match *entity.Match) string {
+	var parts []string
+	if match.Competition != nil {
+		parts = append(parts, match.Competition.Name)
+	}
+	if match.Matchday != nil {
+		parts = append(parts, fmt.Sprintf("Matchday %d", *match.Matchday))
+	}
+	if match.Status == "postponed" {
+		parts = append(parts, "Postponed")
+	}
+	return strings.Join(parts, " - ")
+}
+
+func matchEventStatus(status string) string {
+	switch status {
+	case "postponed":
+		return "TENTATIVE"
+	case "canceled", "cancelled":
+		return "CANCELLED"
+	default:
+		return "CONFIRMED"
+	}
+}
+
+// writeICalLine writes a content line, folding it at 75 octets without
+// splitting a UTF-8 character.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space
+		limit = icalLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,114 @@
+package converter
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+)
+
+func TestWriteICalLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "short line",
+			line: "SUMMARY:Persija vs Persib",
+			want: "SUMMARY:Persija vs Persib\r\n",
+		},
+		{
+			name: "exactly 75 octets",
+			line: strings.Repeat("a", 75),
+			want: strings.Repeat("a", 75) + "\r\n",
+		},
+		{
+			name: "76 octets",
+			line: strings.Repeat("a", 76),
+			want: strings.Repeat("a", 75) + "\r\n a\r\n",
+		},
+		{
+			name: "continuation lines hold 74 octets after the space",
+			line: strings.Repeat("a", 75+74+1),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n",
+		},
+		{
+			name: "character across the fold moves to the next line",
+			line: strings.Repeat("a", 74) + "é",
+			want: strings.Repeat("a", 74) + "\r\n é\r\n",
+		},
+		{
+			name: "three octet character across the fold",
+			line: strings.Repeat("a", 73) + "⚽⚽",
+			want: strings.Repeat("a", 73) + "\r\n ⚽⚽\r\n",
+		},
+		{
+			name: "long multibyte line",
+			line: "DESCRIPTION:" + strings.Repeat("Liga ⚽ ", 30),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			writeICalLine(&b, tt.line)
+			got := b.String()
+			if tt.want != "" && got != tt.want {
+				t.Errorf("writeICalLine() = %q, want %q", got, tt.want)
+			}
+
+			if !strings.HasSuffix(got, "\r\n") {
+				t.Fatalf("writeICalLine() = %q, want a CRLF ending", got)
+			}
+			physical := strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n")
+			for i, line := range physical {
+				if len(line) > icalLineLimit {
+					t.Errorf("line %d is %d octets, want at most %d", i+1, len(line), icalLineLimit)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a UTF-8 character: %q", i+1, line)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d does not start with a space: %q", i+1, line)
+				}
+			}
+			if unfolded := strings.ReplaceAll(strings.TrimSuffix(got, "\r\n"), "\r\n ", ""); unfolded != tt.line {
+				t.Errorf("unfolded line = %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestMatchSequence(t *testing.T) {
+	at := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+	postponed := entity.MatchReschedule{PostponedAt: &at}
+	rescheduled := entity.MatchReschedule{RescheduledAt: &at}
+	postponedThenRescheduled := entity.MatchReschedule{PostponedAt: &at, RescheduledAt: &at}
+
+	tests := []struct {
+		name  string
+		match entity.Match
+		want  int
+	}{
+		{"untouched", entity.Match{Status: "scheduled"}, 0},
+		{"completed", entity.Match{Status: "completed"}, 0},
+		{"postponed", entity.Match{Status: "postponed", Reschedules: []entity.MatchReschedule{postponed}}, 1},
+		{"moved without postponing", entity.Match{Status: "scheduled", Reschedules: []entity.MatchReschedule{rescheduled}}, 1},
+		{"postponed and rescheduled", entity.Match{Status: "scheduled", Reschedules: []entity.MatchReschedule{postponedThenRescheduled}}, 2},
+		{"rescheduled twice", entity.Match{Status: "scheduled", Reschedules: []entity.MatchReschedule{postponedThenRescheduled, rescheduled}}, 3},
+		{"canceled", entity.Match{Status: "canceled"}, 1},
+		{"cancelled spelling", entity.Match{Status: "cancelled"}, 1},
+		{"postponed then canceled", entity.Match{Status: "canceled", Reschedules: []entity.MatchReschedule{postponed}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchSequence(&tt.match); got != tt.want {
+				t.Errorf("matchSequence() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
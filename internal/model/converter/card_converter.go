@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToCardResponse(card *entity.MatchCard) *model.CardResponse {
+	if card == nil {
+		return nil
+	}
+
+	return &model.CardResponse{
+		ID:        card.ID,
+		MatchID:   card.MatchID,
+		PlayerID:  card.PlayerID,
+		CardType:  card.CardType,
+		Minute:    card.Minute,
+		CreatedAt: card.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: card.UpdatedAt.Format(time.RFC3339),
+		DeletedAt: common.ToStringPointer(card.DeletedAt),
+		Player:    ToPlayerResponse(card.Player),
+	}
+}
@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToCompetitionResponse(competition *entity.Competition) *model.CompetitionResponse {
+	if competition == nil {
+		return nil
+	}
+
+	var teams []*model.TeamResponse
+	if competition.Teams != nil {
+		teams = make([]*model.TeamResponse, len(competition.Teams))
+		for i, team := range competition.Teams {
+			teams[i] = ToTeamResponse(&team)
+		}
+	}
+
+	return &model.CompetitionResponse{
+		ID:                    competition.ID,
+		Name:                  competition.Name,
+		AgeCategory:           competition.AgeCategory,
+		EligibleBornOnOrAfter: common.ToDateStringPointer(competition.EligibleBornOnOrAfter),
+		SeasonID:              competition.SeasonID,
+		CreatedAt:             competition.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:             competition.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:             common.ToStringPointer(competition.DeletedAt),
+		Teams:                 teams,
+	}
+}
+
+func ToIneligiblePlayerResponse(player *entity.Player, reason string) *model.IneligiblePlayerResponse {
+	if player == nil {
+		return nil
+	}
+
+	response := &model.IneligiblePlayerResponse{
+		PlayerID:     player.ID,
+		PlayerName:   player.Name,
+		TeamID:       player.TeamID,
+		JerseyNumber: player.JerseyNumber,
+		DateOfBirth:  common.ToDateStringPointer(player.DateOfBirth),
+		Reason:       reason,
+	}
+	if player.Team != nil {
+		response.TeamName = player.Team.Name
+	}
+
+	return response
+}
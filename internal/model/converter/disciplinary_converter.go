@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToDisciplinaryRuleResponse(rule *entity.CompetitionDisciplinaryRule) *model.DisciplinaryRuleResponse {
+	if rule == nil {
+		return nil
+	}
+
+	return &model.DisciplinaryRuleResponse{
+		CompetitionID:            rule.CompetitionID,
+		YellowCardsPerBan:        rule.YellowCardsPerBan,
+		YellowAccumulationBan:    rule.YellowAccumulationBan,
+		StraightRedBan:           rule.StraightRedBan,
+		SecondYellowBan:          rule.SecondYellowBan,
+		YellowResetAfterMatchday: rule.YellowResetAfterMatchday,
+		YellowCardPoints:         rule.YellowCardPoints,
+		RedCardPoints:            rule.RedCardPoints,
+	}
+}
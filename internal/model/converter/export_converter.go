@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+// Export headers and rows for the CSV and XLSX downloads. Each row follows
+// the column order of its header.
+
+var TeamExportHeader = []any{"id", "name", "founded_year", "headquarters_address", "headquarters_city", "home_venue_id", "logo", "created_at", "updated_at"}
+
+func ToTeamExportRow(team *model.TeamResponse) []any {
+	return []any{team.ID, team.Name, team.FoundedYear, team.HeadquartersAddress, team.HeadquartersCity, team.HomeVenueID, team.Logo, team.CreatedAt, team.UpdatedAt}
+}
+
+var PlayerExportHeader = []any{"id", "name", "team_id", "team_name", "position", "jersey_number", "height", "weight", "date_of_birth", "created_at", "updated_at"}
+
+func ToPlayerExportRow(player *model.PlayerResponse) []any {
+	var teamName string
+	if player.Team != nil {
+		teamName = player.Team.Name
+	}
+	return []any{player.ID, player.Name, player.TeamID, teamName, player.Position, player.JerseyNumber, player.Height, player.Weight, player.DateOfBirth, player.CreatedAt, player.UpdatedAt}
+}
+
+var MatchExportHeader = []any{"id", "match_date", "match_time", "kickoff_at", "timezone", "competition_id", "matchday", "home_team_id", "home_team", "away_team_id", "away_team", "home_score", "away_score", "status", "venue_id", "attendance"}
+
+func ToMatchExportRow(match *model.MatchResponse) []any {
+	var homeTeam, awayTeam string
+	if match.HomeTeam != nil {
+		homeTeam = match.HomeTeam.Name
+	}
+	if match.AwayTeam != nil {
+		awayTeam = match.AwayTeam.Name
+	}
+	return []any{match.ID, match.MatchDate, match.MatchTime, match.KickoffAt, match.Timezone, match.CompetitionID, match.Matchday, match.HomeTeamID, homeTeam, match.AwayTeamID, awayTeam, match.HomeScore, match.AwayScore, match.Status, match.VenueID, match.Attendance}
+}
+
+var GoalExportHeader = []any{"id", "match_id", "match_date", "home_team", "away_team", "player_id", "player_name", "assist_player_id", "goal_time", "added_time", "created_at"}
+
+func ToGoalExportRow(goal *model.GoalResponse) []any {
+	var matchDate, homeTeam, awayTeam, playerName string
+	if goal.Match != nil {
+		matchDate = goal.Match.MatchDate
+		if goal.Match.HomeTeam != nil {
+			homeTeam = goal.Match.HomeTeam.Name
+		}
+		if goal.Match.AwayTeam != nil {
+			awayTeam = goal.Match.AwayTeam.Name
+		}
+	}
+	if goal.Player != nil {
+		playerName = goal.Player.Name
+	}
+	return []any{goal.ID, goal.MatchID, matchDate, homeTeam, awayTeam, goal.PlayerID, playerName, goal.AssistPlayerID, goal.GoalTime, goal.AddedTime, goal.CreatedAt}
+}
+
+var StandingExportHeader = []any{"position", "team_id", "team_name", "played", "won", "drawn", "lost", "goals_for", "goals_against", "goal_difference", "points", "fair_play_points"}
+
+func ToStandingExportRow(standing *model.StandingResponse) []any {
+	return []any{standing.Position, standing.TeamID, standing.TeamName, standing.Played, standing.Won, standing.Drawn, standing.Lost, standing.GoalsFor, standing.GoalsAgainst, standing.GoalDifference, standing.Points, standing.FairPlayPoints}
+}
+
+var LeaderboardExportHeader = []any{"rank", "player_id", "player_name", "team_id", "team_name", "value"}
+
+func ToLeaderboardExportRow(entry *model.LeaderboardEntry) []any {
+	return []any{entry.Rank, entry.PlayerID, entry.PlayerName, entry.TeamID, entry.TeamName, entry.Value}
+}
@@ -14,14 +14,16 @@ func ToGoalResponse(goals *entity.Goal) *model.GoalResponse {
 	}
 
 	return &model.GoalResponse{
-		ID:        goals.ID,
-		MatchID:   goals.MatchID,
-		PlayerID:  goals.PlayerID,
-		GoalTime:  goals.GoalTime,
-		CreatedAt: goals.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: goals.UpdatedAt.Format(time.RFC3339),
-		DeletedAt: common.ToStringPointer(goals.DeletedAt),
-		Match:     ToMatchResponse(goals.Match),
-		Player:    ToPlayerResponse(goals.Player),
+		ID:             goals.ID,
+		MatchID:        goals.MatchID,
+		PlayerID:       goals.PlayerID,
+		AssistPlayerID: goals.AssistPlayerID,
+		GoalTime:       goals.GoalTime,
+		AddedTime:      goals.AddedTime,
+		CreatedAt:      goals.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      goals.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:      common.ToStringPointer(goals.DeletedAt),
+		Match:          ToMatchResponse(goals.Match, nil),
+		Player:         ToPlayerResponse(goals.Player),
 	}
 }
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToHeadToHeadMatches(matches []entity.Match, loc *time.Location) []model.HeadToHeadMatch {
+	responses := []model.HeadToHeadMatch{}
+	for _, match := range matches {
+		responses = append(responses, model.HeadToHeadMatch{
+			MatchID:       match.ID,
+			MatchDate:     localKickoff(&match, loc).Format("2006-01-02"),
+			CompetitionID: match.CompetitionID,
+			HomeTeam:      model.TeamShort{ID: match.HomeTeam.ID, Name: match.HomeTeam.Name},
+			AwayTeam:      model.TeamShort{ID: match.AwayTeam.ID, Name: match.AwayTeam.Name},
+			HomeScore:     *match.HomeScore,
+			AwayScore:     *match.AwayScore,
+		})
+	}
+	return responses
+}
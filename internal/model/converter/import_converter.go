@@ -0,0 +1,120 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+var (
+	TeamImportColumns   = []string{"name", "logo", "founded_year", "headquarters_address", "headquarters_city", "home_venue_id"}
+	PlayerImportColumns = []string{"name", "team_id", "team_name", "position", "jersey_number", "height", "weight", "date_of_birth"}
+)
+
+// ReadImportCSV reads a CSV file whose first line names the columns. Column
+// names are matched case-insensitively and must all be in columns.
+func ReadImportCSV(data []byte, columns []string) ([]model.ImportRecord, error) {
+	// spreadsheets often save CSV with a byte order mark
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	for i, name := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(columns, header[i]) {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+	}
+
+	var records []model.ImportRecord
+	for {
+		fields, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		values := make(map[string]string, len(header))
+		for i, field := range fields {
+			values[header[i]] = strings.TrimSpace(field)
+		}
+		records = append(records, model.ImportRecord{Line: line, Values: values})
+	}
+	return records, nil
+}
+
+// importInt parses an optional whole number column, leaving zero when it is
+// empty so validation reports it as missing.
+func importInt(values map[string]string, column string, errs *[]model.ImportRowError) int {
+	if values[column] == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(values[column])
+	if err != nil {
+		*errs = append(*errs, model.ImportRowError{Field: column, Message: fmt.Sprintf("%s must be a whole number", column)})
+	}
+	return n
+}
+
+func importFloat(values map[string]string, column string, errs *[]model.ImportRowError) float64 {
+	if values[column] == "" {
+		return 0
+	}
+	n, err := strconv.ParseFloat(values[column], 64)
+	if err != nil {
+		*errs = append(*errs, model.ImportRowError{Field: column, Message: fmt.Sprintf("%s must be a number", column)})
+	}
+	return n
+}
+
+// ToTeamImportRequest reads a team row. The errors are columns that could not
+// be parsed; the request still needs validating.
+func ToTeamImportRequest(record *model.ImportRecord) (*model.TeamRequestCreate, []model.ImportRowError) {
+	var errs []model.ImportRowError
+	request := &model.TeamRequestCreate{
+		Name:                record.Values["name"],
+		Logo:                record.Values["logo"],
+		FoundedYear:         importInt(record.Values, "founded_year", &errs),
+		HeadquartersAddress: record.Values["headquarters_address"],
+		HeadquartersCity:    record.Values["headquarters_city"],
+		HomeVenueID:         record.Values["home_venue_id"],
+	}
+	return request, errs
+}
+
+// ToPlayerImportRequest reads a player row. A team_name column is left for
+// the caller to resolve into TeamID.
+func ToPlayerImportRequest(record *model.ImportRecord) (*model.PlayerRequestCreate, []model.ImportRowError) {
+	var errs []model.ImportRowError
+	request := &model.PlayerRequestCreate{
+		Name:         record.Values["name"],
+		TeamID:       record.Values["team_id"],
+		Height:       importFloat(record.Values, "height", &errs),
+		Weight:       importFloat(record.Values, "weight", &errs),
+		Position:     record.Values["position"],
+		JerseyNumber: importInt(record.Values, "jersey_number", &errs),
+		DateOfBirth:  record.Values["date_of_birth"],
+	}
+	return request, errs
+}
+
+func ToImportRowErrors(details []common.ErrorDetail) []model.ImportRowError {
+	errs := make([]model.ImportRowError, len(details))
+	for i, detail := range details {
+		errs[i] = model.ImportRowError{Field: detail.Field, Message: detail.Message}
+	}
+	return errs
+}
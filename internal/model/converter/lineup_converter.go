@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToLineupResponse(lineup *entity.MatchLineup) *model.LineupResponse {
+	if lineup == nil {
+		return nil
+	}
+
+	return &model.LineupResponse{
+		ID:            lineup.ID,
+		MatchID:       lineup.MatchID,
+		TeamID:        lineup.TeamID,
+		PlayerID:      lineup.PlayerID,
+		IsStarter:     lineup.IsStarter,
+		MinutesPlayed: lineup.MinutesPlayed,
+		CreatedAt:     lineup.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     lineup.UpdatedAt.Format(time.RFC3339),
+		Player:        ToPlayerResponse(lineup.Player),
+	}
+}
@@ -0,0 +1,130 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+// narrativeLanguage holds the template and wording of one report language.
+type narrativeLanguage struct {
+	template *template.Template
+	months   [12]string
+	and      string
+}
+
+// narrativeData is what the narrative templates render.
+type narrativeData struct {
+	Home        string
+	Away        string
+	Status      string
+	Date        string
+	Played      bool
+	HomeScore   int
+	AwayScore   int
+	HalfTime    *model.ScoreReport
+	Comeback    *model.ComebackReport
+	HomeScorers []string
+	AwayScorers []string
+	HatTricks   []string
+	Braces      []string
+}
+
+var narrativeEN = newNarrativeLanguage("en", ""+
+	`{{if not .Played}}`+
+	`{{if eq .Status "postponed"}}{{.Home}} against {{.Away}}, scheduled for {{.Date}}, has been postponed.`+
+	`{{else if eq .Status "cancelled"}}{{.Home}} against {{.Away}}, scheduled for {{.Date}}, has been cancelled.`+
+	`{{else if eq .Status "completed"}}No result has been recorded for {{.Home}} against {{.Away}} on {{.Date}}.`+
+	`{{else}}{{.Home}} host {{.Away}} on {{.Date}}.{{end}}`+
+	`{{else}}`+
+	`{{if gt .HomeScore .AwayScore}}{{.Home}} beat {{.Away}} {{.HomeScore}}-{{.AwayScore}} on {{.Date}}.`+
+	`{{else if lt .HomeScore .AwayScore}}{{.Away}} won {{.AwayScore}}-{{.HomeScore}} away at {{.Home}} on {{.Date}}.`+
+	`{{else}}{{.Home}} and {{.Away}} drew {{.HomeScore}}-{{.AwayScore}} on {{.Date}}.{{end}}`+
+	`{{with .HalfTime}} It was {{.Home}}-{{.Away}} at half-time.{{end}}`+
+	`{{with .Comeback}} {{.Team.Name}} came back from {{.Deficit}} {{if eq .Deficit 1}}goal{{else}}goals{{end}} down to {{if eq .Result "win"}}win{{else}}draw{{end}}.{{end}}`+
+	`{{if .HomeScorers}} {{.Home}} scored through {{list .HomeScorers}}.{{end}}`+
+	`{{if .AwayScorers}} {{.Away}} scored through {{list .AwayScorers}}.{{end}}`+
+	`{{if .HatTricks}} {{list .HatTricks}} scored {{if eq (len .HatTricks) 1}}a hat-trick{{else}}hat-tricks{{end}}.{{end}}`+
+	`{{if .Braces}} {{list .Braces}} scored twice.{{end}}`+
+	`{{if and (eq .HomeScore 0) (eq .AwayScore 0)}} Neither side found the net.{{end}}`+
+	`{{end}}`,
+	[12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	"and",
+)
+
+var narrativeID = newNarrativeLanguage("id", ""+
+	`{{if not .Played}}`+
+	`{{if eq .Status "postponed"}}Pertandingan {{.Home}} melawan {{.Away}} yang dijadwalkan pada {{.Date}} ditunda.`+
+	`{{else if eq .Status "cancelled"}}Pertandingan {{.Home}} melawan {{.Away}} yang dijadwalkan pada {{.Date}} dibatalkan.`+
+	`{{else if eq .Status "completed"}}Hasil pertandingan {{.Home}} melawan {{.Away}} pada {{.Date}} belum dicatat.`+
+	`{{else}}{{.Home}} akan menjamu {{.Away}} pada {{.Date}}.{{end}}`+
+	`{{else}}`+
+	`{{if gt .HomeScore .AwayScore}}{{.Home}} mengalahkan {{.Away}} {{.HomeScore}}-{{.AwayScore}} pada {{.Date}}.`+
+	`{{else if lt .HomeScore .AwayScore}}{{.Away}} menang {{.AwayScore}}-{{.HomeScore}} di kandang {{.Home}} pada {{.Date}}.`+
+	`{{else}}{{.Home}} dan {{.Away}} bermain imbang {{.HomeScore}}-{{.AwayScore}} pada {{.Date}}.{{end}}`+
+	`{{with .HalfTime}} Skor babak pertama {{.Home}}-{{.Away}}.{{end}}`+
+	`{{with .Comeback}} {{.Team.Name}} bangkit dari ketertinggalan {{.Deficit}} gol untuk {{if eq .Result "win"}}meraih kemenangan{{else}}bermain imbang{{end}}.{{end}}`+
+	`{{if .HomeScorers}} Gol {{.Home}} dicetak oleh {{list .HomeScorers}}.{{end}}`+
+	`{{if .AwayScorers}} Gol {{.Away}} dicetak oleh {{list .AwayScorers}}.{{end}}`+
+	`{{if .HatTricks}} {{list .HatTricks}} mencetak hat-trick.{{end}}`+
+	`{{if .Braces}} {{list .Braces}} mencetak dua gol.{{end}}`+
+	`{{if and (eq .HomeScore 0) (eq .AwayScore 0)}} Tidak ada gol yang tercipta.{{end}}`+
+	`{{end}}`,
+	[12]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"},
+	"dan",
+)
+
+func newNarrativeLanguage(name, text string, months [12]string, and string) *narrativeLanguage {
+	language := &narrativeLanguage{months: months, and: and}
+	language.template = template.Must(template.New(name).Funcs(template.FuncMap{"list": language.list}).Parse(text))
+	return language
+}
+
+// list joins items as "a, b and c" in the language.
+func (l *narrativeLanguage) list(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " " + l.and + " " + items[len(items)-1]
+}
+
+func (l *narrativeLanguage) date(t time.Time) string {
+	return fmt.Sprintf("%d %s %d", t.Day(), l.months[t.Month()-1], t.Year())
+}
+
+// matchNarrative renders the report as a paragraph in the language.
+func matchNarrative(language *narrativeLanguage, report *model.MatchReportResponse, kickoff time.Time) string {
+	data := narrativeData{
+		Home:     report.HomeTeam.Name,
+		Away:     report.AwayTeam.Name,
+		Status:   report.Status,
+		Date:     language.date(kickoff),
+		Played:   report.HomeScore != nil && report.AwayScore != nil,
+		HalfTime: report.HalfTimeScore,
+		Comeback: report.Comeback,
+	}
+	if data.Played {
+		data.HomeScore, data.AwayScore = *report.HomeScore, *report.AwayScore
+	}
+	for _, scorer := range report.HomeGoals.Scorers {
+		data.HomeScorers = append(data.HomeScorers, fmt.Sprintf("%s (%s)", scorer.PlayerName, strings.Join(scorer.Minutes, ", ")))
+	}
+	for _, scorer := range report.AwayGoals.Scorers {
+		data.AwayScorers = append(data.AwayScorers, fmt.Sprintf("%s (%s)", scorer.PlayerName, strings.Join(scorer.Minutes, ", ")))
+	}
+	for _, scorer := range report.HatTricks {
+		data.HatTricks = append(data.HatTricks, scorer.PlayerName)
+	}
+	for _, scorer := range report.Braces {
+		data.Braces = append(data.Braces, scorer.PlayerName)
+	}
+
+	var b strings.Builder
+	if err := language.template.Execute(&b, data); err != nil {
+		return ""
+	}
+	return b.String()
+}
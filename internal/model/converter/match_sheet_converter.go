@@ -0,0 +1,323 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+// matchSheetSignatures are the people who sign the printed match sheet.
+var matchSheetSignatures = []string{"Referee", "Home team official", "Away team official", "Match commissioner"}
+
+// matchSheet is the report arranged for printing.
+type matchSheet struct {
+	Report     *model.MatchReportResponse
+	Result     string
+	HalfTime   string
+	Home       matchSheetTeam
+	Away       matchSheetTeam
+	Goals      []matchSheetRow
+	Cards      []matchSheetRow
+	Officials  []matchSheetRow
+	Signatures []string
+}
+
+type matchSheetTeam struct {
+	Name        string
+	Starters    []model.LineupReport
+	Substitutes []model.LineupReport
+}
+
+// matchSheetRow is one line of the goals, cards or officials table.
+type matchSheetRow struct {
+	Minute string
+	Team   string
+	Name   string
+	Detail string
+}
+
+func newMatchSheet(report *model.MatchReportResponse) *matchSheet {
+	sheet := &matchSheet{
+		Report:     report,
+		Result:     "-",
+		HalfTime:   "-",
+		Home:       matchSheetTeam{Name: report.HomeTeam.Name},
+		Away:       matchSheetTeam{Name: report.AwayTeam.Name},
+		Signatures: matchSheetSignatures,
+	}
+	if report.HomeScore != nil && report.AwayScore != nil {
+		sheet.Result = fmt.Sprintf("%d - %d", *report.HomeScore, *report.AwayScore)
+	}
+	if report.HalfTimeScore != nil {
+		sheet.HalfTime = fmt.Sprintf("%d - %d", report.HalfTimeScore.Home, report.HalfTimeScore.Away)
+	}
+
+	for _, lineups := range []struct {
+		team    *matchSheetTeam
+		players []model.LineupReport
+	}{{&sheet.Home, report.HomeLineup}, {&sheet.Away, report.AwayLineup}} {
+		for _, player := range lineups.players {
+			if player.IsStarter {
+				lineups.team.Starters = append(lineups.team.Starters, player)
+			} else {
+				lineups.team.Substitutes = append(lineups.team.Substitutes, player)
+			}
+		}
+	}
+
+	teamName := func(teamID string) string {
+		switch teamID {
+		case report.HomeTeam.ID:
+			return report.HomeTeam.Name
+		case report.AwayTeam.ID:
+			return report.AwayTeam.Name
+		}
+		return ""
+	}
+	for _, goal := range report.Goals {
+		sheet.Goals = append(sheet.Goals, matchSheetRow{Minute: goal.Label, Team: teamName(goal.TeamID), Name: goal.PlayerName, Detail: goal.Score})
+	}
+	for _, card := range report.Cards {
+		sheet.Cards = append(sheet.Cards, matchSheetRow{Minute: fmt.Sprintf("%d'", card.Minute), Team: teamName(card.TeamID), Name: card.PlayerName, Detail: card.CardType})
+	}
+	for _, official := range report.Officials {
+		sheet.Officials = append(sheet.Officials, matchSheetRow{Name: official.Name, Detail: officialRoleLabel(official.Role)})
+	}
+	return sheet
+}
+
+// officialRoleLabel turns a role such as assistant_referee_1 into
+// "Assistant referee 1".
+func officialRoleLabel(role string) string {
+	label := strings.ReplaceAll(role, "_", " ")
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+func minutesPlayedLabel(minutes *int16) string {
+	if minutes == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d'", *minutes)
+}
+
+var matchSheetHTML = template.Must(template.New("match-sheet").Funcs(template.FuncMap{
+	"minutes": minutesPlayedLabel,
+	"list":    func(teams ...matchSheetTeam) []matchSheetTeam { return teams },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Match sheet: {{.Home.Name}} vs {{.Away.Name}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; font-size: 12px; margin: 24px; color: #000; }
+h1 { font-size: 20px; margin: 0 0 4px; }
+h2 { font-size: 14px; margin: 18px 0 6px; border-bottom: 1px solid #000; }
+table { width: 100%; border-collapse: collapse; }
+th, td { text-align: left; padding: 3px 6px; border-bottom: 1px solid #ccc; }
+.teams { display: flex; gap: 24px; }
+.teams > div { flex: 1; }
+.signatures { display: flex; flex-wrap: wrap; gap: 24px; margin-top: 12px; }
+.signature { width: 45%; padding-top: 48px; border-bottom: 1px solid #000; }
+.signature span { display: block; margin-top: 52px; }
+@media print { body { margin: 0; } }
+</style>
+</head>
+<body>
+<h1>Official Match Sheet</h1>
+<p><strong>{{.Home.Name}}</strong> vs <strong>{{.Away.Name}}</strong><br>
+{{.Report.MatchDate}} {{.Report.MatchTime}} ({{.Report.Timezone}}) &middot; Status: {{.Report.Status}}<br>
+Result: {{.Result}} &middot; Half-time: {{.HalfTime}}</p>
+
+<h2>Lineups</h2>
+<div class="teams">
+{{range $team := (list .Home .Away)}}<div>
+<h3>{{$team.Name}}</h3>
+<table>
+<tr><th>No</th><th>Player</th><th>Position</th><th>Minutes</th></tr>
+{{range $team.Starters}}<tr><td>{{.JerseyNumber}}</td><td>{{.PlayerName}}</td><td>{{.Position}}</td><td>{{minutes .MinutesPlayed}}</td></tr>
+{{end}}{{if $team.Substitutes}}<tr><th colspan="4">Substitutes</th></tr>
+{{range $team.Substitutes}}<tr><td>{{.JerseyNumber}}</td><td>{{.PlayerName}}</td><td>{{.Position}}</td><td>{{minutes .MinutesPlayed}}</td></tr>
+{{end}}{{end}}</table>
+</div>
+{{end}}</div>
+
+<h2>Goals</h2>
+{{if .Goals}}<table>
+<tr><th>Minute</th><th>Team</th><th>Player</th><th>Score</th></tr>
+{{range .Goals}}<tr><td>{{.Minute}}</td><td>{{.Team}}</td><td>{{.Name}}</td><td>{{.Detail}}</td></tr>
+{{end}}</table>{{else}}<p>No goals.</p>{{end}}
+
+<h2>Cards</h2>
+{{if .Cards}}<table>
+<tr><th>Minute</th><th>Team</th><th>Player</th><th>Card</th></tr>
+{{range .Cards}}<tr><td>{{.Minute}}</td><td>{{.Team}}</td><td>{{.Name}}</td><td>{{.Detail}}</td></tr>
+{{end}}</table>{{else}}<p>No cards.</p>{{end}}
+
+<h2>Officials</h2>
+{{if .Officials}}<table>
+<tr><th>Role</th><th>Name</th></tr>
+{{range .Officials}}<tr><td>{{.Detail}}</td><td>{{.Name}}</td></tr>
+{{end}}</table>{{else}}<p>No officials assigned.</p>{{end}}
+
+<h2>Signatures</h2>
+<div class="signatures">
+{{range .Signatures}}<div class="signature"><span>{{.}}</span></div>
+{{end}}</div>
+</body>
+</html>
+`))
+
+// ToMatchSheetHTML renders the report as a printable match sheet.
+func ToMatchSheetHTML(report *model.MatchReportResponse) ([]byte, error) {
+	var b bytes.Buffer
+	if err := matchSheetHTML.Execute(&b, newMatchSheet(report)); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+const (
+	sheetMargin     = 50.0
+	sheetLineHeight = 15.0
+)
+
+// sheetWriter lays lines out top to bottom, starting a new page when the
+// current one is full.
+type sheetWriter struct {
+	doc  *common.PDFDocument
+	page *common.PDFPage
+	y    float64
+}
+
+func newSheetWriter() *sheetWriter {
+	w := &sheetWriter{doc: common.NewPDFDocument()}
+	w.newPage()
+	return w
+}
+
+func (w *sheetWriter) newPage() {
+	w.page = w.doc.AddPage()
+	w.y = common.PDFPageHeight - sheetMargin
+}
+
+// reserve starts a new page unless height points are left above the margin.
+func (w *sheetWriter) reserve(height float64) {
+	if w.y-height < sheetMargin {
+		w.newPage()
+	}
+}
+
+// fit shortens text to roughly the width available at the font size.
+func fit(text string, width, size float64) string {
+	runes := []rune(text)
+	limit := int(width / (size * 0.5))
+	if len(runes) <= limit || limit < 2 {
+		return text
+	}
+	return string(runes[:limit-1]) + "."
+}
+
+// row writes cells at the given x offsets on one line.
+func (w *sheetWriter) row(size float64, bold bool, columns []float64, cells ...string) {
+	w.reserve(sheetLineHeight)
+	w.y -= sheetLineHeight
+	for i, cell := range cells {
+		right := common.PDFPageWidth - sheetMargin
+		if i+1 < len(columns) {
+			right = columns[i+1] - 6
+		}
+		w.page.Text(columns[i], w.y, size, bold, fit(cell, right-columns[i], size))
+	}
+}
+
+func (w *sheetWriter) heading(text string) {
+	w.reserve(sheetLineHeight*3 + 6)
+	w.y -= sheetLineHeight + 6
+	w.page.Text(sheetMargin, w.y, 13, true, text)
+	w.page.Line(sheetMargin, w.y-4, common.PDFPageWidth-sheetMargin, w.y-4, 0.8)
+	w.y -= 4
+}
+
+func (w *sheetWriter) table(columns []float64, header []string, rows []matchSheetRow, cells func(matchSheetRow) []string, empty string) {
+	if len(rows) == 0 {
+		w.row(10, false, columns, empty)
+		return
+	}
+	w.row(10, true, columns, header...)
+	for _, row := range rows {
+		w.row(10, false, columns, cells(row)...)
+	}
+}
+
+// ToMatchSheetPDF renders the report as a printable A4 match sheet.
+func ToMatchSheetPDF(report *model.MatchReportResponse) []byte {
+	sheet := newMatchSheet(report)
+	w := newSheetWriter()
+	full := []float64{sheetMargin}
+
+	w.y -= 10
+	w.page.Text(sheetMargin, w.y, 18, true, "Official Match Sheet")
+	w.row(12, true, full, fmt.Sprintf("%s vs %s", sheet.Home.Name, sheet.Away.Name))
+	w.row(10, false, full, fmt.Sprintf("%s %s (%s) - Status: %s", report.MatchDate, report.MatchTime, report.Timezone, report.Status))
+	w.row(10, false, full, fmt.Sprintf("Result: %s - Half-time: %s", sheet.Result, sheet.HalfTime))
+
+	// lineups side by side, home on the left
+	half := (common.PDFPageWidth - 2*sheetMargin) / 2
+	lineupColumns := []float64{sheetMargin, sheetMargin + 24, sheetMargin + half - 40, sheetMargin + half, sheetMargin + half + 24, sheetMargin + 2*half - 40}
+	player := func(players []model.LineupReport, i int) []string {
+		if i >= len(players) {
+			return []string{"", "", ""}
+		}
+		return []string{fmt.Sprint(players[i].JerseyNumber), players[i].PlayerName, minutesPlayedLabel(players[i].MinutesPlayed)}
+	}
+	w.heading("Lineups")
+	w.row(11, true, []float64{sheetMargin, sheetMargin + half}, sheet.Home.Name, sheet.Away.Name)
+	for _, group := range []struct {
+		title string
+		home  []model.LineupReport
+		away  []model.LineupReport
+	}{{"Starting", sheet.Home.Starters, sheet.Away.Starters}, {"Substitutes", sheet.Home.Substitutes, sheet.Away.Substitutes}} {
+		if len(group.home) == 0 && len(group.away) == 0 {
+			continue
+		}
+		w.row(10, true, []float64{sheetMargin, sheetMargin + half}, group.title, group.title)
+		for i := 0; i < max(len(group.home), len(group.away)); i++ {
+			w.row(10, false, lineupColumns, append(player(group.home, i), player(group.away, i)...)...)
+		}
+	}
+	if len(report.HomeLineup) == 0 && len(report.AwayLineup) == 0 {
+		w.row(10, false, full, "No lineups submitted.")
+	}
+
+	eventColumns := []float64{sheetMargin, sheetMargin + 50, sheetMargin + 200, sheetMargin + 400}
+	eventCells := func(row matchSheetRow) []string { return []string{row.Minute, row.Team, row.Name, row.Detail} }
+	w.heading("Goals")
+	w.table(eventColumns, []string{"Minute", "Team", "Player", "Score"}, sheet.Goals, eventCells, "No goals.")
+	w.heading("Cards")
+	w.table(eventColumns, []string{"Minute", "Team", "Player", "Card"}, sheet.Cards, eventCells, "No cards.")
+	w.heading("Officials")
+	w.table([]float64{sheetMargin, sheetMargin + 200}, []string{"Role", "Name"}, sheet.Officials,
+		func(row matchSheetRow) []string { return []string{row.Detail, row.Name} }, "No officials assigned.")
+
+	// two signature boxes per line: a rule to sign on with the role beneath
+	w.heading("Signatures")
+	for i := 0; i < len(sheet.Signatures); i += 2 {
+		w.reserve(60)
+		w.y -= 45
+		for j, title := range sheet.Signatures[i:min(i+2, len(sheet.Signatures))] {
+			x := sheetMargin + float64(j)*half
+			w.page.Line(x, w.y, x+half-30, w.y, 0.5)
+			w.page.Text(x, w.y-12, 9, false, title)
+		}
+		w.y -= 15
+	}
+
+	return w.doc.Bytes()
+}
@@ -0,0 +1,109 @@
+package converter
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func intPtr(v int) *int { return &v }
+
+func int16Ptr(v int16) *int16 { return &v }
+
+// matchSheetReport is a finished match with an event of every kind.
+func matchSheetReport() *model.MatchReportResponse {
+	home := model.TeamShort{ID: "home", Name: "Persija Jakarta"}
+	away := model.TeamShort{ID: "away", Name: "Persib Bandung"}
+	return &model.MatchReportResponse{
+		ID:            "match",
+		MatchDate:     "2025-08-16",
+		MatchTime:     "19:00:00",
+		Timezone:      "Asia/Jakarta",
+		Status:        "completed",
+		HomeTeam:      home,
+		AwayTeam:      away,
+		HomeScore:     intPtr(2),
+		AwayScore:     intPtr(1),
+		HalfTimeScore: &model.ScoreReport{Home: 1, Away: 1},
+		Goals: []model.GoalReport{
+			{PlayerName: "Marko Simic", TeamID: "home", Label: "12'", Score: "1 - 0"},
+			{PlayerName: "David da Silva", TeamID: "away", Label: "45+2'", Score: "1 - 1"},
+			{PlayerName: "Marko Simic", TeamID: "home", Label: "78'", Score: "2 - 1"},
+		},
+		HomeLineup: []model.LineupReport{
+			{PlayerName: "Andritany Ardhiyasa", JerseyNumber: 1, Position: "goalkeeper", IsStarter: true, MinutesPlayed: int16Ptr(90)},
+			{PlayerName: "Marko Simic", JerseyNumber: 9, Position: "forward", IsStarter: true, MinutesPlayed: int16Ptr(84)},
+			{PlayerName: "Witan Sulaeman", JerseyNumber: 8, Position: "midfielder", MinutesPlayed: int16Ptr(6)},
+		},
+		AwayLineup: []model.LineupReport{
+			{PlayerName: "Teja Paku Alam", JerseyNumber: 14, Position: "goalkeeper", IsStarter: true},
+			{PlayerName: "David da Silva", JerseyNumber: 19, Position: "forward", IsStarter: true},
+		},
+		Cards: []model.CardReport{
+			{PlayerName: "David da Silva", TeamID: "away", CardType: "yellow", Minute: 30},
+			{PlayerName: "Witan Sulaeman", TeamID: "home", CardType: "red", Minute: 89},
+		},
+		Officials: []model.OfficialReport{
+			{Name: "Thoriq Alkatiri", Role: "referee"},
+			{Name: "Nurhadi", Role: "assistant_referee_1"},
+		},
+	}
+}
+
+// unplayedMatchSheetReport is a scheduled match with nothing recorded yet.
+func unplayedMatchSheetReport() *model.MatchReportResponse {
+	return &model.MatchReportResponse{
+		ID:        "match",
+		MatchDate: "2025-08-23",
+		MatchTime: "15:30:00",
+		Timezone:  "Asia/Makassar",
+		Status:    "scheduled",
+		HomeTeam:  model.TeamShort{ID: "home", Name: "PSM Makassar"},
+		AwayTeam:  model.TeamShort{ID: "away", Name: "Bali United"},
+	}
+}
+
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v (run go test -update to create it)", path, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s; run go test -update if the change is intended", path)
+	}
+}
+
+func TestMatchSheet(t *testing.T) {
+	tests := []struct {
+		name   string
+		report *model.MatchReportResponse
+	}{
+		{"match_sheet_completed", matchSheetReport()},
+		{"match_sheet_scheduled", unplayedMatchSheetReport()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := ToMatchSheetHTML(tt.report)
+			if err != nil {
+				t.Fatalf("ToMatchSheetHTML: %v", err)
+			}
+			assertGolden(t, tt.name+".html.golden", html)
+			assertGolden(t, tt.name+".pdf.golden", ToMatchSheetPDF(tt.report))
+		})
+	}
+}
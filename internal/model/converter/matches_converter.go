@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/Fadlihardiyanto/football-api/internal/common"
@@ -8,92 +9,265 @@ import (
 	"github.com/Fadlihardiyanto/football-api/internal/model"
 )
 
-func ToMatchResponse(match *entity.Match) *model.MatchResponse {
+// localKickoff returns the kickoff in loc, or in the match's own timezone when
+// loc is nil.
+func localKickoff(match *entity.Match, loc *time.Location) time.Time {
+	if loc == nil {
+		var err error
+		if loc, err = time.LoadLocation(match.Timezone); err != nil {
+			loc = time.UTC
+		}
+	}
+	return match.KickoffAt.In(loc)
+}
+
+// ToLocalMatchDate is the match date in loc, or in the match's own timezone
+// when loc is nil.
+func ToLocalMatchDate(match *entity.Match, loc *time.Location) string {
+	return localKickoff(match, loc).Format("2006-01-02")
+}
+
+// ToMatchResponse renders the match date and time in loc. A nil loc keeps them
+// in the timezone of the venue.
+func ToMatchResponse(match *entity.Match, loc *time.Location) *model.MatchResponse {
 	if match == nil {
 		return nil
 	}
 
+	kickoff := localKickoff(match, loc)
+
 	return &model.MatchResponse{
-		ID:         match.ID,
-		HomeTeam:   ToTeamResponse(&match.HomeTeam),
-		AwayTeam:   ToTeamResponse(&match.AwayTeam),
-		MatchDate:  match.MatchDate.Format("2006-01-02"),
-		MatchTime:  match.MatchTime,
-		HomeTeamID: match.HomeTeamID,
-		AwayTeamID: match.AwayTeamID,
-		HomeScore:  match.HomeScore,
-		AwayScore:  match.AwayScore,
-		Status:     match.Status,
-		CreatedAt:  match.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:  match.UpdatedAt.Format(time.RFC3339),
-		DeletedAt:  common.ToStringPointer(match.DeletedAt),
+		ID:            match.ID,
+		HomeTeam:      ToTeamResponse(&match.HomeTeam),
+		AwayTeam:      ToTeamResponse(&match.AwayTeam),
+		MatchDate:     kickoff.Format("2006-01-02"),
+		MatchTime:     kickoff.Format("15:04:05"),
+		KickoffAt:     kickoff.Format(time.RFC3339),
+		Timezone:      kickoff.Location().String(),
+		VenueTimezone: match.Timezone,
+		HomeTeamID:    match.HomeTeamID,
+		AwayTeamID:    match.AwayTeamID,
+		HomeScore:     match.HomeScore,
+		AwayScore:     match.AwayScore,
+		Status:        match.Status,
+		CompetitionID: match.CompetitionID,
+		Matchday:      match.Matchday,
+		VenueID:       match.VenueID,
+		Attendance:    match.Attendance,
+		CreatedAt:     match.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     match.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:     common.ToStringPointer(match.DeletedAt),
+		Reschedules:   ToMatchRescheduleResponses(match.Reschedules),
 	}
 }
 
-func ToMatchReportResponse(match *entity.Match, goals []entity.Goal, allPreviousMatches []entity.Match) *model.MatchReportResponse {
-	var status string
-	if *match.HomeScore > *match.AwayScore {
-		status = "Home Win"
-	} else if *match.HomeScore < *match.AwayScore {
-		status = "Away Win"
-	} else {
-		status = "Draw"
+func ToMatchRescheduleResponses(reschedules []entity.MatchReschedule) []model.MatchRescheduleResponse {
+	var responses []model.MatchRescheduleResponse
+	for _, reschedule := range reschedules {
+		responses = append(responses, model.MatchRescheduleResponse{
+			ID:            reschedule.ID,
+			Reason:        reschedule.Reason,
+			OriginalDate:  reschedule.OriginalDate.Format("2006-01-02"),
+			OriginalTime:  reschedule.OriginalTime,
+			NewDate:       common.ToDateStringPointer(reschedule.NewDate),
+			NewTime:       reschedule.NewTime,
+			PostponedAt:   common.ToStringPointer(reschedule.PostponedAt),
+			RescheduledAt: common.ToStringPointer(reschedule.RescheduledAt),
+			CreatedAt:     reschedule.CreatedAt.Format(time.RFC3339),
+		})
 	}
+	return responses
+}
 
-	// count goals per player
-	golPerPemain := map[string]int{}
-	for _, goal := range goals {
-		golPerPemain[goal.PlayerID]++
+// goalLabel renders the goal's minute, e.g. 12' or 45+2'.
+func goalLabel(goal *entity.Goal) string {
+	if goal.AddedTime != nil && *goal.AddedTime > 0 {
+		return fmt.Sprintf("%d+%d'", goal.GoalTime, *goal.AddedTime)
 	}
+	return fmt.Sprintf("%d'", goal.GoalTime)
+}
 
-	// Find top scorer
-	var topScorerName string
-	var maxGoals int
-	for _, goal := range goals {
-		count := golPerPemain[goal.PlayerID]
-		if count > maxGoals {
-			maxGoals = count
-			topScorerName = goal.Player.Name
+// ToMatchReportResponse builds the report from the match's goals in the order
+// they were scored. The win totals come from the head-to-head record before
+// the match, seen from the home team. The half-time score and comeback are
+// only derived when the recorded goals add up to the final score.
+func ToMatchReportResponse(match *entity.Match, goals []entity.Goal, lineups []entity.MatchLineup, cards []entity.MatchCard, headToHead model.HeadToHeadRecord, homeCoach, awayCoach *entity.StaffMember, officials []entity.MatchOfficial, loc *time.Location) *model.MatchReportResponse {
+	status := "No Result"
+	if match.HomeScore != nil && match.AwayScore != nil {
+		if *match.HomeScore > *match.AwayScore {
+			status = "Home Win"
+		} else if *match.HomeScore < *match.AwayScore {
+			status = "Away Win"
+		} else {
+			status = "Draw"
+		}
+	}
+
+	officialReports := []model.OfficialReport{}
+	for _, official := range officials {
+		report := model.OfficialReport{ID: official.OfficialID, Role: official.Role}
+		if official.Official != nil {
+			report.Name = official.Official.Name
+		}
+		officialReports = append(officialReports, report)
+	}
+
+	kickoff := localKickoff(match, loc)
+	homeTeam := model.TeamShort{ID: match.HomeTeam.ID, Name: match.HomeTeam.Name}
+	awayTeam := model.TeamShort{ID: match.AwayTeam.ID, Name: match.AwayTeam.Name}
+
+	report := &model.MatchReportResponse{
+		ID:               match.ID,
+		MatchDate:        kickoff.Format("2006-01-02"),
+		MatchTime:        kickoff.Format("15:04:05"),
+		KickoffAt:        kickoff.Format(time.RFC3339),
+		Timezone:         kickoff.Location().String(),
+		Status:           match.Status,
+		HomeTeam:         homeTeam,
+		AwayTeam:         awayTeam,
+		HomeHeadCoach:    ToStaffShort(homeCoach),
+		AwayHeadCoach:    ToStaffShort(awayCoach),
+		HomeScore:        match.HomeScore,
+		AwayScore:        match.AwayScore,
+		StatusResult:     status,
+		Goals:            []model.GoalReport{},
+		HomeGoals:        model.TeamGoalsReport{Team: homeTeam, Goals: []model.GoalReport{}, Scorers: []model.ScorerReport{}},
+		AwayGoals:        model.TeamGoalsReport{Team: awayTeam, Goals: []model.GoalReport{}, Scorers: []model.ScorerReport{}},
+		Braces:           []model.ScorerReport{},
+		HatTricks:        []model.ScorerReport{},
+		HomeLineup:       []model.LineupReport{},
+		AwayLineup:       []model.LineupReport{},
+		Cards:            []model.CardReport{},
+		HomeTeamWinTotal: headToHead.Wins,
+		AwayTeamWinTotal: headToHead.Losses,
+		Officials:        officialReports,
+	}
+
+	for _, lineup := range lineups {
+		lineupReport := model.LineupReport{
+			PlayerID:      lineup.PlayerID,
+			IsStarter:     lineup.IsStarter,
+			MinutesPlayed: lineup.MinutesPlayed,
+		}
+		if lineup.Player != nil {
+			lineupReport.PlayerName = lineup.Player.Name
+			lineupReport.JerseyNumber = lineup.Player.JerseyNumber
+			lineupReport.Position = lineup.Player.Position
 		}
+		switch lineup.TeamID {
+		case match.HomeTeamID:
+			report.HomeLineup = append(report.HomeLineup, lineupReport)
+		case match.AwayTeamID:
+			report.AwayLineup = append(report.AwayLineup, lineupReport)
+		}
+	}
+
+	for _, card := range cards {
+		cardReport := model.CardReport{PlayerID: card.PlayerID, CardType: card.CardType, Minute: card.Minute}
+		if card.Player != nil {
+			cardReport.PlayerName = card.Player.Name
+			cardReport.TeamID = card.Player.TeamID
+		}
+		report.Cards = append(report.Cards, cardReport)
 	}
 
-	// Count accumulative wins
-	homeWins := 0
-	awayWins := 0
-	for _, m := range allPreviousMatches {
-		if m.HomeScore == nil || m.AwayScore == nil {
+	// walk the goals in order, keeping the running score and each side's
+	// largest deficit
+	var home, away, halfTimeHome, halfTimeAway, homeDeficit, awayDeficit int
+	scorers := map[string]*model.ScorerReport{}
+	var scorerOrder []string
+	for i := range goals {
+		goal := &goals[i]
+		goalReport := model.GoalReport{
+			PlayerID:  goal.PlayerID,
+			Minute:    goal.GoalTime,
+			AddedTime: goal.AddedTime,
+			Label:     goalLabel(goal),
+		}
+		if goal.Player != nil {
+			goalReport.PlayerName = goal.Player.Name
+			goalReport.TeamID = goal.Player.TeamID
+		}
+
+		var side *model.TeamGoalsReport
+		switch goalReport.TeamID {
+		case match.HomeTeamID:
+			home++
+			if goal.GoalTime <= 45 {
+				halfTimeHome++
+			}
+			side = &report.HomeGoals
+		case match.AwayTeamID:
+			away++
+			if goal.GoalTime <= 45 {
+				halfTimeAway++
+			}
+			side = &report.AwayGoals
+		}
+		homeDeficit = max(homeDeficit, away-home)
+		awayDeficit = max(awayDeficit, home-away)
+		goalReport.Score = fmt.Sprintf("%d-%d", home, away)
+
+		report.Goals = append(report.Goals, goalReport)
+		if side == nil {
 			continue
 		}
-		if *m.HomeScore > *m.AwayScore && m.HomeTeamID == match.HomeTeamID {
-			homeWins++
+		side.Goals = append(side.Goals, goalReport)
+
+		scorer, ok := scorers[goal.PlayerID]
+		if !ok {
+			scorer = &model.ScorerReport{PlayerID: goal.PlayerID, PlayerName: goalReport.PlayerName, TeamID: goalReport.TeamID, Minutes: []string{}}
+			scorers[goal.PlayerID] = scorer
+			scorerOrder = append(scorerOrder, goal.PlayerID)
 		}
-		if *m.AwayScore > *m.HomeScore && m.AwayTeamID == match.AwayTeamID {
-			awayWins++
+		scorer.Goals++
+		scorer.Minutes = append(scorer.Minutes, goalReport.Label)
+	}
+
+	// the first player to reach the highest tally is the top scorer
+	var maxGoals int
+	for _, playerID := range scorerOrder {
+		scorer := scorers[playerID]
+		switch {
+		case scorer.Goals >= 3:
+			scorer.Feat = "hat_trick"
+			report.HatTricks = append(report.HatTricks, *scorer)
+		case scorer.Goals == 2:
+			scorer.Feat = "brace"
+			report.Braces = append(report.Braces, *scorer)
+		}
+		if scorer.Goals > maxGoals {
+			maxGoals = scorer.Goals
+			report.TopScorer = scorer.PlayerName
+		}
+		if scorer.TeamID == match.HomeTeamID {
+			report.HomeGoals.Scorers = append(report.HomeGoals.Scorers, *scorer)
+		} else {
+			report.AwayGoals.Scorers = append(report.AwayGoals.Scorers, *scorer)
 		}
 	}
 
-	// Build goal detail
-	var goalReports []model.GoalReport
-	for _, goal := range goals {
-		goalReports = append(goalReports, model.GoalReport{
-			PlayerName: goal.Player.Name,
-			TeamID:     goal.Player.TeamID,
-			Minute:     int16(goal.GoalTime),
-		})
+	if match.HomeScore != nil && match.AwayScore != nil && home == *match.HomeScore && away == *match.AwayScore {
+		report.HalfTimeScore = &model.ScoreReport{Home: halfTimeHome, Away: halfTimeAway}
+		switch {
+		case homeDeficit > 0 && home >= away:
+			report.Comeback = &model.ComebackReport{Team: homeTeam, Deficit: homeDeficit, Result: comebackResult(home, away)}
+		case awayDeficit > 0 && away >= home:
+			report.Comeback = &model.ComebackReport{Team: awayTeam, Deficit: awayDeficit, Result: comebackResult(away, home)}
+		}
 	}
 
-	return &model.MatchReportResponse{
-		ID:               match.ID,
-		MatchDate:        match.MatchDate.Format("2006-01-02"),
-		MatchTime:        match.MatchTime,
-		HomeTeam:         model.TeamShort{ID: match.HomeTeam.ID, Name: match.HomeTeam.Name},
-		AwayTeam:         model.TeamShort{ID: match.AwayTeam.ID, Name: match.AwayTeam.Name},
-		HomeScore:        *match.HomeScore,
-		AwayScore:        *match.AwayScore,
-		StatusResult:     status,
-		Goals:            goalReports,
-		TopScorer:        topScorerName,
-		HomeTeamWinTotal: homeWins,
-		AwayTeamWinTotal: awayWins,
+	report.Narrative = model.MatchReportNarrative{
+		EN: matchNarrative(narrativeEN, report, kickoff),
+		ID: matchNarrative(narrativeID, report, kickoff),
+	}
+
+	return report
+}
+
+func comebackResult(goalsFor, goalsAgainst int) string {
+	if goalsFor > goalsAgainst {
+		return "win"
 	}
+	return "draw"
 }
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToOfficialResponse(official *entity.Official) *model.OfficialResponse {
+	if official == nil {
+		return nil
+	}
+
+	teams := []model.TeamShort{}
+	for _, team := range official.Teams {
+		teams = append(teams, model.TeamShort{ID: team.ID, Name: team.Name})
+	}
+
+	return &model.OfficialResponse{
+		ID:              official.ID,
+		Name:            official.Name,
+		Nationality:     official.Nationality,
+		AffiliatedTeams: teams,
+		CreatedAt:       official.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       official.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:       common.ToStringPointer(official.DeletedAt),
+	}
+}
+
+func ToMatchOfficialResponse(assignment *entity.MatchOfficial) *model.MatchOfficialResponse {
+	if assignment == nil {
+		return nil
+	}
+
+	response := &model.MatchOfficialResponse{
+		ID:         assignment.ID,
+		MatchID:    assignment.MatchID,
+		OfficialID: assignment.OfficialID,
+		Role:       assignment.Role,
+		CreatedAt:  assignment.CreatedAt.Format(time.RFC3339),
+	}
+	if assignment.Official != nil {
+		response.OfficialName = assignment.Official.Name
+	}
+
+	return response
+}
+
+func ToOfficialMatchResponse(assignment *entity.MatchOfficial, loc *time.Location) *model.OfficialMatchResponse {
+	if assignment == nil || assignment.Match == nil {
+		return nil
+	}
+
+	match := assignment.Match
+	kickoff := localKickoff(match, loc)
+	return &model.OfficialMatchResponse{
+		MatchID:   match.ID,
+		MatchDate: kickoff.Format("2006-01-02"),
+		MatchTime: kickoff.Format("15:04:05"),
+		Role:      assignment.Role,
+		HomeTeam:  model.TeamShort{ID: match.HomeTeam.ID, Name: match.HomeTeam.Name},
+		AwayTeam:  model.TeamShort{ID: match.AwayTeam.ID, Name: match.AwayTeam.Name},
+		HomeScore: match.HomeScore,
+		AwayScore: match.AwayScore,
+		Status:    match.Status,
+	}
+}
@@ -20,6 +20,7 @@ func ToPlayerResponse(player *entity.Player) *model.PlayerResponse {
 		Height:       player.Height,
 		Weight:       player.Weight,
 		JerseyNumber: player.JerseyNumber,
+		DateOfBirth:  common.ToDateStringPointer(player.DateOfBirth),
 		TeamID:       player.TeamID,
 		CreatedAt:    player.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:    player.UpdatedAt.Format(time.RFC3339),
@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"math"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func roundProbability(p float64) float64 {
+	return math.Round(p*10000) / 10000
+}
+
+func ToMatchPredictionResponse(match *entity.Match, prediction *entity.MatchPrediction, loc *time.Location) *model.MatchPredictionResponse {
+	scorelines := []model.ScorelineProbability{}
+	for _, scoreline := range prediction.Scorelines {
+		scorelines = append(scorelines, model.ScorelineProbability{
+			HomeGoals:   scoreline.Home,
+			AwayGoals:   scoreline.Away,
+			Probability: roundProbability(scoreline.Probability),
+		})
+	}
+
+	return &model.MatchPredictionResponse{
+		MatchID:           match.ID,
+		HomeTeam:          model.TeamShort{ID: match.HomeTeam.ID, Name: match.HomeTeam.Name},
+		AwayTeam:          model.TeamShort{ID: match.AwayTeam.ID, Name: match.AwayTeam.Name},
+		KickoffAt:         localKickoff(match, loc).Format(time.RFC3339),
+		HomeExpectedGoals: math.Round(prediction.HomeExpectedGoals*100) / 100,
+		AwayExpectedGoals: math.Round(prediction.AwayExpectedGoals*100) / 100,
+		HomeWin:           roundProbability(prediction.HomeWin),
+		Draw:              roundProbability(prediction.Draw),
+		AwayWin:           roundProbability(prediction.AwayWin),
+		Scorelines:        scorelines,
+		TrainingMatches:   prediction.TrainingMatches,
+		ComputedAt:        prediction.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"math"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+// RoundRating rounds a rating to two decimals for display.
+func RoundRating(rating float64) float64 {
+	return math.Round(rating*100) / 100
+}
+
+func ToRatingPoints(ratings []entity.TeamRating, loc *time.Location) []model.RatingPoint {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	points := []model.RatingPoint{}
+	for _, rating := range ratings {
+		point := model.RatingPoint{
+			MatchID:       rating.MatchID,
+			PlayedAt:      rating.PlayedAt.In(loc).Format(time.RFC3339),
+			Opponent:      model.TeamShort{ID: rating.OpponentID},
+			RatingBefore:  RoundRating(rating.RatingBefore),
+			RatingAfter:   RoundRating(rating.RatingAfter),
+			Change:        RoundRating(rating.RatingAfter - rating.RatingBefore),
+			ExpectedScore: math.Round(rating.ExpectedScore*1000) / 1000,
+		}
+		if rating.Opponent != nil {
+			point.Opponent.Name = rating.Opponent.Name
+		}
+		points = append(points, point)
+	}
+	return points
+}
@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToRecordResponse(record *entity.Record, loc *time.Location) *model.RecordResponse {
+	if record == nil {
+		return nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	response := &model.RecordResponse{
+		ID:            record.ID,
+		Type:          record.Type,
+		Value:         record.Value,
+		Description:   record.Description,
+		CompetitionID: record.CompetitionID,
+		TeamID:        record.TeamID,
+		PlayerID:      record.PlayerID,
+		MatchID:       record.MatchID,
+		GoalID:        record.GoalID,
+		Context:       record.Context,
+		AchievedAt:    record.AchievedAt.In(loc).Format(time.RFC3339),
+		CreatedAt:     record.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     record.UpdatedAt.Format(time.RFC3339),
+	}
+	if response.Context == nil {
+		response.Context = map[string]interface{}{}
+	}
+	if record.Competition != nil {
+		response.CompetitionName = &record.Competition.Name
+	}
+	if record.Team != nil {
+		response.TeamName = &record.Team.Name
+	}
+	if record.Player != nil {
+		response.PlayerName = &record.Player.Name
+	}
+	return response
+}
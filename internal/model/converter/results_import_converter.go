@@ -0,0 +1,249 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+const (
+	ResultsFormatFootballData = "football-data"
+	ResultsFormatOpenFootball = "openfootball"
+)
+
+// DetectResultsFormat treats a file starting with a JSON object as
+// openfootball and anything else as football-data CSV.
+func DetectResultsFormat(data []byte) string {
+	if bytes.HasPrefix(bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))), []byte("{")) {
+		return ResultsFormatOpenFootball
+	}
+	return ResultsFormatFootballData
+}
+
+// ReadHistoricalResults reads the finished matches of a results file.
+// Matches without a full-time score are fixtures and are left out.
+func ReadHistoricalResults(format string, data []byte) ([]model.HistoricalResult, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if format == ResultsFormatOpenFootball {
+		return readOpenFootball(data)
+	}
+	return readFootballData(data)
+}
+
+// latin1ToUTF8 converts older football-data files, which are not UTF-8.
+func latin1ToUTF8(data []byte) []byte {
+	if utf8.Valid(data) {
+		return data
+	}
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return []byte(string(runes))
+}
+
+// footballDataColumns lists the accepted names of each column; the extra
+// leagues files use the shorter names.
+var footballDataColumns = map[string][]string{
+	"date":       {"Date"},
+	"time":       {"Time"},
+	"home_team":  {"HomeTeam", "Home"},
+	"away_team":  {"AwayTeam", "Away"},
+	"home_goals": {"FTHG", "HG"},
+	"away_goals": {"FTAG", "AG"},
+}
+
+func readFootballData(data []byte) ([]model.HistoricalResult, error) {
+	r := csv.NewReader(bytes.NewReader(latin1ToUTF8(data)))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	index := map[string]int{}
+	for column, names := range footballDataColumns {
+		for i, name := range header {
+			if slices.ContainsFunc(names, func(n string) bool { return strings.EqualFold(n, strings.TrimSpace(name)) }) {
+				index[column] = i
+				break
+			}
+		}
+	}
+	for _, column := range []string{"date", "home_team", "away_team", "home_goals", "away_goals"} {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("missing %s column", footballDataColumns[column][0])
+		}
+	}
+
+	var results []model.HistoricalResult
+	for {
+		fields, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		field := func(column string) string {
+			i, ok := index[column]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[i])
+		}
+
+		// files end with empty rows and list fixtures without a score
+		if field("home_team") == "" || field("home_goals") == "" || field("away_goals") == "" {
+			continue
+		}
+
+		result := model.HistoricalResult{Row: line, HomeTeam: field("home_team"), AwayTeam: field("away_team"), Time: field("time")}
+		if result.Date, err = parseResultDate(field("date"), "02/01/2006", "02/01/06"); err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", line, field("date"))
+		}
+		if result.HomeScore, err = strconv.Atoi(field("home_goals")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid home goals %q", line, field("home_goals"))
+		}
+		if result.AwayScore, err = strconv.Atoi(field("away_goals")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid away goals %q", line, field("away_goals"))
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func parseResultDate(value string, layouts ...string) (time.Time, error) {
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// openFootballTeam is a team given either as a name or, in older files, as an
+// object with a name.
+type openFootballTeam string
+
+func (t *openFootballTeam) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*t = openFootballTeam(name)
+		return nil
+	}
+	var team struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &team); err != nil {
+		return err
+	}
+	*t = openFootballTeam(team.Name)
+	return nil
+}
+
+type openFootballGoal struct {
+	Name    string `json:"name"`
+	Minute  int    `json:"minute"`
+	Offset  *int   `json:"offset"`
+	OwnGoal bool   `json:"owngoal"`
+}
+
+// openFootballMatch covers both layouts: newer files give the score as
+// score.ft, older ones as score1 and score2.
+type openFootballMatch struct {
+	Round string           `json:"round"`
+	Date  string           `json:"date"`
+	Time  string           `json:"time"`
+	Team1 openFootballTeam `json:"team1"`
+	Team2 openFootballTeam `json:"team2"`
+	Score *struct {
+		FT []int `json:"ft"`
+	} `json:"score"`
+	Score1 *int               `json:"score1"`
+	Score2 *int               `json:"score2"`
+	Goals1 []openFootballGoal `json:"goals1"`
+	Goals2 []openFootballGoal `json:"goals2"`
+}
+
+type openFootballFile struct {
+	Matches []openFootballMatch `json:"matches"`
+	Rounds  []struct {
+		Name    string              `json:"name"`
+		Matches []openFootballMatch `json:"matches"`
+	} `json:"rounds"`
+}
+
+var (
+	roundNumber = regexp.MustCompile(`(\d+)\s*$`)
+	clockTime   = regexp.MustCompile(`^\d{1,2}:\d{2}`)
+)
+
+func readOpenFootball(data []byte) ([]model.HistoricalResult, error) {
+	var file openFootballFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	matches := file.Matches
+	for _, round := range file.Rounds {
+		for _, match := range round.Matches {
+			if match.Round == "" {
+				match.Round = round.Name
+			}
+			matches = append(matches, match)
+		}
+	}
+
+	var results []model.HistoricalResult
+	for i, match := range matches {
+		row := i + 1
+		home, away := match.Score1, match.Score2
+		if match.Score != nil && len(match.Score.FT) == 2 {
+			home, away = &match.Score.FT[0], &match.Score.FT[1]
+		}
+		if home == nil || away == nil {
+			continue
+		}
+
+		date, err := parseResultDate(match.Date, "2006-01-02")
+		if err != nil {
+			return nil, fmt.Errorf("match %d: invalid date %q", row, match.Date)
+		}
+		result := model.HistoricalResult{
+			Row:       row,
+			Date:      date,
+			Time:      clockTime.FindString(match.Time),
+			HomeTeam:  strings.TrimSpace(string(match.Team1)),
+			AwayTeam:  strings.TrimSpace(string(match.Team2)),
+			HomeScore: *home,
+			AwayScore: *away,
+		}
+		if m := roundNumber.FindStringSubmatch(match.Round); m != nil {
+			matchday, _ := strconv.Atoi(m[1])
+			result.Matchday = &matchday
+		}
+		for _, goal := range match.Goals1 {
+			result.Goals = append(result.Goals, model.HistoricalGoal{Home: true, Player: goal.Name, Minute: goal.Minute, Offset: goal.Offset, OwnGoal: goal.OwnGoal})
+		}
+		for _, goal := range match.Goals2 {
+			result.Goals = append(result.Goals, model.HistoricalGoal{Player: goal.Name, Minute: goal.Minute, Offset: goal.Offset, OwnGoal: goal.OwnGoal})
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
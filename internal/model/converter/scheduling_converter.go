@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToSchedulingRuleResponse(rule *entity.CompetitionSchedulingRule, blackoutDates []entity.CompetitionBlackoutDate) *model.SchedulingRuleResponse {
+	if rule == nil {
+		return nil
+	}
+
+	dates := []model.BlackoutDateResponse{}
+	for _, date := range blackoutDates {
+		dates = append(dates, model.BlackoutDateResponse{
+			Date:   date.BlackoutDate.Format("2006-01-02"),
+			Reason: date.Reason,
+		})
+	}
+
+	return &model.SchedulingRuleResponse{
+		CompetitionID: rule.CompetitionID,
+		MinRestHours:  rule.MinRestHours,
+		BlackoutDates: dates,
+	}
+}
+
+func ToVenueUnavailabilityResponse(unavailability *entity.VenueUnavailability) *model.VenueUnavailabilityResponse {
+	if unavailability == nil {
+		return nil
+	}
+
+	return &model.VenueUnavailabilityResponse{
+		ID:            unavailability.ID,
+		VenueID:       unavailability.VenueID,
+		CompetitionID: unavailability.CompetitionID,
+		StartDate:     unavailability.StartDate.Format("2006-01-02"),
+		EndDate:       unavailability.EndDate.Format("2006-01-02"),
+		Reason:        unavailability.Reason,
+		CreatedAt:     unavailability.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     unavailability.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToSchedulingConflictResponse(match *entity.Match, violations []model.SchedulingViolation, loc *time.Location) *model.SchedulingConflictResponse {
+	if match == nil {
+		return nil
+	}
+
+	kickoff := localKickoff(match, loc)
+	return &model.SchedulingConflictResponse{
+		MatchID:       match.ID,
+		CompetitionID: match.CompetitionID,
+		Matchday:      match.Matchday,
+		MatchDate:     kickoff.Format("2006-01-02"),
+		MatchTime:     kickoff.Format("15:04:05"),
+		HomeTeam:      model.TeamShort{ID: match.HomeTeam.ID, Name: match.HomeTeam.Name},
+		AwayTeam:      model.TeamShort{ID: match.AwayTeam.ID, Name: match.AwayTeam.Name},
+		Violations:    violations,
+	}
+}
@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToSeasonResponse(season *entity.Season) *model.SeasonResponse {
+	if season == nil {
+		return nil
+	}
+
+	var competitions []model.CompetitionResponse
+	for _, competition := range season.Competitions {
+		competitions = append(competitions, *ToCompetitionResponse(&competition))
+	}
+
+	return &model.SeasonResponse{
+		ID:           season.ID,
+		Name:         season.Name,
+		StartDate:    season.StartDate.Format("2006-01-02"),
+		EndDate:      season.EndDate.Format("2006-01-02"),
+		CreatedAt:    season.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    season.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:    common.ToStringPointer(season.DeletedAt),
+		Competitions: competitions,
+	}
+}
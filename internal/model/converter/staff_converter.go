@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToStaffResponse(staff *entity.StaffMember) *model.StaffResponse {
+	if staff == nil {
+		return nil
+	}
+
+	return &model.StaffResponse{
+		ID:          staff.ID,
+		TeamID:      staff.TeamID,
+		Name:        staff.Name,
+		Role:        staff.Role,
+		Nationality: staff.Nationality,
+		StartDate:   staff.StartDate.Format("2006-01-02"),
+		EndDate:     common.ToDateStringPointer(staff.EndDate),
+		CreatedAt:   staff.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   staff.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:   common.ToStringPointer(staff.DeletedAt),
+	}
+}
+
+func ToStaffShort(staff *entity.StaffMember) *model.StaffShort {
+	if staff == nil {
+		return nil
+	}
+
+	return &model.StaffShort{
+		ID:   staff.ID,
+		Name: staff.Name,
+	}
+}
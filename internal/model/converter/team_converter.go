@@ -21,6 +21,14 @@ func ToTeamResponse(team *entity.Team) *model.TeamResponse {
 		}
 	}
 
+	var staff []*model.StaffResponse
+	if team.Staff != nil {
+		staff = make([]*model.StaffResponse, len(team.Staff))
+		for i, member := range team.Staff {
+			staff[i] = ToStaffResponse(&member)
+		}
+	}
+
 	return &model.TeamResponse{
 		ID:                  team.ID,
 		Name:                team.Name,
@@ -28,9 +36,12 @@ func ToTeamResponse(team *entity.Team) *model.TeamResponse {
 		FoundedYear:         team.FoundedYear,
 		HeadquartersAddress: team.HeadquartersAddress,
 		HeadquartersCity:    team.HeadquartersCity,
+		HomeVenueID:         team.HomeVenueID,
+		Placeholder:         team.Placeholder,
 		CreatedAt:           team.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:           team.UpdatedAt.Format(time.RFC3339),
 		DeletedAt:           common.ToStringPointer(team.DeletedAt),
 		Players:             players,
+		Staff:               staff,
 	}
 }
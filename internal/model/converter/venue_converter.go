@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func ToVenueResponse(venue *entity.Venue) *model.VenueResponse {
+	if venue == nil {
+		return nil
+	}
+
+	return &model.VenueResponse{
+		ID:          venue.ID,
+		Name:        venue.Name,
+		City:        venue.City,
+		Capacity:    venue.Capacity,
+		SurfaceType: venue.SurfaceType,
+		Latitude:    venue.Latitude,
+		Longitude:   venue.Longitude,
+		Timezone:    venue.Timezone,
+		CreatedAt:   venue.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   venue.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:   common.ToStringPointer(venue.DeletedAt),
+	}
+}
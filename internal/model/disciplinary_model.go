@@ -0,0 +1,53 @@
+package model
+
+type DisciplinaryRuleResponse struct {
+	CompetitionID            string `json:"competition_id"`
+	YellowCardsPerBan        int    `json:"yellow_cards_per_ban"`
+	YellowAccumulationBan    int    `json:"yellow_accumulation_ban"`
+	StraightRedBan           int    `json:"straight_red_ban"`
+	SecondYellowBan          int    `json:"second_yellow_ban"`
+	YellowResetAfterMatchday *int   `json:"yellow_reset_after_matchday"`
+	YellowCardPoints         int    `json:"yellow_card_points"`
+	RedCardPoints            int    `json:"red_card_points"`
+}
+
+type DisciplinaryRuleRequestFindByCompetitionID struct {
+	CompetitionID string `json:"competition_id" validate:"required,uuid"`
+}
+
+// DisciplinaryRuleRequestUpdate only changes the fields that are sent. A
+// yellow_reset_after_matchday of 0 removes the reset.
+type DisciplinaryRuleRequestUpdate struct {
+	CompetitionID            string `json:"competition_id" validate:"required,uuid"`
+	YellowCardsPerBan        *int   `json:"yellow_cards_per_ban" validate:"omitempty,min=1"`
+	YellowAccumulationBan    *int   `json:"yellow_accumulation_ban" validate:"omitempty,min=0"`
+	StraightRedBan           *int   `json:"straight_red_ban" validate:"omitempty,min=0"`
+	SecondYellowBan          *int   `json:"second_yellow_ban" validate:"omitempty,min=0"`
+	YellowResetAfterMatchday *int   `json:"yellow_reset_after_matchday" validate:"omitempty,min=0"`
+	YellowCardPoints         *int   `json:"yellow_card_points" validate:"omitempty,min=0"`
+	RedCardPoints            *int   `json:"red_card_points" validate:"omitempty,min=0"`
+}
+
+type FairPlayResponse struct {
+	Rank        int    `json:"rank"`
+	TeamID      string `json:"team_id"`
+	TeamName    string `json:"team_name"`
+	YellowCards int64  `json:"yellow_cards"`
+	RedCards    int64  `json:"red_cards"`
+	Points      int64  `json:"points"`
+}
+
+type StandingResponse struct {
+	Position       int    `json:"position"`
+	TeamID         string `json:"team_id"`
+	TeamName       string `json:"team_name"`
+	Played         int    `json:"played"`
+	Won            int    `json:"won"`
+	Drawn          int    `json:"drawn"`
+	Lost           int    `json:"lost"`
+	GoalsFor       int    `json:"goals_for"`
+	GoalsAgainst   int    `json:"goals_against"`
+	GoalDifference int    `json:"goal_difference"`
+	Points         int    `json:"points"`
+	FairPlayPoints int64  `json:"fair_play_points"`
+}
@@ -0,0 +1,72 @@
+package model
+
+type TeamFormResponse struct {
+	Team           TeamShort           `json:"team"`
+	Form           TeamForm            `json:"form"`
+	Results        []FormResult        `json:"results"`
+	LongestStreaks []SeasonFormStreaks `json:"longest_streaks"`
+	PointsPerGame  PointsPerGameTrend  `json:"points_per_game"`
+}
+
+// TeamForm holds the last results as W/D/L strings, most recent first, and the
+// streaks the team is currently on.
+type TeamForm struct {
+	Overall        string      `json:"overall"`
+	Home           string      `json:"home"`
+	Away           string      `json:"away"`
+	CurrentStreaks FormStreaks `json:"current_streaks"`
+}
+
+type FormResult struct {
+	MatchID       string    `json:"match_id"`
+	MatchDate     string    `json:"match_date"`
+	CompetitionID *string   `json:"competition_id"`
+	Opponent      TeamShort `json:"opponent"`
+	Side          string    `json:"side"`
+	GoalsFor      int       `json:"goals_for"`
+	GoalsAgainst  int       `json:"goals_against"`
+	Result        string    `json:"result"`
+}
+
+type FormStreaks struct {
+	Winning     int `json:"winning"`
+	Unbeaten    int `json:"unbeaten"`
+	Winless     int `json:"winless"`
+	Losing      int `json:"losing"`
+	CleanSheets int `json:"clean_sheets"`
+	Scoring     int `json:"scoring"`
+}
+
+type SeasonFormStreaks struct {
+	SeasonID   *string     `json:"season_id"`
+	SeasonName *string     `json:"season_name"`
+	Streaks    FormStreaks `json:"streaks"`
+}
+
+type PointsPerGameTrend struct {
+	Overall float64                `json:"overall"`
+	Recent  float64                `json:"recent"`
+	Seasons []SeasonPointsPerGame  `json:"seasons"`
+	Rolling []RollingPointsPerGame `json:"rolling"`
+}
+
+type SeasonPointsPerGame struct {
+	SeasonID      *string `json:"season_id"`
+	SeasonName    *string `json:"season_name"`
+	Played        int     `json:"played"`
+	Points        int     `json:"points"`
+	PointsPerGame float64 `json:"points_per_game"`
+}
+
+// RollingPointsPerGame is the points per game over the window of results
+// ending with the match.
+type RollingPointsPerGame struct {
+	MatchID       string  `json:"match_id"`
+	MatchDate     string  `json:"match_date"`
+	PointsPerGame float64 `json:"points_per_game"`
+}
+
+type TeamFormRequest struct {
+	TeamID string `json:"team_id" validate:"required,uuid"`
+	Last   int    `json:"last" validate:"omitempty,min=1,max=50"`
+}
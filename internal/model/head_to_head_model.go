@@ -0,0 +1,50 @@
+package model
+
+type HeadToHeadResponse struct {
+	Team           TeamShort                `json:"team"`
+	Opponent       TeamShort                `json:"opponent"`
+	AllTime        HeadToHeadRecord         `json:"all_time"`
+	Seasons        []HeadToHeadSeasonRecord `json:"seasons"`
+	BiggestWins    []HeadToHeadMatch        `json:"biggest_wins"`
+	BiggestLosses  []HeadToHeadMatch        `json:"biggest_losses"`
+	RecentMeetings []HeadToHeadMatch        `json:"recent_meetings"`
+	TopScorers     []HeadToHeadScorer       `json:"top_scorers"`
+}
+
+// HeadToHeadRecord is seen from the requested team.
+type HeadToHeadRecord struct {
+	Played       int `json:"played"`
+	Wins         int `json:"wins"`
+	Draws        int `json:"draws"`
+	Losses       int `json:"losses"`
+	GoalsFor     int `json:"goals_for"`
+	GoalsAgainst int `json:"goals_against"`
+}
+
+type HeadToHeadSeasonRecord struct {
+	SeasonID   *string          `json:"season_id"`
+	SeasonName *string          `json:"season_name"`
+	Record     HeadToHeadRecord `json:"record"`
+}
+
+type HeadToHeadMatch struct {
+	MatchID       string    `json:"match_id"`
+	MatchDate     string    `json:"match_date"`
+	CompetitionID *string   `json:"competition_id"`
+	HomeTeam      TeamShort `json:"home_team"`
+	AwayTeam      TeamShort `json:"away_team"`
+	HomeScore     int       `json:"home_score"`
+	AwayScore     int       `json:"away_score"`
+}
+
+type HeadToHeadScorer struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	TeamID     string `json:"team_id"`
+	Goals      int    `json:"goals"`
+}
+
+type HeadToHeadRequest struct {
+	TeamID      string `json:"team_id" validate:"required,uuid"`
+	OtherTeamID string `json:"other_team_id" validate:"required,uuid,nefield=TeamID"`
+}
@@ -0,0 +1,117 @@
+package model
+
+import "time"
+
+// ImportRequest carries an uploaded CSV file. A dry run only validates the
+// rows; commit imports all of them or none.
+type ImportRequest struct {
+	Mode string `json:"mode" validate:"required,oneof=dry_run commit"`
+	File []byte `json:"-" validate:"required"`
+}
+
+type ImportResponse struct {
+	Mode     string            `json:"mode"`
+	Total    int               `json:"total"`
+	Valid    int               `json:"valid"`
+	Invalid  int               `json:"invalid"`
+	Imported int               `json:"imported"`
+	Rows     []ImportRowResult `json:"rows"`
+}
+
+// ImportRowResult reports one data row. Row is the line number in the file,
+// counting the header as line 1.
+type ImportRowResult struct {
+	Row    int              `json:"row"`
+	ID     *string          `json:"id,omitempty"`
+	Status string           `json:"status"`
+	Errors []ImportRowError `json:"errors"`
+}
+
+type ImportRowError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ImportRecord is one data line of an import file, keyed by column name.
+type ImportRecord struct {
+	Line   int
+	Values map[string]string
+}
+
+// ResultsImportRequest carries a file of past results in the
+// football-data.co.uk CSV or openfootball JSON format. The format is detected
+// from the file when not given. Kickoff times in the file are read in
+// Timezone.
+type ResultsImportRequest struct {
+	Mode          string `json:"mode" validate:"required,oneof=dry_run commit"`
+	File          []byte `json:"-" validate:"required"`
+	Format        string `json:"format" validate:"omitempty,oneof=football-data openfootball"`
+	CompetitionID string `json:"competition_id" validate:"omitempty,uuid"`
+	Timezone      string `json:"timezone" validate:"required"`
+	CreateTeams   bool   `json:"create_teams"`
+}
+
+type ResultsImportResponse struct {
+	Mode     string              `json:"mode"`
+	Format   string              `json:"format"`
+	Total    int                 `json:"total"`
+	Valid    int                 `json:"valid"`
+	Invalid  int                 `json:"invalid"`
+	Existing int                 `json:"existing"`
+	Imported int                 `json:"imported"`
+	Goals    int                 `json:"goals"`
+	Teams    []ResultsImportTeam `json:"teams"`
+	Rows     []ResultsImportRow  `json:"rows"`
+}
+
+// ResultsImportTeam shows which team a name in the file was matched to. Match
+// is exact, fuzzy, created or unmatched; created teams are placeholders.
+// Registered is set when the import registers the team in the competition,
+// which a dry run only reports.
+type ResultsImportTeam struct {
+	Name       string  `json:"name"`
+	TeamID     *string `json:"team_id"`
+	TeamName   *string `json:"team_name"`
+	Match      string  `json:"match"`
+	Score      float64 `json:"score"`
+	Registered bool    `json:"registered"`
+}
+
+// ResultsImportRow reports one match of the file. Status is valid, invalid,
+// existing or imported. Scorers that could not be matched to a player of
+// their team are listed in SkippedGoals.
+type ResultsImportRow struct {
+	Row          int              `json:"row"`
+	MatchDate    string           `json:"match_date"`
+	HomeTeam     string           `json:"home_team"`
+	AwayTeam     string           `json:"away_team"`
+	HomeScore    int              `json:"home_score"`
+	AwayScore    int              `json:"away_score"`
+	MatchID      *string          `json:"match_id,omitempty"`
+	Status       string           `json:"status"`
+	Goals        int              `json:"goals"`
+	SkippedGoals []string         `json:"skipped_goals"`
+	Errors       []ImportRowError `json:"errors"`
+}
+
+// HistoricalResult is one finished match read from a results file. Row is
+// the line of a CSV file or the position of the match in a JSON file.
+type HistoricalResult struct {
+	Row       int
+	Date      time.Time
+	Time      string
+	HomeTeam  string
+	AwayTeam  string
+	HomeScore int
+	AwayScore int
+	Matchday  *int
+	Goals     []HistoricalGoal
+}
+
+type HistoricalGoal struct {
+	Home    bool
+	Player  string
+	Minute  int
+	Offset  *int
+	OwnGoal bool
+}
@@ -0,0 +1,37 @@
+package model
+
+type InjuryResponse struct {
+	ID             string  `json:"id"`
+	PlayerID       string  `json:"player_id"`
+	InjuryType     string  `json:"injury_type"`
+	StartDate      string  `json:"start_date"`
+	ExpectedReturn *string `json:"expected_return"`
+	Notes          string  `json:"notes"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+	DeletedAt      *string `json:"deleted_at,omitempty"`
+}
+
+type InjuryRequestCreate struct {
+	PlayerID       string `json:"player_id" validate:"required,uuid"`
+	InjuryType     string `json:"injury_type" validate:"required,max=100"`
+	StartDate      string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	ExpectedReturn string `json:"expected_return" validate:"omitempty,datetime=2006-01-02"`
+	Notes          string `json:"notes" validate:"omitempty"`
+}
+
+type InjuryRequestUpdate struct {
+	ID             string `json:"id" validate:"required,uuid"`
+	InjuryType     string `json:"injury_type" validate:"omitempty,max=100"`
+	StartDate      string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	ExpectedReturn string `json:"expected_return" validate:"omitempty,datetime=2006-01-02"`
+	Notes          string `json:"notes" validate:"omitempty"`
+}
+
+type InjuryRequestFindByPlayerID struct {
+	PlayerID string `json:"player_id" validate:"required,uuid"`
+}
+
+type InjuryRequestSoftDelete struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
@@ -0,0 +1,31 @@
+package model
+
+type LeaderboardResponse struct {
+	SeasonID string             `json:"season_id"`
+	Board    string             `json:"board"`
+	Entries  []LeaderboardEntry `json:"entries"`
+}
+
+// LeaderboardEntry is one player on a leaderboard. Players on the same value
+// share a rank.
+type LeaderboardEntry struct {
+	Rank       int64   `json:"rank"`
+	PlayerID   string  `json:"player_id"`
+	PlayerName string  `json:"player_name"`
+	TeamID     string  `json:"team_id"`
+	TeamName   *string `json:"team_name"`
+	Value      int64   `json:"value"`
+}
+
+type LeaderboardRequest struct {
+	SeasonID string `json:"season_id" validate:"required,uuid"`
+	Board    string `json:"board" validate:"required,oneof=goals assists clean-sheets yellow-cards red-cards"`
+	Page     int    `json:"page" validate:"min=1"`
+	Limit    int    `json:"limit" validate:"min=1,max=100"`
+}
+
+// LeaderboardExportRequest selects a whole board; exports are not paged.
+type LeaderboardExportRequest struct {
+	SeasonID string `json:"season_id" validate:"required,uuid"`
+	Board    string `json:"board" validate:"required,oneof=goals assists clean-sheets yellow-cards red-cards"`
+}
@@ -0,0 +1,29 @@
+package model
+
+type LineupResponse struct {
+	ID            string          `json:"id"`
+	MatchID       string          `json:"match_id"`
+	TeamID        string          `json:"team_id"`
+	PlayerID      string          `json:"player_id"`
+	IsStarter     bool            `json:"is_starter"`
+	MinutesPlayed *int16          `json:"minutes_played"`
+	CreatedAt     string          `json:"created_at"`
+	UpdatedAt     string          `json:"updated_at"`
+	Player        *PlayerResponse `json:"player,omitempty"`
+}
+
+type LineupPlayerRequest struct {
+	PlayerID      string `json:"player_id" validate:"required,uuid"`
+	IsStarter     bool   `json:"is_starter"`
+	MinutesPlayed *int16 `json:"minutes_played" validate:"omitempty,min=0,max=130"`
+}
+
+type LineupRequestSubmit struct {
+	MatchID string                `json:"match_id" validate:"required,uuid"`
+	TeamID  string                `json:"team_id" validate:"required,uuid"`
+	Players []LineupPlayerRequest `json:"players" validate:"required,min=1,dive"`
+}
+
+type LineupRequestFindByMatchID struct {
+	MatchID string `json:"match_id" validate:"required,uuid"`
+}
@@ -0,0 +1,24 @@
+package model
+
+// MatchEvent is published when a match's kickoff changes so subscribers can
+// update calendars and notify fans.
+type MatchEvent struct {
+	Type            string  `json:"type"`
+	MatchID         string  `json:"match_id"`
+	CompetitionID   *string `json:"competition_id"`
+	HomeTeamID      string  `json:"home_team_id"`
+	AwayTeamID      string  `json:"away_team_id"`
+	Status          string  `json:"status"`
+	Reason          string  `json:"reason"`
+	OriginalKickoff string  `json:"original_kickoff"`
+	NewKickoff      *string `json:"new_kickoff"`
+	Time            string  `json:"time"`
+}
+
+func (e *MatchEvent) GetKey() string {
+	return e.MatchID
+}
+
+func (e *MatchEvent) GetId() int {
+	return 0
+}
@@ -1,40 +1,59 @@
 package model
 
 type MatchResponse struct {
-	ID         string        `json:"id"`
-	MatchDate  string        `json:"match_date"`
-	MatchTime  string        `json:"match_time"`
-	HomeTeamID string        `json:"home_team_id"`
-	AwayTeamID string        `json:"away_team_id"`
-	HomeScore  *int          `json:"home_score"`
-	AwayScore  *int          `json:"away_score"`
-	Status     string        `json:"status"`
-	CreatedAt  string        `json:"created_at"`
-	UpdatedAt  string        `json:"updated_at"`
-	DeletedAt  *string       `json:"deleted_at,omitempty"`
-	HomeTeam   *TeamResponse `json:"home_team"`
-	AwayTeam   *TeamResponse `json:"away_team"`
+	ID            string                    `json:"id"`
+	MatchDate     string                    `json:"match_date"`
+	MatchTime     string                    `json:"match_time"`
+	KickoffAt     string                    `json:"kickoff_at"`
+	Timezone      string                    `json:"timezone"`
+	VenueTimezone string                    `json:"venue_timezone"`
+	HomeTeamID    string                    `json:"home_team_id"`
+	AwayTeamID    string                    `json:"away_team_id"`
+	HomeScore     *int                      `json:"home_score"`
+	AwayScore     *int                      `json:"away_score"`
+	Status        string                    `json:"status"`
+	CompetitionID *string                   `json:"competition_id"`
+	Matchday      *int                      `json:"matchday"`
+	VenueID       *string                   `json:"venue_id"`
+	Attendance    *int                      `json:"attendance"`
+	CreatedAt     string                    `json:"created_at"`
+	UpdatedAt     string                    `json:"updated_at"`
+	DeletedAt     *string                   `json:"deleted_at,omitempty"`
+	HomeTeam      *TeamResponse             `json:"home_team"`
+	AwayTeam      *TeamResponse             `json:"away_team"`
+	Reschedules   []MatchRescheduleResponse `json:"reschedules,omitempty"`
 }
 
+// MatchRequestCreate and MatchRequestUpdate cannot complete a match; that only
+// happens through FinishMatch, which also applies suspensions, ratings and
+// records.
 type MatchRequestCreate struct {
-	MatchDate  string `json:"match_date" validate:"required"`
-	MatchTime  string `json:"match_time" validate:"required"`
-	HomeTeamID string `json:"home_team_id" validate:"required,uuid"`
-	AwayTeamID string `json:"away_team_id" validate:"required,uuid"`
-	HomeScore  *int   `json:"home_score" validate:"omitempty"`
-	AwayScore  *int   `json:"away_score" validate:"omitempty"`
-	Status     string `json:"status" validate:"required,oneof=scheduled completed canceled"`
+	MatchDate     string `json:"match_date" validate:"required"`
+	MatchTime     string `json:"match_time" validate:"required"`
+	HomeTeamID    string `json:"home_team_id" validate:"required,uuid"`
+	AwayTeamID    string `json:"away_team_id" validate:"required,uuid"`
+	HomeScore     *int   `json:"home_score" validate:"omitempty"`
+	AwayScore     *int   `json:"away_score" validate:"omitempty"`
+	Status        string `json:"status" validate:"required,oneof=scheduled canceled"`
+	CompetitionID string `json:"competition_id" validate:"omitempty,uuid"`
+	Matchday      *int   `json:"matchday" validate:"omitempty,min=1"`
+	VenueID       string `json:"venue_id" validate:"omitempty,uuid"`
+	Attendance    *int   `json:"attendance" validate:"omitempty,min=0"`
 }
 
 type MatchRequestUpdate struct {
-	ID         string `json:"id" validate:"required,uuid"`
-	MatchDate  string `json:"match_date" validate:"omitempty"`
-	MatchTime  string `json:"match_time" validate:"omitempty"`
-	HomeTeamID string `json:"home_team_id" validate:"omitempty,uuid"`
-	AwayTeamID string `json:"away_team_id" validate:"omitempty,uuid"`
-	HomeScore  *int   `json:"home_score" validate:"omitempty"`
-	AwayScore  *int   `json:"away_score" validate:"omitempty"`
-	Status     string `json:"status" validate:"omitempty,oneof=scheduled completed canceled"`
+	ID            string `json:"id" validate:"required,uuid"`
+	MatchDate     string `json:"match_date" validate:"omitempty"`
+	MatchTime     string `json:"match_time" validate:"omitempty"`
+	HomeTeamID    string `json:"home_team_id" validate:"omitempty,uuid"`
+	AwayTeamID    string `json:"away_team_id" validate:"omitempty,uuid"`
+	HomeScore     *int   `json:"home_score" validate:"omitempty"`
+	AwayScore     *int   `json:"away_score" validate:"omitempty"`
+	Status        string `json:"status" validate:"omitempty,oneof=scheduled canceled"`
+	CompetitionID string `json:"competition_id" validate:"omitempty,uuid"`
+	Matchday      *int   `json:"matchday" validate:"omitempty,min=1"`
+	VenueID       string `json:"venue_id" validate:"omitempty,uuid"`
+	Attendance    *int   `json:"attendance" validate:"omitempty,min=0"`
 }
 
 type MatchRequestFindByID struct {
@@ -45,19 +64,63 @@ type MatchRequestSoftDelete struct {
 	ID string `json:"id" validate:"required,uuid"`
 }
 
+type MatchRescheduleResponse struct {
+	ID            string  `json:"id"`
+	Reason        string  `json:"reason"`
+	OriginalDate  string  `json:"original_date"`
+	OriginalTime  string  `json:"original_time"`
+	NewDate       *string `json:"new_date"`
+	NewTime       *string `json:"new_time"`
+	PostponedAt   *string `json:"postponed_at"`
+	RescheduledAt *string `json:"rescheduled_at"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+type MatchRequestPostpone struct {
+	ID     string `json:"id" validate:"required,uuid"`
+	Reason string `json:"reason" validate:"required,max=255"`
+}
+
+// MatchRequestReschedule sets a new kickoff. The reason is only required when
+// the match was not postponed first.
+type MatchRequestReschedule struct {
+	ID        string `json:"id" validate:"required,uuid"`
+	MatchDate string `json:"match_date" validate:"required,datetime=2006-01-02"`
+	MatchTime string `json:"match_time" validate:"required"`
+	Reason    string `json:"reason" validate:"omitempty,max=255"`
+}
+
 type MatchReportResponse struct {
-	ID               string       `json:"id"`
-	MatchDate        string       `json:"match_date"`
-	MatchTime        string       `json:"match_time"`
-	HomeTeam         TeamShort    `json:"home_team"`
-	AwayTeam         TeamShort    `json:"away_team"`
-	HomeScore        int          `json:"home_score"`
-	AwayScore        int          `json:"away_score"`
-	StatusResult     string       `json:"status_result"`
-	Goals            []GoalReport `json:"goals"`
-	TopScorer        string       `json:"top_scorer"`
-	HomeTeamWinTotal int          `json:"home_team_win_total"`
-	AwayTeamWinTotal int          `json:"away_team_win_total"`
+	ID               string               `json:"id"`
+	MatchDate        string               `json:"match_date"`
+	MatchTime        string               `json:"match_time"`
+	KickoffAt        string               `json:"kickoff_at"`
+	Timezone         string               `json:"timezone"`
+	Status           string               `json:"status"`
+	HomeTeam         TeamShort            `json:"home_team"`
+	AwayTeam         TeamShort            `json:"away_team"`
+	HomeHeadCoach    *StaffShort          `json:"home_head_coach"`
+	AwayHeadCoach    *StaffShort          `json:"away_head_coach"`
+	HomeScore        *int                 `json:"home_score"`
+	AwayScore        *int                 `json:"away_score"`
+	HalfTimeScore    *ScoreReport         `json:"half_time_score"`
+	StatusResult     string               `json:"status_result"`
+	Goals            []GoalReport         `json:"goals"`
+	HomeGoals        TeamGoalsReport      `json:"home_goals"`
+	AwayGoals        TeamGoalsReport      `json:"away_goals"`
+	Braces           []ScorerReport       `json:"braces"`
+	HatTricks        []ScorerReport       `json:"hat_tricks"`
+	Comeback         *ComebackReport      `json:"comeback"`
+	HomeLineup       []LineupReport       `json:"home_lineup"`
+	AwayLineup       []LineupReport       `json:"away_lineup"`
+	Cards            []CardReport         `json:"cards"`
+	TopScorer        string               `json:"top_scorer"`
+	Narrative        MatchReportNarrative `json:"narrative"`
+	HomeTeamWinTotal int                  `json:"home_team_win_total"`
+	AwayTeamWinTotal int                  `json:"away_team_win_total"`
+	Officials        []OfficialReport     `json:"officials"`
+	HomeForm         TeamForm             `json:"home_form"`
+	AwayForm         TeamForm             `json:"away_form"`
 }
 
 type TeamShort struct {
@@ -66,11 +129,68 @@ type TeamShort struct {
 }
 
 type GoalReport struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	TeamID     string `json:"team_id"`
+	Minute     int16  `json:"minute"`
+	AddedTime  *int16 `json:"added_time,omitempty"`
+	Label      string `json:"label"`
+	Score      string `json:"score"`
+}
+
+type ScoreReport struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
+
+// TeamGoalsReport groups one side's goals and scorers.
+type TeamGoalsReport struct {
+	Team    TeamShort      `json:"team"`
+	Goals   []GoalReport   `json:"goals"`
+	Scorers []ScorerReport `json:"scorers"`
+}
+
+// ScorerReport is a player's goals in the match. Feat is "brace" for two
+// goals, "hat_trick" for three or more and empty otherwise.
+type ScorerReport struct {
+	PlayerID   string   `json:"player_id"`
+	PlayerName string   `json:"player_name"`
+	TeamID     string   `json:"team_id"`
+	Goals      int      `json:"goals"`
+	Minutes    []string `json:"minutes"`
+	Feat       string   `json:"feat"`
+}
+
+// ComebackReport is set when a side recovered from trailing to draw or win.
+type ComebackReport struct {
+	Team    TeamShort `json:"team"`
+	Deficit int       `json:"deficit"`
+	Result  string    `json:"result"`
+}
+
+type LineupReport struct {
+	PlayerID      string `json:"player_id"`
+	PlayerName    string `json:"player_name"`
+	JerseyNumber  int    `json:"jersey_number"`
+	Position      string `json:"position"`
+	IsStarter     bool   `json:"is_starter"`
+	MinutesPlayed *int16 `json:"minutes_played"`
+}
+
+type CardReport struct {
+	PlayerID   string `json:"player_id"`
 	PlayerName string `json:"player_name"`
 	TeamID     string `json:"team_id"`
+	CardType   string `json:"card_type"`
 	Minute     int16  `json:"minute"`
 }
 
+type MatchReportNarrative struct {
+	EN string `json:"en"`
+	ID string `json:"id"`
+}
+
 type MatchRequestFinish struct {
-	ID string `json:"id" validate:"required,uuid"`
+	ID         string `json:"id" validate:"required,uuid"`
+	Attendance *int   `json:"attendance" validate:"omitempty,min=0"`
 }
@@ -0,0 +1,76 @@
+package model
+
+type OfficialResponse struct {
+	ID              string      `json:"id"`
+	Name            string      `json:"name"`
+	Nationality     string      `json:"nationality"`
+	AffiliatedTeams []TeamShort `json:"affiliated_teams"`
+	CreatedAt       string      `json:"created_at"`
+	UpdatedAt       string      `json:"updated_at"`
+	DeletedAt       *string     `json:"deleted_at,omitempty"`
+}
+
+type OfficialRequestCreate struct {
+	Name              string   `json:"name" validate:"required,max=255"`
+	Nationality       string   `json:"nationality" validate:"omitempty,max=100"`
+	AffiliatedTeamIDs []string `json:"affiliated_team_ids" validate:"omitempty,dive,uuid"`
+}
+
+// OfficialRequestUpdate replaces the declared affiliations when
+// affiliated_team_ids is sent, including as an empty list.
+type OfficialRequestUpdate struct {
+	ID                string    `json:"id" validate:"required,uuid"`
+	Name              string    `json:"name" validate:"omitempty,max=255"`
+	Nationality       string    `json:"nationality" validate:"omitempty,max=100"`
+	AffiliatedTeamIDs *[]string `json:"affiliated_team_ids" validate:"omitempty,dive,uuid"`
+}
+
+type OfficialRequestFindByID struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type OfficialRequestSoftDelete struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type MatchOfficialResponse struct {
+	ID           string `json:"id"`
+	MatchID      string `json:"match_id"`
+	OfficialID   string `json:"official_id"`
+	OfficialName string `json:"official_name"`
+	Role         string `json:"role"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type MatchOfficialRequestAssign struct {
+	MatchID    string `json:"match_id" validate:"required,uuid"`
+	OfficialID string `json:"official_id" validate:"required,uuid"`
+	Role       string `json:"role" validate:"required,oneof=referee assistant_referee_1 assistant_referee_2 fourth_official"`
+}
+
+type MatchOfficialRequestFindByMatchID struct {
+	MatchID string `json:"match_id" validate:"required,uuid"`
+}
+
+type MatchOfficialRequestUnassign struct {
+	ID      string `json:"id" validate:"required,uuid"`
+	MatchID string `json:"match_id" validate:"required,uuid"`
+}
+
+type OfficialMatchResponse struct {
+	MatchID   string    `json:"match_id"`
+	MatchDate string    `json:"match_date"`
+	MatchTime string    `json:"match_time"`
+	Role      string    `json:"role"`
+	HomeTeam  TeamShort `json:"home_team"`
+	AwayTeam  TeamShort `json:"away_team"`
+	HomeScore *int      `json:"home_score"`
+	AwayScore *int      `json:"away_score"`
+	Status    string    `json:"status"`
+}
+
+type OfficialReport struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
@@ -8,6 +8,7 @@ type PlayerResponse struct {
 	Weight       float64       `json:"weight"`
 	Position     string        `json:"position"`
 	JerseyNumber int           `json:"jersey_number"`
+	DateOfBirth  *string       `json:"date_of_birth"`
 	CreatedAt    string        `json:"created_at"`
 	UpdatedAt    string        `json:"updated_at"`
 	DeletedAt    *string       `json:"deleted_at,omitempty"`
@@ -21,6 +22,7 @@ type PlayerRequestCreate struct {
 	Weight       float64 `json:"weight" validate:"required,gt=0"`
 	Position     string  `json:"position" validate:"required,oneof=penyerang gelandang bertahan penjaga_gawang"`
 	JerseyNumber int     `json:"jersey_number" validate:"required,min=1,max=99"`
+	DateOfBirth  string  `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"`
 }
 
 type PlayerRequestUpdate struct {
@@ -31,6 +33,7 @@ type PlayerRequestUpdate struct {
 	Weight       float64 `json:"weight" validate:"omitempty,gt=0"`
 	Position     string  `json:"position" validate:"omitempty,oneof=penyerang gelandang bertahan penjaga_gawang"`
 	JerseyNumber int     `json:"jersey_number" validate:"omitempty,min=1,max=99"`
+	DateOfBirth  string  `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"`
 }
 
 type PlayerRequestFindByID struct {
@@ -40,3 +43,51 @@ type PlayerRequestFindByID struct {
 type PlayerRequestSoftDelete struct {
 	ID string `json:"id" validate:"required,uuid"`
 }
+
+type PlayerComparisonRequest struct {
+	PlayerIDs     []string `json:"ids" validate:"required,min=2,max=5,dive,uuid"`
+	CompetitionID string   `json:"competition_id" validate:"omitempty,uuid"`
+	From          string   `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To            string   `json:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type PlayerComparisonResponse struct {
+	CompetitionID *string            `json:"competition_id"`
+	From          *string            `json:"from"`
+	To            *string            `json:"to"`
+	Players       []PlayerComparison `json:"players"`
+}
+
+type PlayerComparison struct {
+	PlayerID      string            `json:"player_id"`
+	Name          string            `json:"name"`
+	Team          *TeamShort        `json:"team"`
+	Position      string            `json:"position"`
+	Height        float64           `json:"height"`
+	Weight        float64           `json:"weight"`
+	Age           *int              `json:"age"`
+	Appearances   int               `json:"appearances"`
+	Starts        int               `json:"starts"`
+	Minutes       int               `json:"minutes"`
+	Goals         int               `json:"goals"`
+	Assists       int               `json:"assists"`
+	YellowCards   int               `json:"yellow_cards"`
+	RedCards      int               `json:"red_cards"`
+	GoalsPer90    *float64          `json:"goals_per_90"`
+	AssistsPer90  *float64          `json:"assists_per_90"`
+	CardsPer90    *float64          `json:"cards_per_90"`
+	PositionGroup int               `json:"position_group_size"`
+	Percentiles   PlayerPercentiles `json:"percentiles"`
+}
+
+// PlayerPercentiles ranks a player against the players of the same position
+// who appeared in the compared matches, from 0 (lowest) to 100 (highest).
+// Per-90 percentiles are nil when the player has no recorded minutes.
+type PlayerPercentiles struct {
+	Minutes      float64  `json:"minutes"`
+	Goals        float64  `json:"goals"`
+	Assists      float64  `json:"assists"`
+	GoalsPer90   *float64 `json:"goals_per_90"`
+	AssistsPer90 *float64 `json:"assists_per_90"`
+	CardsPer90   *float64 `json:"cards_per_90"`
+}
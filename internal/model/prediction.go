@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// PredictionConfig holds the settings of the match prediction model.
+type PredictionConfig struct {
+	// HalfLife is the age at which a past match counts half as much when the
+	// team strengths are fitted. Zero weighs every match equally.
+	HalfLife time.Duration
+	// RunHour is the hour of the day, in Timezone, the worker recomputes
+	// predictions at.
+	RunHour  int
+	Timezone string
+	// KickoffCheck is how often the worker predicts scheduled matches that
+	// have no prediction yet, so matches created between nightly runs are
+	// predicted before they kick off.
+	KickoffCheck time.Duration
+}
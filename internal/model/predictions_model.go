@@ -0,0 +1,51 @@
+package model
+
+type MatchPredictionResponse struct {
+	MatchID           string                 `json:"match_id"`
+	HomeTeam          TeamShort              `json:"home_team"`
+	AwayTeam          TeamShort              `json:"away_team"`
+	KickoffAt         string                 `json:"kickoff_at"`
+	HomeExpectedGoals float64                `json:"home_expected_goals"`
+	AwayExpectedGoals float64                `json:"away_expected_goals"`
+	HomeWin           float64                `json:"home_win"`
+	Draw              float64                `json:"draw"`
+	AwayWin           float64                `json:"away_win"`
+	Scorelines        []ScorelineProbability `json:"scorelines"`
+	TrainingMatches   int                    `json:"training_matches"`
+	ComputedAt        string                 `json:"computed_at"`
+}
+
+type ScorelineProbability struct {
+	HomeGoals   int     `json:"home_goals"`
+	AwayGoals   int     `json:"away_goals"`
+	Probability float64 `json:"probability"`
+}
+
+// PredictionCalibrationResponse compares past predictions with the results.
+// Lower Brier score and log loss are better.
+type PredictionCalibrationResponse struct {
+	SeasonID          *string             `json:"season_id"`
+	Matches           int                 `json:"matches"`
+	Accuracy          float64             `json:"accuracy"`
+	BrierScore        float64             `json:"brier_score"`
+	LogLoss           float64             `json:"log_loss"`
+	HomeExpectedGoals float64             `json:"home_expected_goals"`
+	HomeGoals         float64             `json:"home_goals"`
+	AwayExpectedGoals float64             `json:"away_expected_goals"`
+	AwayGoals         float64             `json:"away_goals"`
+	Buckets           []CalibrationBucket `json:"buckets"`
+}
+
+// CalibrationBucket groups every predicted outcome probability in [From, To)
+// and compares their mean with how often those outcomes happened.
+type CalibrationBucket struct {
+	From              float64 `json:"from"`
+	To                float64 `json:"to"`
+	Predictions       int     `json:"predictions"`
+	MeanProbability   float64 `json:"mean_probability"`
+	ObservedFrequency float64 `json:"observed_frequency"`
+}
+
+type PredictionCalibrationRequest struct {
+	SeasonID string `json:"season_id" validate:"omitempty,uuid"`
+}
@@ -0,0 +1,13 @@
+package model
+
+// RatingConfig holds the parameters of the Elo team ratings.
+type RatingConfig struct {
+	InitialRating float64
+	KFactor       float64
+	// HomeAdvantage is added to the home team's rating when the expected
+	// result is worked out.
+	HomeAdvantage float64
+	// GoalDiffMultipliers scale the K-factor by the winning margin, indexed by
+	// goal difference. Larger margins use the last multiplier.
+	GoalDiffMultipliers []float64
+}
@@ -0,0 +1,47 @@
+package model
+
+type TeamRatingResponse struct {
+	Rank   int       `json:"rank"`
+	Team   TeamShort `json:"team"`
+	Rating float64   `json:"rating"`
+}
+
+type TeamRatingHistoryResponse struct {
+	Team          TeamShort     `json:"team"`
+	CurrentRating float64       `json:"current_rating"`
+	History       []RatingPoint `json:"history"`
+}
+
+// RatingPoint is the team's rating after one match, in the order played.
+type RatingPoint struct {
+	MatchID       string    `json:"match_id"`
+	PlayedAt      string    `json:"played_at"`
+	Opponent      TeamShort `json:"opponent"`
+	RatingBefore  float64   `json:"rating_before"`
+	RatingAfter   float64   `json:"rating_after"`
+	Change        float64   `json:"change"`
+	ExpectedScore float64   `json:"expected_score"`
+}
+
+type SeasonRatingsResponse struct {
+	SeasonID   string               `json:"season_id"`
+	SeasonName string               `json:"season_name"`
+	Teams      []SeasonRatingChange `json:"teams"`
+}
+
+// SeasonRatingChange compares a team's rating going into the season with its
+// rating now.
+type SeasonRatingChange struct {
+	Team        TeamShort `json:"team"`
+	SeasonStart float64   `json:"season_start"`
+	Current     float64   `json:"current"`
+	Change      float64   `json:"change"`
+}
+
+type TeamRatingRequest struct {
+	TeamID string `json:"team_id" validate:"required,uuid"`
+}
+
+type SeasonRatingsRequest struct {
+	SeasonID string `json:"season_id" validate:"required,uuid"`
+}
@@ -0,0 +1,24 @@
+package model
+
+// RecordEvent is published when a milestone is detected, or when an existing
+// record grows, e.g. an unbeaten run is extended.
+type RecordEvent struct {
+	Type          string  `json:"type"`
+	RecordID      string  `json:"record_id"`
+	RecordType    string  `json:"record_type"`
+	CompetitionID *string `json:"competition_id"`
+	TeamID        *string `json:"team_id"`
+	PlayerID      *string `json:"player_id"`
+	MatchID       *string `json:"match_id"`
+	Value         int     `json:"value"`
+	Description   string  `json:"description"`
+	Time          string  `json:"time"`
+}
+
+func (e *RecordEvent) GetKey() string {
+	return e.RecordID
+}
+
+func (e *RecordEvent) GetId() int {
+	return 0
+}
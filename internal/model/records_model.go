@@ -0,0 +1,33 @@
+package model
+
+type RecordResponse struct {
+	ID              string                 `json:"id"`
+	Type            string                 `json:"type"`
+	Value           int                    `json:"value"`
+	Description     string                 `json:"description"`
+	CompetitionID   *string                `json:"competition_id"`
+	CompetitionName *string                `json:"competition_name"`
+	TeamID          *string                `json:"team_id"`
+	TeamName        *string                `json:"team_name"`
+	PlayerID        *string                `json:"player_id"`
+	PlayerName      *string                `json:"player_name"`
+	MatchID         *string                `json:"match_id"`
+	GoalID          *string                `json:"goal_id"`
+	Context         map[string]interface{} `json:"context"`
+	AchievedAt      string                 `json:"achieved_at"`
+	CreatedAt       string                 `json:"created_at"`
+	UpdatedAt       string                 `json:"updated_at"`
+}
+
+type RecordRequestFindAll struct {
+	Type          string `json:"type" validate:"omitempty,oneof=hat_trick goal_milestone biggest_win unbeaten_run fastest_goal"`
+	CompetitionID string `json:"competition_id" validate:"omitempty,uuid"`
+	TeamID        string `json:"team_id" validate:"omitempty,uuid"`
+	PlayerID      string `json:"player_id" validate:"omitempty,uuid"`
+	Page          int    `json:"page" validate:"min=1"`
+	Limit         int    `json:"limit" validate:"min=1,max=100"`
+}
+
+type RecordRequestFindByID struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// SchedulingConfig holds the defaults used when matches are booked.
+type SchedulingConfig struct {
+	VenueBuffer         time.Duration
+	DefaultMinRestHours int
+	// DefaultTimezone is used for matches without a venue.
+	DefaultTimezone string
+}
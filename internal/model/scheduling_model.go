@@ -0,0 +1,88 @@
+package model
+
+type SchedulingRuleResponse struct {
+	CompetitionID string                 `json:"competition_id"`
+	MinRestHours  int                    `json:"min_rest_hours"`
+	BlackoutDates []BlackoutDateResponse `json:"blackout_dates"`
+}
+
+type BlackoutDateResponse struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
+
+type SchedulingRuleRequestFindByCompetitionID struct {
+	CompetitionID string `json:"competition_id" validate:"required,uuid"`
+}
+
+// SchedulingRuleRequestUpdate only changes the fields that are sent. Sending
+// blackout_dates replaces the whole list, an empty list clears it.
+type SchedulingRuleRequestUpdate struct {
+	CompetitionID string                 `json:"competition_id" validate:"required,uuid"`
+	MinRestHours  *int                   `json:"min_rest_hours" validate:"omitempty,min=0,max=720"`
+	BlackoutDates *[]BlackoutDateRequest `json:"blackout_dates" validate:"omitempty,dive"`
+}
+
+type BlackoutDateRequest struct {
+	Date   string `json:"date" validate:"required,datetime=2006-01-02"`
+	Reason string `json:"reason" validate:"omitempty,max=255"`
+}
+
+type VenueUnavailabilityResponse struct {
+	ID            string  `json:"id"`
+	VenueID       string  `json:"venue_id"`
+	CompetitionID *string `json:"competition_id"`
+	StartDate     string  `json:"start_date"`
+	EndDate       string  `json:"end_date"`
+	Reason        string  `json:"reason"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+// VenueUnavailabilityRequestCreate blocks the venue for every competition unless
+// competition_id is given.
+type VenueUnavailabilityRequestCreate struct {
+	VenueID       string `json:"venue_id" validate:"required,uuid"`
+	CompetitionID string `json:"competition_id" validate:"omitempty,uuid"`
+	StartDate     string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate       string `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Reason        string `json:"reason" validate:"omitempty,max=255"`
+}
+
+type VenueUnavailabilityRequestFindByVenueID struct {
+	VenueID string `json:"venue_id" validate:"required,uuid"`
+}
+
+type VenueUnavailabilityRequestSoftDelete struct {
+	ID      string `json:"id" validate:"required,uuid"`
+	VenueID string `json:"venue_id" validate:"required,uuid"`
+}
+
+type FixtureRequestGenerate struct {
+	CompetitionID     string `json:"competition_id" validate:"required,uuid"`
+	StartDate         string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	MatchTime         string `json:"match_time" validate:"required"`
+	DaysBetweenRounds int    `json:"days_between_rounds" validate:"omitempty,min=1,max=60"`
+	DoubleRoundRobin  bool   `json:"double_round_robin"`
+}
+
+// SchedulingViolation describes one broken scheduling constraint. Type is one of
+// same_day, rest, blackout, venue_unavailable or venue_booked.
+type SchedulingViolation struct {
+	Type         string  `json:"type"`
+	Message      string  `json:"message"`
+	TeamID       *string `json:"team_id,omitempty"`
+	OtherMatchID *string `json:"other_match_id,omitempty"`
+	VenueID      *string `json:"venue_id,omitempty"`
+}
+
+type SchedulingConflictResponse struct {
+	MatchID       string                `json:"match_id"`
+	CompetitionID *string               `json:"competition_id"`
+	Matchday      *int                  `json:"matchday"`
+	MatchDate     string                `json:"match_date"`
+	MatchTime     string                `json:"match_time"`
+	HomeTeam      TeamShort             `json:"home_team"`
+	AwayTeam      TeamShort             `json:"away_team"`
+	Violations    []SchedulingViolation `json:"violations"`
+}
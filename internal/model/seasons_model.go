@@ -0,0 +1,33 @@
+package model
+
+type SeasonResponse struct {
+	ID           string                `json:"id"`
+	Name         string                `json:"name"`
+	StartDate    string                `json:"start_date"`
+	EndDate      string                `json:"end_date"`
+	CreatedAt    string                `json:"created_at"`
+	UpdatedAt    string                `json:"updated_at"`
+	DeletedAt    *string               `json:"deleted_at,omitempty"`
+	Competitions []CompetitionResponse `json:"competitions,omitempty"`
+}
+
+type SeasonRequestCreate struct {
+	Name      string `json:"name" validate:"required,max=100"`
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+type SeasonRequestUpdate struct {
+	ID        string `json:"id" validate:"required,uuid"`
+	Name      string `json:"name" validate:"omitempty,max=100"`
+	StartDate string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type SeasonRequestFindByID struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type SeasonRequestSoftDelete struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
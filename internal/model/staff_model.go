@@ -0,0 +1,47 @@
+package model
+
+type StaffResponse struct {
+	ID          string  `json:"id"`
+	TeamID      string  `json:"team_id"`
+	Name        string  `json:"name"`
+	Role        string  `json:"role"`
+	Nationality string  `json:"nationality"`
+	StartDate   string  `json:"start_date"`
+	EndDate     *string `json:"end_date"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	DeletedAt   *string `json:"deleted_at,omitempty"`
+}
+
+type StaffRequestCreate struct {
+	TeamID      string `json:"team_id" validate:"required,uuid"`
+	Name        string `json:"name" validate:"required,max=255"`
+	Role        string `json:"role" validate:"required,oneof=head_coach assistant_coach goalkeeper_coach physio"`
+	Nationality string `json:"nationality" validate:"omitempty,max=100"`
+	StartDate   string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate     string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type StaffRequestUpdate struct {
+	ID          string `json:"id" validate:"required,uuid"`
+	TeamID      string `json:"team_id" validate:"required,uuid"`
+	Name        string `json:"name" validate:"omitempty,max=255"`
+	Role        string `json:"role" validate:"omitempty,oneof=head_coach assistant_coach goalkeeper_coach physio"`
+	Nationality string `json:"nationality" validate:"omitempty,max=100"`
+	StartDate   string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate     string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type StaffRequestFindByTeamID struct {
+	TeamID string `json:"team_id" validate:"required,uuid"`
+}
+
+type StaffRequestSoftDelete struct {
+	ID     string `json:"id" validate:"required,uuid"`
+	TeamID string `json:"team_id" validate:"required,uuid"`
+}
+
+type StaffShort struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
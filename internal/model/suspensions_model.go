@@ -0,0 +1,43 @@
+package model
+
+type SuspensionResponse struct {
+	ID               string  `json:"id"`
+	PlayerID         string  `json:"player_id"`
+	CompetitionID    *string `json:"competition_id"`
+	MatchID          *string `json:"match_id"`
+	Reason           string  `json:"reason"`
+	MatchesRemaining int     `json:"matches_remaining"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+	DeletedAt        *string `json:"deleted_at,omitempty"`
+}
+
+type SuspensionRequestCreate struct {
+	PlayerID         string `json:"player_id" validate:"required,uuid"`
+	CompetitionID    string `json:"competition_id" validate:"omitempty,uuid"`
+	Reason           string `json:"reason" validate:"required,max=255"`
+	MatchesRemaining int    `json:"matches_remaining" validate:"required,min=1"`
+}
+
+type SuspensionRequestFindByPlayerID struct {
+	PlayerID string `json:"player_id" validate:"required,uuid"`
+}
+
+type SuspensionRequestSoftDelete struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type AvailabilityResponse struct {
+	PlayerID    string               `json:"player_id"`
+	Date        string               `json:"date"`
+	Available   bool                 `json:"available"`
+	Reasons     []string             `json:"reasons"`
+	Injuries    []InjuryResponse     `json:"injuries"`
+	Suspensions []SuspensionResponse `json:"suspensions"`
+}
+
+type AvailabilityRequest struct {
+	PlayerID      string `json:"player_id" validate:"required,uuid"`
+	Date          string `json:"date" validate:"required,datetime=2006-01-02"`
+	CompetitionID string `json:"competition_id" validate:"omitempty,uuid"`
+}
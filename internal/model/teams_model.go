@@ -1,5 +1,7 @@
 package model
 
+// TeamResponse.Placeholder is set for teams created by a results import with
+// only a name, until their founded year and headquarters are filled in.
 type TeamResponse struct {
 	ID                  string            `json:"id"`
 	Name                string            `json:"name"`
@@ -7,10 +9,13 @@ type TeamResponse struct {
 	FoundedYear         int               `json:"founded_year"`
 	HeadquartersAddress string            `json:"headquarters_address"`
 	HeadquartersCity    string            `json:"headquarters_city"`
+	HomeVenueID         *string           `json:"home_venue_id"`
+	Placeholder         bool              `json:"placeholder"`
 	CreatedAt           string            `json:"created_at"`
 	UpdatedAt           string            `json:"updated_at"`
 	DeletedAt           *string           `json:"deleted_at,omitempty"`
 	Players             []*PlayerResponse `json:"players,omitempty"`
+	Staff               []*StaffResponse  `json:"staff,omitempty"`
 }
 
 type TeamRequestCreate struct {
@@ -19,6 +24,7 @@ type TeamRequestCreate struct {
 	FoundedYear         int    `json:"founded_year" validate:"required"`
 	HeadquartersAddress string `json:"headquarters_address" validate:"required"`
 	HeadquartersCity    string `json:"headquarters_city" validate:"required"`
+	HomeVenueID         string `json:"home_venue_id" validate:"omitempty,uuid"`
 }
 
 type TeamRequestUpdate struct {
@@ -28,10 +34,16 @@ type TeamRequestUpdate struct {
 	FoundedYear         int    `json:"founded_year" validate:"omitempty"`
 	HeadquartersAddress string `json:"headquarters_address" validate:"omitempty"`
 	HeadquartersCity    string `json:"headquarters_city" validate:"omitempty"`
+	HomeVenueID         string `json:"home_venue_id" validate:"omitempty,uuid"`
+}
+
+type TeamRequestFindAll struct {
+	IncludeStaff bool `json:"include_staff"`
 }
 
 type TeamRequestFindByID struct {
-	ID string `json:"id" validate:"required,uuid"`
+	ID           string `json:"id" validate:"required,uuid"`
+	IncludeStaff bool   `json:"include_staff"`
 }
 
 type TeamRequestSoftDelete struct {
@@ -0,0 +1,44 @@
+package model
+
+type VenueResponse struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	City        string   `json:"city"`
+	Capacity    int      `json:"capacity"`
+	SurfaceType string   `json:"surface_type"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+	Timezone    string   `json:"timezone"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	DeletedAt   *string  `json:"deleted_at,omitempty"`
+}
+
+type VenueRequestCreate struct {
+	Name        string   `json:"name" validate:"required,max=255"`
+	City        string   `json:"city" validate:"required,max=100"`
+	Capacity    int      `json:"capacity" validate:"min=0"`
+	SurfaceType string   `json:"surface_type" validate:"required,oneof=natural_grass artificial_turf hybrid"`
+	Latitude    *float64 `json:"latitude" validate:"omitempty,latitude"`
+	Longitude   *float64 `json:"longitude" validate:"omitempty,longitude"`
+	Timezone    string   `json:"timezone" validate:"omitempty,timezone"`
+}
+
+type VenueRequestUpdate struct {
+	ID          string   `json:"id" validate:"required,uuid"`
+	Name        string   `json:"name" validate:"omitempty,max=255"`
+	City        string   `json:"city" validate:"omitempty,max=100"`
+	Capacity    *int     `json:"capacity" validate:"omitempty,min=0"`
+	SurfaceType string   `json:"surface_type" validate:"omitempty,oneof=natural_grass artificial_turf hybrid"`
+	Latitude    *float64 `json:"latitude" validate:"omitempty,latitude"`
+	Longitude   *float64 `json:"longitude" validate:"omitempty,longitude"`
+	Timezone    string   `json:"timezone" validate:"omitempty,timezone"`
+}
+
+type VenueRequestFindByID struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type VenueRequestSoftDelete struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ArchiveRepository reads the rows that make up a league archive. Each Find
+// method streams the rows of one season, or of the whole database when
+// seasonID is empty, batchSize at a time. Deleted rows are left out.
+type ArchiveRepository interface {
+	FindSeasons(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Season) error) error
+	FindCompetitions(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Competition) error) error
+	FindCompetitionTeams(db *gorm.DB, seasonID string) ([]entity.CompetitionTeam, error)
+	FindVenues(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Venue) error) error
+	FindTeams(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Team) error) error
+	FindPlayers(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Player) error) error
+	FindMatches(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Match) error) error
+	FindGoals(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Goal) error) error
+	CountLeagueRows(db *gorm.DB) (int64, error)
+}
+
+type archiveRepoImpl struct {
+	Log *logrus.Logger
+}
+
+func NewArchiveRepo(log *logrus.Logger) ArchiveRepository {
+	return &archiveRepoImpl{
+		Log: log,
+	}
+}
+
+// findArchiveRows streams the rows of T whose ID is in scope, or every row
+// when scope is nil.
+func findArchiveRows[T any](db *gorm.DB, scope *gorm.DB, batchSize int, fn func([]T) error) error {
+	var batch []T
+	query := db.Model(&batch).Where("deleted_at IS NULL")
+	if scope != nil {
+		query = query.Where("id IN (?)", scope)
+	}
+	return query.FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
+}
+
+// The scope functions select the IDs belonging to a season: its competitions
+// and their matches and goals, the teams registered or playing in them along
+// with the teams of every scorer and assistant, and the venues those matches
+// and teams use.
+
+func seasonCompetitionIDs(db *gorm.DB, seasonID string) *gorm.DB {
+	return db.Model(&entity.Competition{}).Select("id").Where("season_id = ? AND deleted_at IS NULL", seasonID)
+}
+
+func seasonMatchIDs(db *gorm.DB, seasonID string) *gorm.DB {
+	return db.Model(&entity.Match{}).Select("id").Where("competition_id IN (?) AND deleted_at IS NULL", seasonCompetitionIDs(db, seasonID))
+}
+
+func seasonGoalIDs(db *gorm.DB, seasonID string) *gorm.DB {
+	return db.Model(&entity.Goal{}).Select("id").Where("match_id IN (?) AND deleted_at IS NULL", seasonMatchIDs(db, seasonID))
+}
+
+func seasonTeamIDs(db *gorm.DB, seasonID string) *gorm.DB {
+	registered := db.Model(&entity.CompetitionTeam{}).Select("team_id").Where("competition_id IN (?)", seasonCompetitionIDs(db, seasonID))
+	home := db.Model(&entity.Match{}).Select("home_team_id").Where("id IN (?)", seasonMatchIDs(db, seasonID))
+	away := db.Model(&entity.Match{}).Select("away_team_id").Where("id IN (?)", seasonMatchIDs(db, seasonID))
+	scorers := db.Model(&entity.Goal{}).Select("player_id").Where("id IN (?)", seasonGoalIDs(db, seasonID))
+	assistants := db.Model(&entity.Goal{}).Select("assist_player_id").Where("id IN (?) AND assist_player_id IS NOT NULL", seasonGoalIDs(db, seasonID))
+	involved := db.Model(&entity.Player{}).Select("team_id").Where("id IN (?) OR id IN (?)", scorers, assistants)
+	return db.Model(&entity.Team{}).Select("id").
+		Where("id IN (?) OR id IN (?) OR id IN (?) OR id IN (?)", registered, home, away, involved)
+}
+
+func (a *archiveRepoImpl) FindSeasons(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Season) error) error {
+	var scope *gorm.DB
+	if seasonID != "" {
+		scope = db.Model(&entity.Season{}).Select("id").Where("id = ?", seasonID)
+	}
+	if err := findArchiveRows(db, scope, batchSize, fn); err != nil {
+		a.Log.Errorf("Failed to find archive seasons: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (a *archiveRepoImpl) FindCompetitions(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Competition) error) error {
+	var scope *gorm.DB
+	if seasonID != "" {
+		scope = seasonCompetitionIDs(db, seasonID)
+	}
+	if err := findArchiveRows(db, scope, batchSize, fn); err != nil {
+		a.Log.Errorf("Failed to find archive competitions: %v", err)
+		return err
+	}
+	return nil
+}
+
+// FindCompetitionTeams returns the team registrations of the archived
+// competitions.
+func (a *archiveRepoImpl) FindCompetitionTeams(db *gorm.DB, seasonID string) ([]entity.CompetitionTeam, error) {
+	var registrations []entity.CompetitionTeam
+	query := db.Model(&registrations).Where("competition_id IN (?)", db.Model(&entity.Competition{}).Select("id").Where("deleted_at IS NULL"))
+	if seasonID != "" {
+		query = query.Where("competition_id IN (?)", seasonCompetitionIDs(db, seasonID))
+	}
+	if err := query.Order("competition_id, team_id").Find(&registrations).Error; err != nil {
+		a.Log.Errorf("Failed to find archive competition teams: %v", err)
+		return nil, err
+	}
+	return registrations, nil
+}
+
+func (a *archiveRepoImpl) FindVenues(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Venue) error) error {
+	var scope *gorm.DB
+	if seasonID != "" {
+		matchVenues := db.Model(&entity.Match{}).Select("venue_id").Where("id IN (?) AND venue_id IS NOT NULL", seasonMatchIDs(db, seasonID))
+		homeVenues := db.Model(&entity.Team{}).Select("home_venue_id").Where("id IN (?) AND home_venue_id IS NOT NULL", seasonTeamIDs(db, seasonID))
+		scope = db.Model(&entity.Venue{}).Select("id").Where("id IN (?) OR id IN (?)", matchVenues, homeVenues)
+	}
+	if err := findArchiveRows(db, scope, batchSize, fn); err != nil {
+		a.Log.Errorf("Failed to find archive venues: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (a *archiveRepoImpl) FindTeams(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Team) error) error {
+	var scope *gorm.DB
+	if seasonID != "" {
+		scope = seasonTeamIDs(db, seasonID)
+	}
+	if err := findArchiveRows(db, scope, batchSize, fn); err != nil {
+		a.Log.Errorf("Failed to find archive teams: %v", err)
+		return err
+	}
+	return nil
+}
+
+// FindPlayers returns the current squads of the archived teams.
+func (a *archiveRepoImpl) FindPlayers(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Player) error) error {
+	var scope *gorm.DB
+	if seasonID != "" {
+		scope = db.Model(&entity.Player{}).Select("id").Where("team_id IN (?)", seasonTeamIDs(db, seasonID))
+	}
+	if err := findArchiveRows(db, scope, batchSize, fn); err != nil {
+		a.Log.Errorf("Failed to find archive players: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (a *archiveRepoImpl) FindMatches(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Match) error) error {
+	var scope *gorm.DB
+	if seasonID != "" {
+		scope = seasonMatchIDs(db, seasonID)
+	}
+	if err := findArchiveRows(db, scope, batchSize, fn); err != nil {
+		a.Log.Errorf("Failed to find archive matches: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (a *archiveRepoImpl) FindGoals(db *gorm.DB, seasonID string, batchSize int, fn func([]entity.Goal) error) error {
+	var scope *gorm.DB
+	if seasonID != "" {
+		scope = seasonGoalIDs(db, seasonID)
+	}
+	if err := findArchiveRows(db, scope, batchSize, fn); err != nil {
+		a.Log.Errorf("Failed to find archive goals: %v", err)
+		return err
+	}
+	return nil
+}
+
+// CountLeagueRows counts the rows, deleted or not, of every table an archive
+// restores into. Restoring needs it to be zero.
+func (a *archiveRepoImpl) CountLeagueRows(db *gorm.DB) (int64, error) {
+	var total int64
+	for _, table := range []any{&entity.Season{}, &entity.Competition{}, &entity.CompetitionTeam{}, &entity.Venue{}, &entity.Team{}, &entity.Player{}, &entity.Match{}, &entity.Goal{}} {
+		var count int64
+		if err := db.Model(table).Count(&count).Error; err != nil {
+			a.Log.Errorf("Failed to count rows of %T: %v", table, err)
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
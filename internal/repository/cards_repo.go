@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CardsRepository interface {
+	Repository[entity.MatchCard]
+	FindByMatchID(db *gorm.DB, matchID string) ([]entity.MatchCard, error)
+	CountAccumulatedYellows(db *gorm.DB, playerID string, competitionID *string, afterMatchday, uptoMatchday *int) (int64, error)
+	CountByTeamAndCompetition(db *gorm.DB, competitionID string) ([]TeamCardCount, error)
+}
+
+type TeamCardCount struct {
+	TeamID      string
+	YellowCards int64
+	RedCards    int64
+}
+
+type cardsRepoImpl struct {
+	Repository[entity.MatchCard]
+	Log *logrus.Logger
+}
+
+func NewCardsRepo(db *gorm.DB, log *logrus.Logger) CardsRepository {
+	return &cardsRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.MatchCard](db),
+	}
+}
+
+func (c *cardsRepoImpl) FindByMatchID(db *gorm.DB, matchID string) ([]entity.MatchCard, error) {
+	var cards []entity.MatchCard
+	if err := db.Preload("Player").Where("match_id = ? AND deleted_at IS NULL", matchID).Order("minute ASC").Find(&cards).Error; err != nil {
+		c.Log.Errorf("Failed to find cards by match ID %s: %v", matchID, err)
+		return nil, err
+	}
+	return cards, nil
+}
+
+// CountAccumulatedYellows counts a player's yellow cards across matches of the
+// same competition, leaving out matches where the player was sent off after two
+// yellows. A nil competition counts cards from matches outside any competition.
+// afterMatchday and uptoMatchday optionally limit the count to a matchday window.
+func (c *cardsRepoImpl) CountAccumulatedYellows(db *gorm.DB, playerID string, competitionID *string, afterMatchday, uptoMatchday *int) (int64, error) {
+	var count int64
+	dismissals := db.Model(&entity.MatchCard{}).Select("match_id").
+		Where("player_id = ? AND card_type = 'yellow' AND deleted_at IS NULL", playerID).
+		Group("match_id").
+		Having("COUNT(*) >= 2")
+	query := db.Model(&entity.MatchCard{}).
+		Joins("JOIN matches m ON m.id = match_cards.match_id").
+		Where("match_cards.player_id = ? AND match_cards.card_type = 'yellow'", playerID).
+		Where("match_cards.match_id NOT IN (?)", dismissals).
+		Where("match_cards.deleted_at IS NULL AND m.deleted_at IS NULL").
+		Where("m.competition_id IS NOT DISTINCT FROM ?", competitionID)
+	if afterMatchday != nil {
+		query = query.Where("m.matchday > ?", *afterMatchday)
+	}
+	if uptoMatchday != nil {
+		query = query.Where("m.matchday <= ?", *uptoMatchday)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		c.Log.Errorf("Failed to count yellow cards for player %s: %v", playerID, err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountByTeamAndCompetition totals yellow and red cards per team over the
+// competition's completed matches. Cards are credited to the side the player
+// is in the lineup for, or to the player's current team when the match has no
+// lineup for them and that team played in it.
+func (c *cardsRepoImpl) CountByTeamAndCompetition(db *gorm.DB, competitionID string) ([]TeamCardCount, error) {
+	const side = "COALESCE(l.team_id, p.team_id)"
+	var counts []TeamCardCount
+	if err := db.Model(&entity.MatchCard{}).
+		Select(side+" AS team_id, "+
+			"COUNT(*) FILTER (WHERE match_cards.card_type = 'yellow') AS yellow_cards, "+
+			"COUNT(*) FILTER (WHERE match_cards.card_type = 'red') AS red_cards").
+		Joins("JOIN matches m ON m.id = match_cards.match_id").
+		Joins("JOIN players p ON p.id = match_cards.player_id").
+		Joins("LEFT JOIN match_lineups l ON l.match_id = match_cards.match_id AND l.player_id = match_cards.player_id AND l.deleted_at IS NULL").
+		Where("m.competition_id = ? AND m.status = ?", competitionID, "completed").
+		Where(side + " IN (m.home_team_id, m.away_team_id)").
+		Where("match_cards.deleted_at IS NULL AND m.deleted_at IS NULL").
+		Group(side).
+		Scan(&counts).Error; err != nil {
+		c.Log.Errorf("Failed to count cards per team for competition %s: %v", competitionID, err)
+		return nil, err
+	}
+	return counts, nil
+}
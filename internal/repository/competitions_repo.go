@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CompetitionsRepository interface {
+	Repository[entity.Competition]
+	RegisterTeam(db *gorm.DB, competitionID, teamID string) error
+	CheckTeamRegistered(db *gorm.DB, competitionID, teamID string) (bool, error)
+	FindIneligiblePlayers(db *gorm.DB, competitionID string, bornOnOrAfter time.Time) ([]entity.Player, error)
+}
+
+type competitionsRepoImpl struct {
+	Repository[entity.Competition]
+	Log *logrus.Logger
+}
+
+func NewCompetitionsRepo(db *gorm.DB, log *logrus.Logger) CompetitionsRepository {
+	return &competitionsRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.Competition](db),
+	}
+}
+
+func (c *competitionsRepoImpl) RegisterTeam(db *gorm.DB, competitionID, teamID string) error {
+	registration := &entity.CompetitionTeam{
+		CompetitionID: competitionID,
+		TeamID:        teamID,
+	}
+	if err := db.Create(registration).Error; err != nil {
+		c.Log.Errorf("Failed to register team %s in competition %s: %v", teamID, competitionID, err)
+		return err
+	}
+	return nil
+}
+
+func (c *competitionsRepoImpl) CheckTeamRegistered(db *gorm.DB, competitionID, teamID string) (bool, error) {
+	var count int64
+	if err := db.Model(&entity.CompetitionTeam{}).Where("competition_id = ? AND team_id = ?", competitionID, teamID).Count(&count).Error; err != nil {
+		c.Log.Errorf("Failed to check team %s registration in competition %s: %v", teamID, competitionID, err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindIneligiblePlayers returns active players of the competition's registered teams
+// who were born before the cut-off date or have no date of birth recorded.
+func (c *competitionsRepoImpl) FindIneligiblePlayers(db *gorm.DB, competitionID string, bornOnOrAfter time.Time) ([]entity.Player, error) {
+	var players []entity.Player
+	if err := db.Preload("Team").
+		Joins("JOIN competition_teams ct ON ct.team_id = players.team_id").
+		Where("ct.competition_id = ? AND players.deleted_at IS NULL", competitionID).
+		Where("(players.date_of_birth IS NULL OR players.date_of_birth < ?)", bornOnOrAfter).
+		Order("players.team_id, players.jersey_number").
+		Find(&players).Error; err != nil {
+		c.Log.Errorf("Failed to find ineligible players for competition %s: %v", competitionID, err)
+		return nil, err
+	}
+	return players, nil
+}
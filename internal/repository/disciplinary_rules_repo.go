@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DisciplinaryRulesRepository interface {
+	FindByCompetitionID(db *gorm.DB, competitionID string) (*entity.CompetitionDisciplinaryRule, error)
+	Save(db *gorm.DB, rule *entity.CompetitionDisciplinaryRule) error
+}
+
+type disciplinaryRulesRepoImpl struct {
+	DB  *gorm.DB
+	Log *logrus.Logger
+}
+
+func NewDisciplinaryRulesRepo(db *gorm.DB, log *logrus.Logger) DisciplinaryRulesRepository {
+	return &disciplinaryRulesRepoImpl{
+		DB:  db,
+		Log: log,
+	}
+}
+
+func (d *disciplinaryRulesRepoImpl) FindByCompetitionID(db *gorm.DB, competitionID string) (*entity.CompetitionDisciplinaryRule, error) {
+	var rule entity.CompetitionDisciplinaryRule
+	if err := db.Where("competition_id = ?", competitionID).First(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// Save creates or replaces the competition's rules.
+func (d *disciplinaryRulesRepoImpl) Save(db *gorm.DB, rule *entity.CompetitionDisciplinaryRule) error {
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "competition_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"yellow_cards_per_ban", "yellow_accumulation_ban", "straight_red_ban", "second_yellow_ban", "yellow_reset_after_matchday", "yellow_card_points", "red_card_points", "updated_at"}),
+	}).Create(rule).Error; err != nil {
+		d.Log.Errorf("Failed to save disciplinary rules for competition %s: %v", rule.CompetitionID, err)
+		return err
+	}
+	return nil
+}
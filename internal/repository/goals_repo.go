@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"database/sql"
+	"time"
+
 	"github.com/Fadlihardiyanto/football-api/internal/entity"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -8,6 +11,29 @@ import (
 
 type GoalsRepository interface {
 	Repository[entity.Goal]
+	FindTeamGoalTimings(tx *gorm.DB, teamID, seasonID string) ([]GoalTiming, error)
+	FindTeamSeasonScorers(tx *gorm.DB, teamID, seasonID string, limit int) ([]SeasonScorer, error)
+}
+
+type SeasonScorer struct {
+	PlayerID   string
+	PlayerName string
+	Goals      int
+}
+
+// GoalTiming is one goal in a completed match, with the team of its scorer.
+type GoalTiming struct {
+	MatchID      string
+	SeasonID     *string
+	SeasonName   *string
+	KickoffAt    time.Time
+	HomeTeamID   string
+	AwayTeamID   string
+	HomeScore    int
+	AwayScore    int
+	ScorerTeamID string
+	GoalTime     int16
+	AddedTime    *int16
 }
 
 type goalsRepoImpl struct {
@@ -21,3 +47,53 @@ func NewGoalsRepo(db *gorm.DB, log *logrus.Logger) GoalsRepository {
 		Repository: NewRepository[entity.Goal](db),
 	}
 }
+
+// FindTeamGoalTimings returns the goals of the team's completed matches in the
+// order they were scored, limited to the season when one is given.
+func (r *goalsRepoImpl) FindTeamGoalTimings(tx *gorm.DB, teamID, seasonID string) ([]GoalTiming, error) {
+	query := tx.Table("goals g").
+		Select("g.match_id AS match_id, c.season_id AS season_id, s.name AS season_name, m.kickoff_at AS kickoff_at, "+
+			"m.home_team_id AS home_team_id, m.away_team_id AS away_team_id, "+
+			"COALESCE(m.home_score, 0) AS home_score, COALESCE(m.away_score, 0) AS away_score, "+
+			"p.team_id AS scorer_team_id, g.goal_time AS goal_time, g.added_time AS added_time").
+		Joins("JOIN matches m ON m.id = g.match_id").
+		Joins("JOIN players p ON p.id = g.player_id").
+		Joins("LEFT JOIN competitions c ON c.id = m.competition_id").
+		Joins("LEFT JOIN seasons s ON s.id = c.season_id").
+		Where("g.deleted_at IS NULL AND m.deleted_at IS NULL AND m.status = ?", "completed").
+		Where("m.home_team_id = @team OR m.away_team_id = @team", sql.Named("team", teamID))
+	if seasonID != "" {
+		query = query.Where("c.season_id = ?", seasonID)
+	}
+
+	var timings []GoalTiming
+	if err := query.
+		Order("m.kickoff_at ASC, g.match_id, g.goal_time ASC, COALESCE(g.added_time, 0) ASC, g.created_at ASC").
+		Scan(&timings).Error; err != nil {
+		r.Log.Errorf("Failed to find goal timings for team %s: %v", teamID, err)
+		return nil, err
+	}
+	return timings, nil
+}
+
+// FindTeamSeasonScorers returns the team's top scorers in the season's
+// completed matches.
+func (r *goalsRepoImpl) FindTeamSeasonScorers(tx *gorm.DB, teamID, seasonID string, limit int) ([]SeasonScorer, error) {
+	var scorers []SeasonScorer
+	if err := tx.Table("goals g").
+		Select("p.id AS player_id, p.name AS player_name, COUNT(*) AS goals").
+		Joins("JOIN matches m ON m.id = g.match_id").
+		Joins("JOIN competitions c ON c.id = m.competition_id").
+		Joins("JOIN players p ON p.id = g.player_id").
+		Where("g.deleted_at IS NULL AND m.deleted_at IS NULL AND m.status = ?", "completed").
+		Where("c.season_id = ? AND p.team_id = ?", seasonID, teamID).
+		Where("m.home_team_id = @team OR m.away_team_id = @team", sql.Named("team", teamID)).
+		Group("p.id, p.name").
+		Order("COUNT(*) DESC, p.name ASC").
+		Limit(limit).
+		Scan(&scorers).Error; err != nil {
+		r.Log.Errorf("Failed to find scorers of team %s in season %s: %v", teamID, seasonID, err)
+		return nil, err
+	}
+	return scorers, nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type InjuriesRepository interface {
+	Repository[entity.PlayerInjury]
+	FindByPlayerID(db *gorm.DB, playerID string) ([]entity.PlayerInjury, error)
+	FindActiveByPlayerID(db *gorm.DB, playerID string, date time.Time) ([]entity.PlayerInjury, error)
+}
+
+type injuriesRepoImpl struct {
+	Repository[entity.PlayerInjury]
+	Log *logrus.Logger
+}
+
+func NewInjuriesRepo(db *gorm.DB, log *logrus.Logger) InjuriesRepository {
+	return &injuriesRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.PlayerInjury](db),
+	}
+}
+
+func (i *injuriesRepoImpl) FindByPlayerID(db *gorm.DB, playerID string) ([]entity.PlayerInjury, error) {
+	var injuries []entity.PlayerInjury
+	if err := db.Where("player_id = ? AND deleted_at IS NULL", playerID).Order("start_date DESC").Find(&injuries).Error; err != nil {
+		i.Log.Errorf("Failed to find injuries by player ID %s: %v", playerID, err)
+		return nil, err
+	}
+	return injuries, nil
+}
+
+// FindActiveByPlayerID returns injuries that keep the player out on the given date.
+// An injury without an expected return date is treated as open-ended.
+func (i *injuriesRepoImpl) FindActiveByPlayerID(db *gorm.DB, playerID string, date time.Time) ([]entity.PlayerInjury, error) {
+	var injuries []entity.PlayerInjury
+	if err := db.Where("player_id = ? AND deleted_at IS NULL", playerID).
+		Where("start_date <= ?", date).
+		Where("(expected_return IS NULL OR expected_return > ?)", date).
+		Find(&injuries).Error; err != nil {
+		i.Log.Errorf("Failed to find active injuries by player ID %s: %v", playerID, err)
+		return nil, err
+	}
+	return injuries, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	LeaderboardGoals       = "goals"
+	LeaderboardAssists     = "assists"
+	LeaderboardCleanSheets = "clean-sheets"
+	LeaderboardYellowCards = "yellow-cards"
+	LeaderboardRedCards    = "red-cards"
+)
+
+// LeaderboardBoards lists every leaderboard kept per season.
+var LeaderboardBoards = []string{LeaderboardGoals, LeaderboardAssists, LeaderboardCleanSheets, LeaderboardYellowCards, LeaderboardRedCards}
+
+type LeaderboardsRepository interface {
+	FindScores(db *gorm.DB, seasonID, board string) ([]LeaderboardScore, error)
+	FindCleanSheetKeepers(db *gorm.DB, match *entity.Match) ([]string, error)
+	FindMatchSeasonID(db *gorm.DB, matchID string) (*string, error)
+	FindPlayers(db *gorm.DB, ids []string) ([]entity.Player, error)
+}
+
+type LeaderboardScore struct {
+	PlayerID string
+	Score    int64
+}
+
+type leaderboardsRepoImpl struct {
+	DB  *gorm.DB
+	Log *logrus.Logger
+}
+
+func NewLeaderboardsRepo(db *gorm.DB, log *logrus.Logger) LeaderboardsRepository {
+	return &leaderboardsRepoImpl{
+		DB:  db,
+		Log: log,
+	}
+}
+
+// cleanSheetCondition matches the starting goalkeepers of a side that did not
+// concede in a completed match.
+const cleanSheetCondition = "l.is_starter AND l.deleted_at IS NULL AND p.position = 'penjaga_gawang' AND m.status = 'completed' AND " +
+	"((l.team_id = m.home_team_id AND m.away_score = 0) OR (l.team_id = m.away_team_id AND m.home_score = 0))"
+
+// FindScores totals the board's statistic per player over the season's matches.
+func (l *leaderboardsRepoImpl) FindScores(db *gorm.DB, seasonID, board string) ([]LeaderboardScore, error) {
+	var query *gorm.DB
+	switch board {
+	case LeaderboardGoals:
+		query = db.Table("goals g").Select("g.player_id AS player_id, COUNT(*) AS score").
+			Joins("JOIN matches m ON m.id = g.match_id").
+			Where("g.deleted_at IS NULL").
+			Group("g.player_id")
+	case LeaderboardAssists:
+		query = db.Table("goals g").Select("g.assist_player_id AS player_id, COUNT(*) AS score").
+			Joins("JOIN matches m ON m.id = g.match_id").
+			Where("g.deleted_at IS NULL AND g.assist_player_id IS NOT NULL").
+			Group("g.assist_player_id")
+	case LeaderboardYellowCards, LeaderboardRedCards:
+		cardType := "yellow"
+		if board == LeaderboardRedCards {
+			cardType = "red"
+		}
+		query = db.Table("match_cards mc").Select("mc.player_id AS player_id, COUNT(*) AS score").
+			Joins("JOIN matches m ON m.id = mc.match_id").
+			Where("mc.deleted_at IS NULL AND mc.card_type = ?", cardType).
+			Group("mc.player_id")
+	case LeaderboardCleanSheets:
+		query = db.Table("match_lineups l").Select("l.player_id AS player_id, COUNT(*) AS score").
+			Joins("JOIN matches m ON m.id = l.match_id").
+			Joins("JOIN players p ON p.id = l.player_id").
+			Where(cleanSheetCondition).
+			Group("l.player_id")
+	default:
+		return nil, fmt.Errorf("unknown leaderboard %s", board)
+	}
+
+	var scores []LeaderboardScore
+	if err := query.
+		Joins("JOIN competitions c ON c.id = m.competition_id").
+		Where("c.season_id = ? AND m.deleted_at IS NULL", seasonID).
+		Scan(&scores).Error; err != nil {
+		l.Log.Errorf("Failed to compute %s leaderboard for season %s: %v", board, seasonID, err)
+		return nil, err
+	}
+	return scores, nil
+}
+
+// FindCleanSheetKeepers returns the starting goalkeepers credited with a clean
+// sheet in the completed match.
+func (l *leaderboardsRepoImpl) FindCleanSheetKeepers(db *gorm.DB, match *entity.Match) ([]string, error) {
+	var playerIDs []string
+	if err := db.Table("match_lineups l").
+		Joins("JOIN matches m ON m.id = l.match_id").
+		Joins("JOIN players p ON p.id = l.player_id").
+		Where("l.match_id = ?", match.ID).
+		Where(cleanSheetCondition).
+		Pluck("l.player_id", &playerIDs).Error; err != nil {
+		l.Log.Errorf("Failed to find clean sheet keepers for match %s: %v", match.ID, err)
+		return nil, err
+	}
+	return playerIDs, nil
+}
+
+// FindMatchSeasonID returns the season of the match's competition, nil when the
+// match is not part of a season.
+func (l *leaderboardsRepoImpl) FindMatchSeasonID(db *gorm.DB, matchID string) (*string, error) {
+	var seasonIDs []*string
+	if err := db.Table("matches m").
+		Joins("JOIN competitions c ON c.id = m.competition_id").
+		Where("m.id = ?", matchID).
+		Pluck("c.season_id", &seasonIDs).Error; err != nil {
+		l.Log.Errorf("Failed to find season of match %s: %v", matchID, err)
+		return nil, err
+	}
+	if len(seasonIDs) == 0 {
+		return nil, nil
+	}
+	return seasonIDs[0], nil
+}
+
+func (l *leaderboardsRepoImpl) FindPlayers(db *gorm.DB, ids []string) ([]entity.Player, error) {
+	var players []entity.Player
+	if err := db.Preload("Team").Where("id IN ?", ids).Find(&players).Error; err != nil {
+		l.Log.Errorf("Failed to find leaderboard players: %v", err)
+		return nil, err
+	}
+	return players, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type LineupsRepository interface {
+	Repository[entity.MatchLineup]
+	FindByMatchID(db *gorm.DB, matchID string) ([]entity.MatchLineup, error)
+	SoftDeleteByMatchIDAndTeamID(db *gorm.DB, matchID, teamID string) error
+	FindTeamSeasonAppearances(db *gorm.DB, teamID, seasonID string, limit int) ([]PlayerAppearances, error)
+}
+
+type PlayerAppearances struct {
+	PlayerID    string
+	PlayerName  string
+	Appearances int
+	Starts      int
+}
+
+type lineupsRepoImpl struct {
+	Repository[entity.MatchLineup]
+	Log *logrus.Logger
+}
+
+func NewLineupsRepo(db *gorm.DB, log *logrus.Logger) LineupsRepository {
+	return &lineupsRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.MatchLineup](db),
+	}
+}
+
+func (l *lineupsRepoImpl) FindByMatchID(db *gorm.DB, matchID string) ([]entity.MatchLineup, error) {
+	var lineups []entity.MatchLineup
+	if err := db.Preload("Player").Where("match_id = ? AND deleted_at IS NULL", matchID).Order("team_id, is_starter DESC").Find(&lineups).Error; err != nil {
+		l.Log.Errorf("Failed to find lineups by match ID %s: %v", matchID, err)
+		return nil, err
+	}
+	return lineups, nil
+}
+
+func (l *lineupsRepoImpl) SoftDeleteByMatchIDAndTeamID(db *gorm.DB, matchID, teamID string) error {
+	if err := db.Model(&entity.MatchLineup{}).
+		Where("match_id = ? AND team_id = ? AND deleted_at IS NULL", matchID, teamID).
+		Update("deleted_at", gorm.Expr("CURRENT_TIMESTAMP")).Error; err != nil {
+		l.Log.Errorf("Failed to soft delete lineup for match %s and team %s: %v", matchID, teamID, err)
+		return err
+	}
+	return nil
+}
+
+// FindTeamSeasonAppearances returns the players the team named in the most
+// lineups of the season's completed matches.
+func (l *lineupsRepoImpl) FindTeamSeasonAppearances(db *gorm.DB, teamID, seasonID string, limit int) ([]PlayerAppearances, error) {
+	var appearances []PlayerAppearances
+	if err := db.Table("match_lineups l").
+		Select("p.id AS player_id, p.name AS player_name, COUNT(*) AS appearances, COUNT(*) FILTER (WHERE l.is_starter) AS starts").
+		Joins("JOIN matches m ON m.id = l.match_id").
+		Joins("JOIN competitions c ON c.id = m.competition_id").
+		Joins("JOIN players p ON p.id = l.player_id").
+		Where("l.deleted_at IS NULL AND m.deleted_at IS NULL AND m.status = ?", "completed").
+		Where("c.season_id = ? AND l.team_id = ?", seasonID, teamID).
+		Group("p.id, p.name").
+		Order("COUNT(*) DESC, starts DESC, p.name ASC").
+		Limit(limit).
+		Scan(&appearances).Error; err != nil {
+		l.Log.Errorf("Failed to find appearances for team %s in season %s: %v", teamID, seasonID, err)
+		return nil, err
+	}
+	return appearances, nil
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/Fadlihardiyanto/football-api/internal/entity"
@@ -12,6 +14,43 @@ type MatchesRepository interface {
 	Repository[entity.Match]
 	FindGoalsByMatchIDWithPlayer(tx *gorm.DB, matchID string) ([]entity.Goal, error)
 	FindAllBeforeDate(tx *gorm.DB, date time.Time) ([]entity.Match, error)
+	FindCompletedByCompetitionID(tx *gorm.DB, competitionID string) ([]entity.Match, error)
+	FindVenueConflicts(tx *gorm.DB, venueID string, kickoff time.Time, buffer time.Duration, excludeMatchID string) ([]entity.Match, error)
+	FindTeamMatchesAround(tx *gorm.DB, teamID string, matchDate, kickoff time.Time, window time.Duration, excludeMatchID string) ([]entity.Match, error)
+	FindScheduledBySeasonID(tx *gorm.DB, seasonID string) ([]entity.Match, error)
+	CountByCompetitionID(tx *gorm.DB, competitionID string) (int64, error)
+	FindReschedulesByMatchID(tx *gorm.DB, matchID string) ([]entity.MatchReschedule, error)
+	FindOpenPostponement(tx *gorm.DB, matchID string) (*entity.MatchReschedule, error)
+	SaveReschedule(tx *gorm.DB, reschedule *entity.MatchReschedule) error
+	FindFixturesByTeamID(tx *gorm.DB, teamID string) ([]entity.Match, error)
+	FindFixturesBySeasonID(tx *gorm.DB, seasonID string) ([]entity.Match, error)
+	FindHeadToHeadRecords(tx *gorm.DB, teamID, otherTeamID string, before *time.Time) ([]HeadToHeadRecord, error)
+	FindHeadToHeadBiggestWins(tx *gorm.DB, winnerID, loserID string, limit int) ([]entity.Match, error)
+	FindHeadToHeadMeetings(tx *gorm.DB, teamID, otherTeamID string, limit int) ([]entity.Match, error)
+	FindHeadToHeadScorers(tx *gorm.DB, teamID, otherTeamID string, limit int) ([]HeadToHeadScorer, error)
+	FindCompletedByTeamID(tx *gorm.DB, teamID string, before *time.Time) ([]entity.Match, error)
+	FindCompletedByTeamAndSeason(tx *gorm.DB, teamID, seasonID string) ([]entity.Match, error)
+	FindByTeamsAndDate(tx *gorm.DB, homeTeamID, awayTeamID string, date time.Time) (*entity.Match, error)
+}
+
+// HeadToHeadRecord is a team's record against one opponent in a season, seen
+// from the first team. Matches outside a competition have no season.
+type HeadToHeadRecord struct {
+	SeasonID     *string
+	SeasonName   *string
+	Played       int
+	Wins         int
+	Draws        int
+	Losses       int
+	GoalsFor     int
+	GoalsAgainst int
+}
+
+type HeadToHeadScorer struct {
+	PlayerID   string
+	PlayerName string
+	TeamID     string
+	Goals      int
 }
 
 type matchesRepoImpl struct {
@@ -26,9 +65,14 @@ func NewMatchesRepo(db *gorm.DB, log *logrus.Logger) MatchesRepository {
 	}
 }
 
+// FindGoalsByMatchIDWithPlayer returns the match's goals in the order they were
+// scored.
 func (r *matchesRepoImpl) FindGoalsByMatchIDWithPlayer(tx *gorm.DB, matchID string) ([]entity.Goal, error) {
 	var goals []entity.Goal
-	err := tx.Preload("Player").Where("match_id = ?", matchID).Find(&goals).Error
+	err := tx.Preload("Player").
+		Where("match_id = ? AND deleted_at IS NULL", matchID).
+		Order("goal_time ASC, added_time ASC NULLS FIRST, created_at ASC").
+		Find(&goals).Error
 	return goals, err
 }
 
@@ -40,3 +84,271 @@ func (r *matchesRepoImpl) FindAllBeforeDate(tx *gorm.DB, date time.Time) ([]enti
 	}
 	return matches, nil
 }
+
+func (r *matchesRepoImpl) FindCompletedByCompetitionID(tx *gorm.DB, competitionID string) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Where("competition_id = ? AND status = ?", competitionID, "completed").
+		Where("home_score IS NOT NULL AND away_score IS NOT NULL").
+		Where("deleted_at IS NULL").
+		Order("match_date ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find completed matches for competition %s: %v", competitionID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindVenueConflicts returns scheduled matches held at the venue whose kickoff is
+// within buffer of the given kickoff. A match without its own venue is played at
+// the home team's venue.
+func (r *matchesRepoImpl) FindVenueConflicts(tx *gorm.DB, venueID string, kickoff time.Time, buffer time.Duration, excludeMatchID string) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Joins("JOIN teams ht ON ht.id = matches.home_team_id").
+		Where("COALESCE(matches.venue_id, ht.home_venue_id) = ?", venueID).
+		Where("matches.id <> ? AND matches.status = ? AND matches.deleted_at IS NULL", excludeMatchID, "scheduled").
+		Where("ABS(EXTRACT(EPOCH FROM (matches.kickoff_at - ?))) < ?", kickoff, buffer.Seconds()).
+		Order("matches.kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find venue conflicts for venue %s: %v", venueID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindTeamMatchesAround returns the team's scheduled or completed matches played on
+// the match date or starting within window of the kickoff.
+func (r *matchesRepoImpl) FindTeamMatchesAround(tx *gorm.DB, teamID string, matchDate, kickoff time.Time, window time.Duration, excludeMatchID string) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Where("(home_team_id = ? OR away_team_id = ?)", teamID, teamID).
+		Where("id <> ? AND status IN ? AND deleted_at IS NULL", excludeMatchID, []string{"scheduled", "completed"}).
+		Where("match_date = ? OR ABS(EXTRACT(EPOCH FROM (kickoff_at - ?))) < ?", matchDate.Format("2006-01-02"), kickoff, window.Seconds()).
+		Order("kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find matches of team %s around %s: %v", teamID, kickoff.Format(time.RFC3339), err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (r *matchesRepoImpl) FindScheduledBySeasonID(tx *gorm.DB, seasonID string) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Preload("HomeTeam").Preload("AwayTeam").
+		Joins("JOIN competitions c ON c.id = matches.competition_id").
+		Where("c.season_id = ? AND c.deleted_at IS NULL", seasonID).
+		Where("matches.status = ? AND matches.deleted_at IS NULL", "scheduled").
+		Order("matches.kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find scheduled matches for season %s: %v", seasonID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (r *matchesRepoImpl) CountByCompetitionID(tx *gorm.DB, competitionID string) (int64, error) {
+	var count int64
+	if err := tx.Model(&entity.Match{}).Where("competition_id = ? AND deleted_at IS NULL", competitionID).Count(&count).Error; err != nil {
+		r.Log.Errorf("Failed to count matches for competition %s: %v", competitionID, err)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *matchesRepoImpl) FindReschedulesByMatchID(tx *gorm.DB, matchID string) ([]entity.MatchReschedule, error) {
+	var reschedules []entity.MatchReschedule
+	if err := tx.Where("match_id = ?", matchID).Order("created_at ASC").Find(&reschedules).Error; err != nil {
+		r.Log.Errorf("Failed to find reschedules for match %s: %v", matchID, err)
+		return nil, err
+	}
+	return reschedules, nil
+}
+
+// FindOpenPostponement returns the postponement still waiting for a new kickoff,
+// or nil when there is none.
+func (r *matchesRepoImpl) FindOpenPostponement(tx *gorm.DB, matchID string) (*entity.MatchReschedule, error) {
+	var reschedules []entity.MatchReschedule
+	if err := tx.Where("match_id = ? AND postponed_at IS NOT NULL AND rescheduled_at IS NULL", matchID).Order("created_at DESC").Limit(1).Find(&reschedules).Error; err != nil {
+		r.Log.Errorf("Failed to find open postponement for match %s: %v", matchID, err)
+		return nil, err
+	}
+	if len(reschedules) == 0 {
+		return nil, nil
+	}
+	return &reschedules[0], nil
+}
+
+func (r *matchesRepoImpl) SaveReschedule(tx *gorm.DB, reschedule *entity.MatchReschedule) error {
+	if err := tx.Save(reschedule).Error; err != nil {
+		r.Log.Errorf("Failed to save reschedule for match %s: %v", reschedule.MatchID, err)
+		return err
+	}
+	return nil
+}
+
+// fixtures preloads what is needed to publish matches as calendar events.
+func fixtures(tx *gorm.DB) *gorm.DB {
+	return tx.
+		Preload("HomeTeam").Preload("HomeTeam.HomeVenue").Preload("AwayTeam").
+		Preload("Venue").Preload("Competition").Preload("Reschedules")
+}
+
+func (r *matchesRepoImpl) FindFixturesByTeamID(tx *gorm.DB, teamID string) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := fixtures(tx).
+		Where("(home_team_id = ? OR away_team_id = ?) AND deleted_at IS NULL", teamID, teamID).
+		Order("kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find fixtures for team %s: %v", teamID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (r *matchesRepoImpl) FindFixturesBySeasonID(tx *gorm.DB, seasonID string) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := fixtures(tx).
+		Joins("JOIN competitions c ON c.id = matches.competition_id").
+		Where("c.season_id = ? AND c.deleted_at IS NULL AND matches.deleted_at IS NULL", seasonID).
+		Order("matches.kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find fixtures for season %s: %v", seasonID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// headToHead restricts matches to completed meetings between the two teams.
+func headToHead(tx *gorm.DB, teamID, otherTeamID string) *gorm.DB {
+	return tx.
+		Where("((matches.home_team_id = ? AND matches.away_team_id = ?) OR (matches.home_team_id = ? AND matches.away_team_id = ?))", teamID, otherTeamID, otherTeamID, teamID).
+		Where("matches.status = ? AND matches.home_score IS NOT NULL AND matches.away_score IS NOT NULL AND matches.deleted_at IS NULL", "completed")
+}
+
+// FindHeadToHeadRecords aggregates the meetings between the two teams per season,
+// optionally only those that kicked off before the given time.
+func (r *matchesRepoImpl) FindHeadToHeadRecords(tx *gorm.DB, teamID, otherTeamID string, before *time.Time) ([]HeadToHeadRecord, error) {
+	var records []HeadToHeadRecord
+	query := headToHead(tx.Model(&entity.Match{}), teamID, otherTeamID).
+		Select("c.season_id AS season_id, s.name AS season_name, "+
+			"COUNT(*) AS played, "+
+			"COUNT(*) FILTER (WHERE (matches.home_team_id = @team AND matches.home_score > matches.away_score) OR (matches.away_team_id = @team AND matches.away_score > matches.home_score)) AS wins, "+
+			"COUNT(*) FILTER (WHERE matches.home_score = matches.away_score) AS draws, "+
+			"COUNT(*) FILTER (WHERE (matches.home_team_id = @team AND matches.home_score < matches.away_score) OR (matches.away_team_id = @team AND matches.away_score < matches.home_score)) AS losses, "+
+			"COALESCE(SUM(CASE WHEN matches.home_team_id = @team THEN matches.home_score ELSE matches.away_score END), 0) AS goals_for, "+
+			"COALESCE(SUM(CASE WHEN matches.home_team_id = @team THEN matches.away_score ELSE matches.home_score END), 0) AS goals_against",
+			sql.Named("team", teamID)).
+		Joins("LEFT JOIN competitions c ON c.id = matches.competition_id").
+		Joins("LEFT JOIN seasons s ON s.id = c.season_id").
+		Group("c.season_id, s.name, s.start_date").
+		Order("s.start_date ASC NULLS FIRST")
+	if before != nil {
+		query = query.Where("matches.kickoff_at < ?", *before)
+	}
+	if err := query.Scan(&records).Error; err != nil {
+		r.Log.Errorf("Failed to aggregate head-to-head of teams %s and %s: %v", teamID, otherTeamID, err)
+		return nil, err
+	}
+	return records, nil
+}
+
+// FindHeadToHeadBiggestWins returns the winner's widest wins over the loser,
+// most goals scored first on equal margins.
+func (r *matchesRepoImpl) FindHeadToHeadBiggestWins(tx *gorm.DB, winnerID, loserID string, limit int) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := headToHead(tx.Preload("HomeTeam").Preload("AwayTeam"), winnerID, loserID).
+		Where("(matches.home_team_id = ? AND matches.home_score > matches.away_score) OR (matches.away_team_id = ? AND matches.away_score > matches.home_score)", winnerID, winnerID).
+		Order("ABS(matches.home_score - matches.away_score) DESC, GREATEST(matches.home_score, matches.away_score) DESC, matches.kickoff_at DESC").
+		Limit(limit).
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find biggest wins of team %s over %s: %v", winnerID, loserID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (r *matchesRepoImpl) FindHeadToHeadMeetings(tx *gorm.DB, teamID, otherTeamID string, limit int) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := headToHead(tx.Preload("HomeTeam").Preload("AwayTeam"), teamID, otherTeamID).
+		Order("matches.kickoff_at DESC").
+		Limit(limit).
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find meetings of teams %s and %s: %v", teamID, otherTeamID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindHeadToHeadScorers ranks the players who scored the most goals in meetings
+// between the two teams.
+func (r *matchesRepoImpl) FindHeadToHeadScorers(tx *gorm.DB, teamID, otherTeamID string, limit int) ([]HeadToHeadScorer, error) {
+	var scorers []HeadToHeadScorer
+	if err := headToHead(tx.Model(&entity.Goal{}), teamID, otherTeamID).
+		Select("p.id AS player_id, p.name AS player_name, p.team_id AS team_id, COUNT(*) AS goals").
+		Joins("JOIN matches ON matches.id = goals.match_id").
+		Joins("JOIN players p ON p.id = goals.player_id").
+		Where("goals.deleted_at IS NULL").
+		Group("p.id, p.name, p.team_id").
+		Order("COUNT(*) DESC, p.name ASC").
+		Limit(limit).
+		Scan(&scorers).Error; err != nil {
+		r.Log.Errorf("Failed to find head-to-head scorers of teams %s and %s: %v", teamID, otherTeamID, err)
+		return nil, err
+	}
+	return scorers, nil
+}
+
+// FindCompletedByTeamID returns the team's results in kickoff order, optionally
+// only those that kicked off before the given time.
+func (r *matchesRepoImpl) FindCompletedByTeamID(tx *gorm.DB, teamID string, before *time.Time) ([]entity.Match, error) {
+	var matches []entity.Match
+	query := tx.
+		Preload("HomeTeam").Preload("AwayTeam").Preload("Competition").Preload("Competition.Season").
+		Where("(home_team_id = ? OR away_team_id = ?)", teamID, teamID).
+		Where("status = ? AND home_score IS NOT NULL AND away_score IS NOT NULL AND deleted_at IS NULL", "completed").
+		Order("kickoff_at ASC")
+	if before != nil {
+		query = query.Where("kickoff_at < ?", *before)
+	}
+	if err := query.Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find results of team %s: %v", teamID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindCompletedByTeamAndSeason returns the team's results in the season's
+// competitions, in kickoff order.
+func (r *matchesRepoImpl) FindCompletedByTeamAndSeason(tx *gorm.DB, teamID, seasonID string) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Preload("HomeTeam").Preload("AwayTeam").Preload("Competition").Preload("Competition.Season").
+		Joins("JOIN competitions c ON c.id = matches.competition_id").
+		Where("c.season_id = ?", seasonID).
+		Where("(matches.home_team_id = ? OR matches.away_team_id = ?)", teamID, teamID).
+		Where("matches.status = ? AND matches.home_score IS NOT NULL AND matches.away_score IS NOT NULL AND matches.deleted_at IS NULL", "completed").
+		Order("matches.kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find results of team %s in season %s: %v", teamID, seasonID, err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindByTeamsAndDate returns the match between the teams on the date, or nil
+// when there is none.
+func (r *matchesRepoImpl) FindByTeamsAndDate(tx *gorm.DB, homeTeamID, awayTeamID string, date time.Time) (*entity.Match, error) {
+	var match entity.Match
+	err := tx.Where("home_team_id = ? AND away_team_id = ? AND match_date = ? AND deleted_at IS NULL", homeTeamID, awayTeamID, date.Format("2006-01-02")).
+		First(&match).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.Log.Errorf("Failed to find match between %s and %s on %s: %v", homeTeamID, awayTeamID, date.Format("2006-01-02"), err)
+		return nil, err
+	}
+	return &match, nil
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type OfficialsRepository interface {
+	Repository[entity.Official]
+	ReplaceAffiliations(db *gorm.DB, officialID string, teamIDs []string) error
+	FindAffiliatedTeamIDs(db *gorm.DB, officialID string, teamIDs []string) ([]string, error)
+	FindAssignmentsByMatchID(db *gorm.DB, matchID string) ([]entity.MatchOfficial, error)
+	FindAssignmentsByOfficialID(db *gorm.DB, officialID string) ([]entity.MatchOfficial, error)
+	FindAssignmentByID(db *gorm.DB, id string) (*entity.MatchOfficial, error)
+	FindAssignmentsOnDate(db *gorm.DB, officialID string, date time.Time, excludeMatchID string) ([]entity.MatchOfficial, error)
+	CheckRoleAssigned(db *gorm.DB, matchID, role string) (bool, error)
+	CheckOfficialAssigned(db *gorm.DB, matchID, officialID string) (bool, error)
+	CreateAssignment(db *gorm.DB, assignment *entity.MatchOfficial) error
+	SoftDeleteAssignment(db *gorm.DB, id string) error
+}
+
+type officialsRepoImpl struct {
+	Repository[entity.Official]
+	Log *logrus.Logger
+}
+
+func NewOfficialsRepo(db *gorm.DB, log *logrus.Logger) OfficialsRepository {
+	return &officialsRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.Official](db),
+	}
+}
+
+func (o *officialsRepoImpl) ReplaceAffiliations(db *gorm.DB, officialID string, teamIDs []string) error {
+	if err := db.Where("official_id = ?", officialID).Delete(&entity.OfficialAffiliation{}).Error; err != nil {
+		o.Log.Errorf("Failed to clear affiliations of official %s: %v", officialID, err)
+		return err
+	}
+	for _, teamID := range teamIDs {
+		affiliation := &entity.OfficialAffiliation{OfficialID: officialID, TeamID: teamID}
+		if err := db.Create(affiliation).Error; err != nil {
+			o.Log.Errorf("Failed to affiliate official %s with team %s: %v", officialID, teamID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// FindAffiliatedTeamIDs returns which of the given teams the official has declared
+// an affiliation with.
+func (o *officialsRepoImpl) FindAffiliatedTeamIDs(db *gorm.DB, officialID string, teamIDs []string) ([]string, error) {
+	var affiliated []string
+	if err := db.Model(&entity.OfficialAffiliation{}).
+		Where("official_id = ? AND team_id IN ?", officialID, teamIDs).
+		Pluck("team_id", &affiliated).Error; err != nil {
+		o.Log.Errorf("Failed to find affiliations of official %s: %v", officialID, err)
+		return nil, err
+	}
+	return affiliated, nil
+}
+
+func (o *officialsRepoImpl) FindAssignmentsByMatchID(db *gorm.DB, matchID string) ([]entity.MatchOfficial, error) {
+	var assignments []entity.MatchOfficial
+	if err := db.Preload("Official").Where("match_id = ? AND deleted_at IS NULL", matchID).Order("role ASC").Find(&assignments).Error; err != nil {
+		o.Log.Errorf("Failed to find officials for match %s: %v", matchID, err)
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (o *officialsRepoImpl) FindAssignmentsByOfficialID(db *gorm.DB, officialID string) ([]entity.MatchOfficial, error) {
+	var assignments []entity.MatchOfficial
+	if err := db.Preload("Match").Preload("Match.HomeTeam").Preload("Match.AwayTeam").
+		Joins("JOIN matches m ON m.id = match_officials.match_id").
+		Where("match_officials.official_id = ? AND match_officials.deleted_at IS NULL AND m.deleted_at IS NULL", officialID).
+		Order("m.kickoff_at DESC").
+		Find(&assignments).Error; err != nil {
+		o.Log.Errorf("Failed to find matches for official %s: %v", officialID, err)
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (o *officialsRepoImpl) FindAssignmentByID(db *gorm.DB, id string) (*entity.MatchOfficial, error) {
+	var assignment entity.MatchOfficial
+	if err := db.Where("id = ? AND deleted_at IS NULL", id).First(&assignment).Error; err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// FindAssignmentsOnDate returns the official's other assignments to matches on the
+// same date that are still going ahead or have been played.
+func (o *officialsRepoImpl) FindAssignmentsOnDate(db *gorm.DB, officialID string, date time.Time, excludeMatchID string) ([]entity.MatchOfficial, error) {
+	var assignments []entity.MatchOfficial
+	if err := db.Preload("Match").
+		Joins("JOIN matches m ON m.id = match_officials.match_id").
+		Where("match_officials.official_id = ? AND match_officials.deleted_at IS NULL", officialID).
+		Where("m.match_date = ? AND m.id <> ? AND m.status IN ? AND m.deleted_at IS NULL", date, excludeMatchID, []string{"scheduled", "completed"}).
+		Find(&assignments).Error; err != nil {
+		o.Log.Errorf("Failed to find assignments of official %s on %s: %v", officialID, date.Format("2006-01-02"), err)
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (o *officialsRepoImpl) CheckRoleAssigned(db *gorm.DB, matchID, role string) (bool, error) {
+	var count int64
+	if err := db.Model(&entity.MatchOfficial{}).Where("match_id = ? AND role = ? AND deleted_at IS NULL", matchID, role).Count(&count).Error; err != nil {
+		o.Log.Errorf("Failed to check role %s for match %s: %v", role, matchID, err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (o *officialsRepoImpl) CheckOfficialAssigned(db *gorm.DB, matchID, officialID string) (bool, error) {
+	var count int64
+	if err := db.Model(&entity.MatchOfficial{}).Where("match_id = ? AND official_id = ? AND deleted_at IS NULL", matchID, officialID).Count(&count).Error; err != nil {
+		o.Log.Errorf("Failed to check official %s for match %s: %v", officialID, matchID, err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (o *officialsRepoImpl) CreateAssignment(db *gorm.DB, assignment *entity.MatchOfficial) error {
+	if err := db.Create(assignment).Error; err != nil {
+		o.Log.Errorf("Failed to assign official %s to match %s: %v", assignment.OfficialID, assignment.MatchID, err)
+		return err
+	}
+	return nil
+}
+
+func (o *officialsRepoImpl) SoftDeleteAssignment(db *gorm.DB, id string) error {
+	if err := db.Model(&entity.MatchOfficial{}).Where("id = ?", id).Update("deleted_at", gorm.Expr("CURRENT_TIMESTAMP")).Error; err != nil {
+		o.Log.Errorf("Failed to remove official assignment %s: %v", id, err)
+		return err
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/Fadlihardiyanto/football-api/internal/entity"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -12,6 +14,31 @@ type PlayersRepository interface {
 	CheckNumberJerseyByNumberAndTeamID(db *gorm.DB, number int, teamID string) (bool, error)
 	CheckPlayerAlreadyHasTeam(db *gorm.DB, playerID string) (bool, error)
 	CheckNumberJerseyByNumberAndTeamIDExceptPlayerID(db *gorm.DB, number int, teamID, playerID string) (bool, error)
+	FindPlayers(db *gorm.DB, ids []string) ([]entity.Player, error)
+	FindByTeamID(db *gorm.DB, teamID string) ([]entity.Player, error)
+	FindStats(db *gorm.DB, filter PlayerStatsFilter) ([]PlayerStats, error)
+}
+
+// PlayerStatsFilter limits player statistics to completed matches of a
+// competition and date range. Empty fields are not applied.
+type PlayerStatsFilter struct {
+	PlayerIDs     []string
+	Positions     []string
+	CompetitionID string
+	From          *time.Time
+	To            *time.Time
+}
+
+type PlayerStats struct {
+	PlayerID    string
+	Position    string
+	Appearances int
+	Starts      int
+	Minutes     int
+	Goals       int
+	Assists     int
+	YellowCards int
+	RedCards    int
 }
 
 type playersRepoImpl struct {
@@ -61,3 +88,75 @@ func (p *playersRepoImpl) CheckNumberJerseyByNumberAndTeamIDExceptPlayerID(db *g
 	}
 	return count > 0, nil
 }
+
+func (p *playersRepoImpl) FindPlayers(db *gorm.DB, ids []string) ([]entity.Player, error) {
+	var players []entity.Player
+	if err := db.Preload("Team").Where("id IN ? AND deleted_at IS NULL", ids).Find(&players).Error; err != nil {
+		p.Log.Errorf("Failed to find players by IDs %v: %v", ids, err)
+		return nil, err
+	}
+	return players, nil
+}
+
+func (p *playersRepoImpl) FindByTeamID(db *gorm.DB, teamID string) ([]entity.Player, error) {
+	var players []entity.Player
+	if err := db.Where("team_id = ? AND deleted_at IS NULL", teamID).Find(&players).Error; err != nil {
+		p.Log.Errorf("Failed to find players of team %s: %v", teamID, err)
+		return nil, err
+	}
+	return players, nil
+}
+
+// defaultMinutesPlayed estimates minutes for lineups saved without them: a
+// full match for starters and none for substitutes.
+const defaultMinutesPlayed = "COALESCE(x.minutes_played, CASE WHEN x.is_starter THEN 90 ELSE 0 END)"
+
+// FindStats totals appearances, minutes, goals, assists and cards for the
+// requested players and for every player of the given positions who appeared
+// in a matching game.
+func (p *playersRepoImpl) FindStats(db *gorm.DB, filter PlayerStatsFilter) ([]PlayerStats, error) {
+	matches := func(query *gorm.DB) *gorm.DB {
+		query = query.Joins("JOIN matches m ON m.id = x.match_id").
+			Where("x.deleted_at IS NULL AND m.deleted_at IS NULL AND m.status = ?", "completed")
+		if filter.CompetitionID != "" {
+			query = query.Where("m.competition_id = ?", filter.CompetitionID)
+		}
+		if filter.From != nil {
+			query = query.Where("m.match_date >= ?", *filter.From)
+		}
+		if filter.To != nil {
+			query = query.Where("m.match_date <= ?", *filter.To)
+		}
+		return query
+	}
+
+	lineups := matches(db.Table("match_lineups x").
+		Select("x.player_id, COUNT(*) AS appearances, COUNT(*) FILTER (WHERE x.is_starter) AS starts, " +
+			"SUM(" + defaultMinutesPlayed + ") AS minutes")).
+		Group("x.player_id")
+	goals := matches(db.Table("goals x").Select("x.player_id, COUNT(*) AS goals")).
+		Group("x.player_id")
+	assists := matches(db.Table("goals x").Select("x.assist_player_id AS player_id, COUNT(*) AS assists")).
+		Where("x.assist_player_id IS NOT NULL").
+		Group("x.assist_player_id")
+	cards := matches(db.Table("match_cards x").
+		Select("x.player_id, COUNT(*) FILTER (WHERE x.card_type = 'yellow') AS yellow_cards, COUNT(*) FILTER (WHERE x.card_type = 'red') AS red_cards")).
+		Group("x.player_id")
+
+	var stats []PlayerStats
+	if err := db.Table("players p").
+		Select("p.id AS player_id, p.position, COALESCE(l.appearances, 0) AS appearances, COALESCE(l.starts, 0) AS starts, "+
+			"COALESCE(l.minutes, 0) AS minutes, COALESCE(g.goals, 0) AS goals, COALESCE(a.assists, 0) AS assists, "+
+			"COALESCE(c.yellow_cards, 0) AS yellow_cards, COALESCE(c.red_cards, 0) AS red_cards").
+		Joins("LEFT JOIN (?) l ON l.player_id = p.id", lineups).
+		Joins("LEFT JOIN (?) g ON g.player_id = p.id", goals).
+		Joins("LEFT JOIN (?) a ON a.player_id = p.id", assists).
+		Joins("LEFT JOIN (?) c ON c.player_id = p.id", cards).
+		Where("p.deleted_at IS NULL").
+		Where("p.id IN ? OR (p.position IN ? AND l.appearances > 0)", filter.PlayerIDs, filter.Positions).
+		Scan(&stats).Error; err != nil {
+		p.Log.Errorf("Failed to find player stats: %v", err)
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PredictionsRepository interface {
+	FindByMatchID(tx *gorm.DB, matchID string) (*entity.MatchPrediction, error)
+	Save(tx *gorm.DB, prediction *entity.MatchPrediction) error
+	FindTrainingMatches(tx *gorm.DB, before time.Time) ([]entity.Match, error)
+	FindUpcomingMatches(tx *gorm.DB, after time.Time) ([]entity.Match, error)
+	FindUnpredictedMatches(tx *gorm.DB, after time.Time) ([]entity.Match, error)
+	FindSettled(tx *gorm.DB, seasonID string) ([]entity.MatchPrediction, error)
+}
+
+type predictionsRepoImpl struct {
+	DB  *gorm.DB
+	Log *logrus.Logger
+}
+
+func NewPredictionsRepo(db *gorm.DB, log *logrus.Logger) PredictionsRepository {
+	return &predictionsRepoImpl{
+		DB:  db,
+		Log: log,
+	}
+}
+
+func (r *predictionsRepoImpl) FindByMatchID(tx *gorm.DB, matchID string) (*entity.MatchPrediction, error) {
+	var prediction entity.MatchPrediction
+	if err := tx.Where("match_id = ?", matchID).First(&prediction).Error; err != nil {
+		return nil, err
+	}
+	return &prediction, nil
+}
+
+// Save replaces the match's prediction.
+func (r *predictionsRepoImpl) Save(tx *gorm.DB, prediction *entity.MatchPrediction) error {
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "match_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"home_expected_goals", "away_expected_goals", "home_win", "draw", "away_win", "scorelines", "training_matches", "updated_at"}),
+	}).Create(prediction).Error; err != nil {
+		r.Log.Errorf("Failed to save prediction for match %s: %v", prediction.MatchID, err)
+		return err
+	}
+	return nil
+}
+
+// FindTrainingMatches returns the completed matches with a score that kicked off
+// before the given time.
+func (r *predictionsRepoImpl) FindTrainingMatches(tx *gorm.DB, before time.Time) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Where("status = ? AND deleted_at IS NULL AND kickoff_at < ?", "completed", before).
+		Where("home_score IS NOT NULL AND away_score IS NOT NULL").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find training matches: %v", err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindUpcomingMatches returns the scheduled matches kicking off after the given
+// time. A match that has kicked off keeps the prediction it had.
+func (r *predictionsRepoImpl) FindUpcomingMatches(tx *gorm.DB, after time.Time) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Where("status = ? AND deleted_at IS NULL", "scheduled").
+		Where("kickoff_at > ?", after).
+		Order("kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find upcoming matches: %v", err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindUnpredictedMatches returns the scheduled matches kicking off after the
+// given time that have no prediction.
+func (r *predictionsRepoImpl) FindUnpredictedMatches(tx *gorm.DB, after time.Time) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.
+		Where("status = ? AND deleted_at IS NULL", "scheduled").
+		Where("kickoff_at > ?", after).
+		Where("NOT EXISTS (SELECT 1 FROM match_predictions mp WHERE mp.match_id = matches.id)").
+		Order("kickoff_at ASC").
+		Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find unpredicted matches: %v", err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindSettled returns the predictions of completed matches, limited to the
+// season when one is given.
+func (r *predictionsRepoImpl) FindSettled(tx *gorm.DB, seasonID string) ([]entity.MatchPrediction, error) {
+	query := tx.Preload("Match").
+		Joins("JOIN matches m ON m.id = match_predictions.match_id").
+		Where("m.status = ? AND m.deleted_at IS NULL", "completed").
+		Where("m.home_score IS NOT NULL AND m.away_score IS NOT NULL")
+	if seasonID != "" {
+		query = query.
+			Joins("JOIN competitions c ON c.id = m.competition_id").
+			Where("c.season_id = ?", seasonID)
+	}
+
+	var predictions []entity.MatchPrediction
+	if err := query.Find(&predictions).Error; err != nil {
+		r.Log.Errorf("Failed to find settled predictions: %v", err)
+		return nil, err
+	}
+	return predictions, nil
+}
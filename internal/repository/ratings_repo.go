@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type RatingsRepository interface {
+	Create(tx *gorm.DB, rating *entity.TeamRating) error
+	FindTeamRating(tx *gorm.DB, teamID string) (*float64, error)
+	SetTeamRating(tx *gorm.DB, teamID string, rating float64) error
+	FindRatedTeams(tx *gorm.DB) ([]entity.Team, error)
+	FindByTeamID(tx *gorm.DB, teamID string) ([]entity.TeamRating, error)
+	FindSeasonStartRatings(tx *gorm.DB, seasonID string) ([]SeasonStartRating, error)
+	FindCompletedMatches(tx *gorm.DB) ([]entity.Match, error)
+	Reset(tx *gorm.DB) error
+}
+
+// SeasonStartRating is a team's rating going into its first rated match of a
+// season.
+type SeasonStartRating struct {
+	TeamID string
+	Rating float64
+}
+
+type ratingsRepoImpl struct {
+	DB  *gorm.DB
+	Log *logrus.Logger
+}
+
+func NewRatingsRepo(db *gorm.DB, log *logrus.Logger) RatingsRepository {
+	return &ratingsRepoImpl{
+		DB:  db,
+		Log: log,
+	}
+}
+
+func (r *ratingsRepoImpl) Create(tx *gorm.DB, rating *entity.TeamRating) error {
+	return tx.Create(rating).Error
+}
+
+// FindTeamRating returns nil for a team that has not been rated yet.
+func (r *ratingsRepoImpl) FindTeamRating(tx *gorm.DB, teamID string) (*float64, error) {
+	var team entity.Team
+	if err := tx.Select("rating").Where("id = ?", teamID).First(&team).Error; err != nil {
+		r.Log.Errorf("Failed to find rating of team %s: %v", teamID, err)
+		return nil, err
+	}
+	return team.Rating, nil
+}
+
+func (r *ratingsRepoImpl) SetTeamRating(tx *gorm.DB, teamID string, rating float64) error {
+	if err := tx.Model(&entity.Team{}).Where("id = ?", teamID).Update("rating", rating).Error; err != nil {
+		r.Log.Errorf("Failed to set rating of team %s: %v", teamID, err)
+		return err
+	}
+	return nil
+}
+
+func (r *ratingsRepoImpl) FindRatedTeams(tx *gorm.DB) ([]entity.Team, error) {
+	var teams []entity.Team
+	if err := tx.Where("rating IS NOT NULL AND deleted_at IS NULL").Order("rating DESC").Find(&teams).Error; err != nil {
+		r.Log.Errorf("Failed to find rated teams: %v", err)
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (r *ratingsRepoImpl) FindByTeamID(tx *gorm.DB, teamID string) ([]entity.TeamRating, error) {
+	var ratings []entity.TeamRating
+	if err := tx.Preload("Opponent").Where("team_id = ?", teamID).Order("played_at ASC, created_at ASC").Find(&ratings).Error; err != nil {
+		r.Log.Errorf("Failed to find rating history of team %s: %v", teamID, err)
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (r *ratingsRepoImpl) FindSeasonStartRatings(tx *gorm.DB, seasonID string) ([]SeasonStartRating, error) {
+	var ratings []SeasonStartRating
+	if err := tx.Table("team_ratings r").
+		Select("DISTINCT ON (r.team_id) r.team_id AS team_id, r.rating_before AS rating").
+		Joins("JOIN matches m ON m.id = r.match_id").
+		Joins("JOIN competitions c ON c.id = m.competition_id").
+		Where("c.season_id = ? AND m.deleted_at IS NULL", seasonID).
+		Order("r.team_id, r.played_at ASC, r.created_at ASC").
+		Scan(&ratings).Error; err != nil {
+		r.Log.Errorf("Failed to find season start ratings for season %s: %v", seasonID, err)
+		return nil, err
+	}
+	return ratings, nil
+}
+
+// FindCompletedMatches returns every completed match with a score in the
+// order it was played, for a full recompute.
+func (r *ratingsRepoImpl) FindCompletedMatches(tx *gorm.DB) ([]entity.Match, error) {
+	var matches []entity.Match
+	if err := tx.Where("status = ? AND deleted_at IS NULL", "completed").
+		Where("home_score IS NOT NULL AND away_score IS NOT NULL").
+		Order("kickoff_at ASC, id ASC").Find(&matches).Error; err != nil {
+		r.Log.Errorf("Failed to find completed matches: %v", err)
+		return nil, err
+	}
+	return matches, nil
+}
+
+// Reset drops the rating history and every team's current rating.
+func (r *ratingsRepoImpl) Reset(tx *gorm.DB) error {
+	if err := tx.Where("1 = 1").Delete(&entity.TeamRating{}).Error; err != nil {
+		r.Log.Errorf("Failed to delete rating history: %v", err)
+		return err
+	}
+	if err := tx.Model(&entity.Team{}).Where("rating IS NOT NULL").Update("rating", nil).Error; err != nil {
+		r.Log.Errorf("Failed to reset team ratings: %v", err)
+		return err
+	}
+	return nil
+}
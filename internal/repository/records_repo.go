@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type RecordsRepository interface {
+	Repository[entity.Record]
+	FindByKey(tx *gorm.DB, key string) (*entity.Record, error)
+	FindFiltered(tx *gorm.DB, filter RecordFilter, page, limit int) ([]entity.Record, int64, error)
+	CountPlayerGoals(tx *gorm.DB, playerID, matchID string) (int64, error)
+	FindFastestGoalTime(tx *gorm.DB, competitionID *string, exceptGoalID string) (*int16, error)
+	SoftDeleteForGoal(tx *gorm.DB, goal *entity.Goal, matchGoals, careerGoals int64) error
+}
+
+// RecordFilter narrows a record listing. Empty fields are not applied.
+type RecordFilter struct {
+	Type          string
+	CompetitionID string
+	TeamID        string
+	PlayerID      string
+}
+
+type recordsRepoImpl struct {
+	Repository[entity.Record]
+	Log *logrus.Logger
+}
+
+func NewRecordsRepo(db *gorm.DB, log *logrus.Logger) RecordsRepository {
+	return &recordsRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.Record](db),
+	}
+}
+
+// FindByKey returns the record with the key, including withdrawn ones, or nil
+// when it was never detected.
+func (r *recordsRepoImpl) FindByKey(tx *gorm.DB, key string) (*entity.Record, error) {
+	var record entity.Record
+	if err := tx.Preload("Player").Where("record_key = ?", key).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.Log.Errorf("Failed to find record by key %s: %v", key, err)
+		return nil, err
+	}
+	return &record, nil
+}
+
+// FindFiltered returns a page of records, most recent first, and the number of
+// records matching the filter.
+func (r *recordsRepoImpl) FindFiltered(tx *gorm.DB, filter RecordFilter, page, limit int) ([]entity.Record, int64, error) {
+	filtered := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("deleted_at IS NULL")
+		if filter.Type != "" {
+			db = db.Where("type = ?", filter.Type)
+		}
+		if filter.CompetitionID != "" {
+			db = db.Where("competition_id = ?", filter.CompetitionID)
+		}
+		if filter.TeamID != "" {
+			db = db.Where("team_id = ?", filter.TeamID)
+		}
+		if filter.PlayerID != "" {
+			db = db.Where("player_id = ?", filter.PlayerID)
+		}
+		return db
+	}
+
+	var total int64
+	if err := tx.Model(&entity.Record{}).Scopes(filtered).Count(&total).Error; err != nil {
+		r.Log.Errorf("Failed to count records: %v", err)
+		return nil, 0, err
+	}
+
+	var records []entity.Record
+	if err := tx.Scopes(filtered).
+		Preload("Competition").Preload("Team").Preload("Player").
+		Order("achieved_at DESC, created_at DESC").
+		Offset((page - 1) * limit).Limit(limit).
+		Find(&records).Error; err != nil {
+		r.Log.Errorf("Failed to find records: %v", err)
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// CountPlayerGoals counts the player's goals in the match, or in every match
+// when matchID is empty.
+func (r *recordsRepoImpl) CountPlayerGoals(tx *gorm.DB, playerID, matchID string) (int64, error) {
+	query := tx.Table("goals g").
+		Joins("JOIN matches m ON m.id = g.match_id").
+		Where("g.player_id = ? AND g.deleted_at IS NULL AND m.deleted_at IS NULL", playerID)
+	if matchID != "" {
+		query = query.Where("g.match_id = ?", matchID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		r.Log.Errorf("Failed to count goals of player %s: %v", playerID, err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindFastestGoalTime returns the earliest minute any other goal was scored in
+// the competition, or in matches outside a competition when competitionID is
+// nil. It returns nil when there is no such goal.
+func (r *recordsRepoImpl) FindFastestGoalTime(tx *gorm.DB, competitionID *string, exceptGoalID string) (*int16, error) {
+	query := tx.Table("goals g").
+		Joins("JOIN matches m ON m.id = g.match_id").
+		Where("g.id <> ? AND g.deleted_at IS NULL AND m.deleted_at IS NULL", exceptGoalID)
+	if competitionID != nil {
+		query = query.Where("m.competition_id = ?", *competitionID)
+	} else {
+		query = query.Where("m.competition_id IS NULL")
+	}
+
+	var fastest *int16
+	if err := query.Select("MIN(g.goal_time)").Scan(&fastest).Error; err != nil {
+		r.Log.Errorf("Failed to find fastest goal: %v", err)
+		return nil, err
+	}
+	return fastest, nil
+}
+
+// SoftDeleteForGoal withdraws the records that no longer hold once the goal is
+// deleted: those set by the goal itself, a hat-trick in its match that is now
+// short of three goals and goal milestones above the scorer's new total.
+func (r *recordsRepoImpl) SoftDeleteForGoal(tx *gorm.DB, goal *entity.Goal, matchGoals, careerGoals int64) error {
+	withdrawn := tx.Where("goal_id = ?", goal.ID).
+		Or("type = ? AND player_id = ? AND value > ?", entity.RecordGoalMilestone, goal.PlayerID, careerGoals)
+	if matchGoals < 3 {
+		withdrawn = withdrawn.Or("type = ? AND match_id = ? AND player_id = ?", entity.RecordHatTrick, goal.MatchID, goal.PlayerID)
+	}
+	if err := tx.Model(&entity.Record{}).
+		Where("deleted_at IS NULL").
+		Where(withdrawn).
+		Update("deleted_at", gorm.Expr("CURRENT_TIMESTAMP")).Error; err != nil {
+		r.Log.Errorf("Failed to withdraw records of goal %s: %v", goal.ID, err)
+		return err
+	}
+	return nil
+}
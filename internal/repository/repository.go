@@ -7,6 +7,7 @@ type Repository[T any] interface {
 	FindByIDWithRelations(db *gorm.DB, id string, relations ...string) (*T, error)
 	FindAll(db *gorm.DB) ([]T, error)
 	FindAllWithRelations(db *gorm.DB, relations ...string) ([]T, error)
+	FindAllInBatches(db *gorm.DB, batchSize int, fn func([]T) error, relations ...string) error
 	Update(db *gorm.DB, entity *T) error
 	Create(db *gorm.DB, entity *T) error
 	Delete(db *gorm.DB, entity *T) error
@@ -65,6 +66,19 @@ func (r *repositoryImpl[T]) FindAllWithRelations(db *gorm.DB, relations ...strin
 	return entities, nil
 }
 
+// FindAllInBatches hands rows to fn batchSize at a time in primary key order,
+// so callers can stream a table without loading it whole.
+func (r *repositoryImpl[T]) FindAllInBatches(db *gorm.DB, batchSize int, fn func([]T) error, relations ...string) error {
+	var batch []T
+	query := db.Model(&batch).Where("deleted_at is null")
+	for _, relation := range relations {
+		query = query.Preload(relation)
+	}
+	return query.FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
+}
+
 func (r *repositoryImpl[T]) Update(db *gorm.DB, entity *T) error {
 	return db.Save(entity).Error
 }
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SchedulingRepository interface {
+	FindRuleByCompetitionID(db *gorm.DB, competitionID string) (*entity.CompetitionSchedulingRule, error)
+	SaveRule(db *gorm.DB, rule *entity.CompetitionSchedulingRule) error
+	FindBlackoutDates(db *gorm.DB, competitionID string) ([]entity.CompetitionBlackoutDate, error)
+	FindBlackoutDate(db *gorm.DB, competitionID string, date time.Time) (*entity.CompetitionBlackoutDate, error)
+	ReplaceBlackoutDates(db *gorm.DB, competitionID string, dates []entity.CompetitionBlackoutDate) error
+	FindVenueUnavailabilities(db *gorm.DB, venueID string) ([]entity.VenueUnavailability, error)
+	FindVenueUnavailabilityByID(db *gorm.DB, id string) (*entity.VenueUnavailability, error)
+	FindVenueUnavailabilitiesOnDate(db *gorm.DB, venueID string, date time.Time, competitionID *string) ([]entity.VenueUnavailability, error)
+	CreateVenueUnavailability(db *gorm.DB, unavailability *entity.VenueUnavailability) error
+	SoftDeleteVenueUnavailability(db *gorm.DB, id string) error
+}
+
+type schedulingRepoImpl struct {
+	DB  *gorm.DB
+	Log *logrus.Logger
+}
+
+func NewSchedulingRepo(db *gorm.DB, log *logrus.Logger) SchedulingRepository {
+	return &schedulingRepoImpl{
+		DB:  db,
+		Log: log,
+	}
+}
+
+func (s *schedulingRepoImpl) FindRuleByCompetitionID(db *gorm.DB, competitionID string) (*entity.CompetitionSchedulingRule, error) {
+	var rule entity.CompetitionSchedulingRule
+	if err := db.Where("competition_id = ?", competitionID).First(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (s *schedulingRepoImpl) SaveRule(db *gorm.DB, rule *entity.CompetitionSchedulingRule) error {
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "competition_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"min_rest_hours", "updated_at"}),
+	}).Create(rule).Error; err != nil {
+		s.Log.Errorf("Failed to save scheduling rules for competition %s: %v", rule.CompetitionID, err)
+		return err
+	}
+	return nil
+}
+
+func (s *schedulingRepoImpl) FindBlackoutDates(db *gorm.DB, competitionID string) ([]entity.CompetitionBlackoutDate, error) {
+	var dates []entity.CompetitionBlackoutDate
+	if err := db.Where("competition_id = ?", competitionID).Order("blackout_date ASC").Find(&dates).Error; err != nil {
+		s.Log.Errorf("Failed to find blackout dates for competition %s: %v", competitionID, err)
+		return nil, err
+	}
+	return dates, nil
+}
+
+// FindBlackoutDate returns nil without an error when the date is not blacked out.
+func (s *schedulingRepoImpl) FindBlackoutDate(db *gorm.DB, competitionID string, date time.Time) (*entity.CompetitionBlackoutDate, error) {
+	var dates []entity.CompetitionBlackoutDate
+	if err := db.Where("competition_id = ? AND blackout_date = ?", competitionID, date.Format("2006-01-02")).Limit(1).Find(&dates).Error; err != nil {
+		s.Log.Errorf("Failed to check blackout date %s for competition %s: %v", date.Format("2006-01-02"), competitionID, err)
+		return nil, err
+	}
+	if len(dates) == 0 {
+		return nil, nil
+	}
+	return &dates[0], nil
+}
+
+func (s *schedulingRepoImpl) ReplaceBlackoutDates(db *gorm.DB, competitionID string, dates []entity.CompetitionBlackoutDate) error {
+	if err := db.Where("competition_id = ?", competitionID).Delete(&entity.CompetitionBlackoutDate{}).Error; err != nil {
+		s.Log.Errorf("Failed to clear blackout dates for competition %s: %v", competitionID, err)
+		return err
+	}
+	for i := range dates {
+		if err := db.Create(&dates[i]).Error; err != nil {
+			s.Log.Errorf("Failed to create blackout date for competition %s: %v", competitionID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *schedulingRepoImpl) FindVenueUnavailabilities(db *gorm.DB, venueID string) ([]entity.VenueUnavailability, error) {
+	var unavailabilities []entity.VenueUnavailability
+	if err := db.Where("venue_id = ? AND deleted_at IS NULL", venueID).Order("start_date ASC").Find(&unavailabilities).Error; err != nil {
+		s.Log.Errorf("Failed to find unavailability of venue %s: %v", venueID, err)
+		return nil, err
+	}
+	return unavailabilities, nil
+}
+
+func (s *schedulingRepoImpl) FindVenueUnavailabilityByID(db *gorm.DB, id string) (*entity.VenueUnavailability, error) {
+	var unavailability entity.VenueUnavailability
+	if err := db.Where("id = ? AND deleted_at IS NULL", id).First(&unavailability).Error; err != nil {
+		return nil, err
+	}
+	return &unavailability, nil
+}
+
+// FindVenueUnavailabilitiesOnDate returns the periods covering the date that
+// apply to every competition or to the given one.
+func (s *schedulingRepoImpl) FindVenueUnavailabilitiesOnDate(db *gorm.DB, venueID string, date time.Time, competitionID *string) ([]entity.VenueUnavailability, error) {
+	var unavailabilities []entity.VenueUnavailability
+	query := db.Where("venue_id = ? AND deleted_at IS NULL AND start_date <= ? AND end_date >= ?", venueID, date.Format("2006-01-02"), date.Format("2006-01-02"))
+	if competitionID != nil {
+		query = query.Where("competition_id IS NULL OR competition_id = ?", *competitionID)
+	} else {
+		query = query.Where("competition_id IS NULL")
+	}
+	if err := query.Find(&unavailabilities).Error; err != nil {
+		s.Log.Errorf("Failed to check unavailability of venue %s on %s: %v", venueID, date.Format("2006-01-02"), err)
+		return nil, err
+	}
+	return unavailabilities, nil
+}
+
+func (s *schedulingRepoImpl) CreateVenueUnavailability(db *gorm.DB, unavailability *entity.VenueUnavailability) error {
+	if err := db.Create(unavailability).Error; err != nil {
+		s.Log.Errorf("Failed to create unavailability for venue %s: %v", unavailability.VenueID, err)
+		return err
+	}
+	return nil
+}
+
+func (s *schedulingRepoImpl) SoftDeleteVenueUnavailability(db *gorm.DB, id string) error {
+	if err := db.Model(&entity.VenueUnavailability{}).Where("id = ?", id).Update("deleted_at", gorm.Expr("CURRENT_TIMESTAMP")).Error; err != nil {
+		s.Log.Errorf("Failed to delete venue unavailability %s: %v", id, err)
+		return err
+	}
+	return nil
+}
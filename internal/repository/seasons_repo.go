@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SeasonsRepository interface {
+	Repository[entity.Season]
+	FindByIDWithCompetitions(db *gorm.DB, id string) (*entity.Season, error)
+	CheckSeasonExistsByName(db *gorm.DB, name string) (bool, error)
+}
+
+type seasonsRepoImpl struct {
+	Repository[entity.Season]
+	Log *logrus.Logger
+}
+
+func NewSeasonsRepo(db *gorm.DB, log *logrus.Logger) SeasonsRepository {
+	return &seasonsRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.Season](db),
+	}
+}
+
+// FindByIDWithCompetitions loads the season with its competitions that have not
+// been deleted.
+func (s *seasonsRepoImpl) FindByIDWithCompetitions(db *gorm.DB, id string) (*entity.Season, error) {
+	var season entity.Season
+	if err := db.Preload("Competitions", "deleted_at IS NULL").Where("id = ? AND deleted_at IS NULL", id).First(&season).Error; err != nil {
+		return nil, err
+	}
+	return &season, nil
+}
+
+func (s *seasonsRepoImpl) CheckSeasonExistsByName(db *gorm.DB, name string) (bool, error) {
+	var count int64
+	if err := db.Model(&entity.Season{}).Where("name = ? AND deleted_at IS NULL", name).Count(&count).Error; err != nil {
+		s.Log.Errorf("Failed to check if season %s exists: %v", name, err)
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type StaffRepository interface {
+	Repository[entity.StaffMember]
+	FindByTeamID(db *gorm.DB, teamID string) ([]entity.StaffMember, error)
+	FindByTeamIDs(db *gorm.DB, teamIDs []string) ([]entity.StaffMember, error)
+	FindHeadCoachOnDate(db *gorm.DB, teamID string, date time.Time) (*entity.StaffMember, error)
+	CountOverlappingTenures(db *gorm.DB, staff *entity.StaffMember) (int64, error)
+}
+
+type staffRepoImpl struct {
+	Repository[entity.StaffMember]
+	Log *logrus.Logger
+}
+
+func NewStaffRepo(db *gorm.DB, log *logrus.Logger) StaffRepository {
+	return &staffRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.StaffMember](db),
+	}
+}
+
+func (s *staffRepoImpl) FindByTeamID(db *gorm.DB, teamID string) ([]entity.StaffMember, error) {
+	var staff []entity.StaffMember
+	if err := db.Where("team_id = ? AND deleted_at IS NULL", teamID).Order("start_date DESC").Find(&staff).Error; err != nil {
+		s.Log.Errorf("Failed to find staff by team ID %s: %v", teamID, err)
+		return nil, err
+	}
+	return staff, nil
+}
+
+func (s *staffRepoImpl) FindByTeamIDs(db *gorm.DB, teamIDs []string) ([]entity.StaffMember, error) {
+	var staff []entity.StaffMember
+	if len(teamIDs) == 0 {
+		return staff, nil
+	}
+	if err := db.Where("team_id IN ? AND deleted_at IS NULL", teamIDs).Order("team_id, start_date DESC").Find(&staff).Error; err != nil {
+		s.Log.Errorf("Failed to find staff by team IDs: %v", err)
+		return nil, err
+	}
+	return staff, nil
+}
+
+// FindHeadCoachOnDate returns the team's head coach on the given date, or nil when
+// the post was vacant.
+func (s *staffRepoImpl) FindHeadCoachOnDate(db *gorm.DB, teamID string, date time.Time) (*entity.StaffMember, error) {
+	var staff []entity.StaffMember
+	if err := db.Where("team_id = ? AND role = ? AND deleted_at IS NULL", teamID, "head_coach").
+		Where("start_date <= ? AND (end_date IS NULL OR end_date >= ?)", date, date).
+		Order("start_date DESC").
+		Limit(1).
+		Find(&staff).Error; err != nil {
+		s.Log.Errorf("Failed to find head coach for team %s: %v", teamID, err)
+		return nil, err
+	}
+	if len(staff) == 0 {
+		return nil, nil
+	}
+	return &staff[0], nil
+}
+
+// CountOverlappingTenures counts other members of the same team and role whose
+// tenure overlaps the given one. Open-ended tenures run indefinitely.
+func (s *staffRepoImpl) CountOverlappingTenures(db *gorm.DB, staff *entity.StaffMember) (int64, error) {
+	var count int64
+	query := db.Model(&entity.StaffMember{}).
+		Where("team_id = ? AND role = ? AND id <> ? AND deleted_at IS NULL", staff.TeamID, staff.Role, staff.ID).
+		Where("(end_date IS NULL OR end_date >= ?)", staff.StartDate)
+	if staff.EndDate != nil {
+		query = query.Where("start_date <= ?", *staff.EndDate)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		s.Log.Errorf("Failed to count overlapping tenures for team %s: %v", staff.TeamID, err)
+		return 0, err
+	}
+	return count, nil
+}
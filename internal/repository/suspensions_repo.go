@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SuspensionsRepository interface {
+	Repository[entity.PlayerSuspension]
+	FindByPlayerID(db *gorm.DB, playerID string) ([]entity.PlayerSuspension, error)
+	FindActiveByPlayerID(db *gorm.DB, playerID string, competitionID *string) ([]entity.PlayerSuspension, error)
+	ServeForMatch(db *gorm.DB, match *entity.Match) error
+}
+
+type suspensionsRepoImpl struct {
+	Repository[entity.PlayerSuspension]
+	Log *logrus.Logger
+}
+
+func NewSuspensionsRepo(db *gorm.DB, log *logrus.Logger) SuspensionsRepository {
+	return &suspensionsRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.PlayerSuspension](db),
+	}
+}
+
+func (s *suspensionsRepoImpl) FindByPlayerID(db *gorm.DB, playerID string) ([]entity.PlayerSuspension, error) {
+	var suspensions []entity.PlayerSuspension
+	if err := db.Where("player_id = ? AND deleted_at IS NULL", playerID).Order("created_at DESC").Find(&suspensions).Error; err != nil {
+		s.Log.Errorf("Failed to find suspensions by player ID %s: %v", playerID, err)
+		return nil, err
+	}
+	return suspensions, nil
+}
+
+// FindActiveByPlayerID returns suspensions with matches left to serve that apply to
+// the given competition. Suspensions without a competition apply everywhere.
+func (s *suspensionsRepoImpl) FindActiveByPlayerID(db *gorm.DB, playerID string, competitionID *string) ([]entity.PlayerSuspension, error) {
+	var suspensions []entity.PlayerSuspension
+	query := db.Where("player_id = ? AND matches_remaining > 0 AND deleted_at IS NULL", playerID)
+	if competitionID != nil {
+		query = query.Where("(competition_id IS NULL OR competition_id = ?)", *competitionID)
+	} else {
+		query = query.Where("competition_id IS NULL")
+	}
+	if err := query.Find(&suspensions).Error; err != nil {
+		s.Log.Errorf("Failed to find active suspensions by player ID %s: %v", playerID, err)
+		return nil, err
+	}
+	return suspensions, nil
+}
+
+// ServeForMatch decrements the remaining count of every active suspension that the
+// completed match counts towards, for players of both teams.
+func (s *suspensionsRepoImpl) ServeForMatch(db *gorm.DB, match *entity.Match) error {
+	query := db.Model(&entity.PlayerSuspension{}).
+		Where("matches_remaining > 0 AND deleted_at IS NULL").
+		Where("player_id IN (?)", db.Model(&entity.Player{}).Select("id").Where("team_id IN ?", []string{match.HomeTeamID, match.AwayTeamID}))
+	if match.CompetitionID != nil {
+		query = query.Where("(competition_id IS NULL OR competition_id = ?)", *match.CompetitionID)
+	} else {
+		query = query.Where("competition_id IS NULL")
+	}
+	if err := query.Update("matches_remaining", gorm.Expr("matches_remaining - 1")).Error; err != nil {
+		s.Log.Errorf("Failed to serve suspensions for match %s: %v", match.ID, err)
+		return err
+	}
+	return nil
+}
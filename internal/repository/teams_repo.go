@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"errors"
+
 	"github.com/Fadlihardiyanto/football-api/internal/entity"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -10,6 +12,7 @@ type TeamsRepository interface {
 	Repository[entity.Team]
 	CheckTeamExistsByTeamID(db *gorm.DB, teamID string) (bool, error)
 	CheckTeamExistsByName(db *gorm.DB, name string) (bool, error)
+	FindByName(db *gorm.DB, name string) (*entity.Team, error)
 }
 
 type teamsRepoImpl struct {
@@ -41,3 +44,16 @@ func (t *teamsRepoImpl) CheckTeamExistsByName(db *gorm.DB, name string) (bool, e
 	}
 	return count > 0, nil
 }
+
+// FindByName returns the team with the name, or nil when there is none.
+func (t *teamsRepoImpl) FindByName(db *gorm.DB, name string) (*entity.Team, error) {
+	var team entity.Team
+	if err := db.Where("name = ? AND deleted_at IS NULL", name).First(&team).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		t.Log.Errorf("Failed to find team by name %s: %v", name, err)
+		return nil, err
+	}
+	return &team, nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type VenuesRepository interface {
+	Repository[entity.Venue]
+	CheckVenueExistsByNameAndCity(db *gorm.DB, name, city string) (bool, error)
+	UpdateMatchTimezones(db *gorm.DB, venueID, timezone string) (int64, error)
+	UpdateHomeMatchTimezones(db *gorm.DB, teamID, timezone string) (int64, error)
+}
+
+type venuesRepoImpl struct {
+	Repository[entity.Venue]
+	Log *logrus.Logger
+}
+
+func NewVenuesRepo(db *gorm.DB, log *logrus.Logger) VenuesRepository {
+	return &venuesRepoImpl{
+		Log:        log,
+		Repository: NewRepository[entity.Venue](db),
+	}
+}
+
+func (v *venuesRepoImpl) CheckVenueExistsByNameAndCity(db *gorm.DB, name, city string) (bool, error) {
+	var count int64
+	if err := db.Model(&entity.Venue{}).Where("name = ? AND city = ? AND deleted_at IS NULL", name, city).Count(&count).Error; err != nil {
+		v.Log.Errorf("Failed to check if venue %s in %s exists: %v", name, city, err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// UpdateMatchTimezones moves the matches played at the venue, including home
+// matches of teams based there that name no venue, to a new timezone. Each
+// keeps its local kickoff time, so its kickoff instant shifts.
+func (v *venuesRepoImpl) UpdateMatchTimezones(db *gorm.DB, venueID, timezone string) (int64, error) {
+	result := db.Model(&entity.Match{}).
+		Where("deleted_at IS NULL AND timezone <> ?", timezone).
+		Where("venue_id = ? OR (venue_id IS NULL AND home_team_id IN (?))", venueID,
+			db.Model(&entity.Team{}).Select("id").Where("home_venue_id = ?", venueID)).
+		Updates(map[string]any{
+			"kickoff_at": gorm.Expr("(kickoff_at AT TIME ZONE timezone) AT TIME ZONE ?", timezone),
+			"timezone":   timezone,
+		})
+	if result.Error != nil {
+		v.Log.Errorf("Failed to update timezone of matches at venue %s: %v", venueID, result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// UpdateHomeMatchTimezones moves the team's scheduled home matches that name no
+// venue, and so are played at its home venue, to a new timezone. Each keeps its
+// local kickoff time, so its kickoff instant shifts.
+func (v *venuesRepoImpl) UpdateHomeMatchTimezones(db *gorm.DB, teamID, timezone string) (int64, error) {
+	result := db.Model(&entity.Match{}).
+		Where("deleted_at IS NULL AND timezone <> ?", timezone).
+		Where("home_team_id = ? AND venue_id IS NULL AND status = ?", teamID, "scheduled").
+		Updates(map[string]any{
+			"kickoff_at": gorm.Expr("(kickoff_at AT TIME ZONE timezone) AT TIME ZONE ?", timezone),
+			"timezone":   timezone,
+		})
+	if result.Error != nil {
+		v.Log.Errorf("Failed to update timezone of home matches of team %s: %v", teamID, result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,420 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// goalTimingBuckets are the periods goals are grouped in. Stoppage time at the
+// end of each half has its own bucket, extra time goes in 15-minute buckets.
+var goalTimingBuckets = []string{"1-15", "16-30", "31-45", "45+", "46-60", "61-75", "76-90", "90+", "91-105", "106-120"}
+
+var goalTimingHalves = []string{"first_half", "second_half", "extra_time"}
+
+// seasonSummaryPlayers is the number of top scorers and most-used players in a
+// season summary.
+const seasonSummaryPlayers = 5
+
+type AnalyticsUseCase interface {
+	GoalTiming(ctx context.Context, request *model.GoalTimingRequest) (*model.GoalTimingResponse, error)
+	SeasonSummary(ctx context.Context, request *model.TeamSeasonSummaryRequest) (*model.TeamSeasonSummaryResponse, error)
+}
+
+type analyticsUseCaseImpl struct {
+	GoalsRepo   repository.GoalsRepository
+	MatchesRepo repository.MatchesRepository
+	LineupsRepo repository.LineupsRepository
+	TeamsRepo   repository.TeamsRepository
+	SeasonsRepo repository.SeasonsRepository
+	DB          *gorm.DB
+	Log         *logrus.Logger
+}
+
+func NewAnalyticsUseCase(goalsRepo repository.GoalsRepository, matchesRepo repository.MatchesRepository, lineupsRepo repository.LineupsRepository, teamsRepo repository.TeamsRepository, seasonsRepo repository.SeasonsRepository, db *gorm.DB, log *logrus.Logger) AnalyticsUseCase {
+	return &analyticsUseCaseImpl{
+		GoalsRepo:   goalsRepo,
+		MatchesRepo: matchesRepo,
+		LineupsRepo: lineupsRepo,
+		TeamsRepo:   teamsRepo,
+		SeasonsRepo: seasonsRepo,
+		DB:          db,
+		Log:         log,
+	}
+}
+
+// goalTimingBucket returns the 15-minute bucket and the half of the goal, and
+// whether it was scored in stoppage time.
+func goalTimingBucket(goal repository.GoalTiming) (string, string, bool) {
+	stoppage := goal.AddedTime != nil && *goal.AddedTime > 0
+
+	switch {
+	case goal.GoalTime > 90:
+		if goal.GoalTime <= 105 {
+			return "91-105", "extra_time", stoppage
+		}
+		return "106-120", "extra_time", stoppage
+	case stoppage && goal.GoalTime == 45:
+		return "45+", "first_half", true
+	case stoppage && goal.GoalTime == 90:
+		return "90+", "second_half", true
+	case goal.GoalTime <= 15:
+		return "1-15", "first_half", false
+	case goal.GoalTime <= 30:
+		return "16-30", "first_half", false
+	case goal.GoalTime <= 45:
+		return "31-45", "first_half", false
+	case goal.GoalTime <= 60:
+		return "46-60", "second_half", false
+	case goal.GoalTime <= 75:
+		return "61-75", "second_half", false
+	default:
+		return "76-90", "second_half", false
+	}
+}
+
+func newGoalTimingBuckets(periods []string) []model.GoalTimingBucket {
+	buckets := make([]model.GoalTimingBucket, len(periods))
+	for i, period := range periods {
+		buckets[i].Period = period
+	}
+	return buckets
+}
+
+func countGoalTiming(buckets []model.GoalTimingBucket, period string, scored bool) {
+	for i := range buckets {
+		if buckets[i].Period == period {
+			if scored {
+				buckets[i].Scored++
+			} else {
+				buckets[i].Conceded++
+			}
+			return
+		}
+	}
+}
+
+// goalTimingBreakdown builds the breakdown from the goals of the team's
+// matches, in the order they were scored.
+func goalTimingBreakdown(teamID string, goals []repository.GoalTiming) model.GoalTimingBreakdown {
+	breakdown := model.GoalTimingBreakdown{
+		Buckets:      newGoalTimingBuckets(goalTimingBuckets),
+		Halves:       newGoalTimingBuckets(goalTimingHalves),
+		StoppageTime: newGoalTimingBuckets(goalTimingHalves),
+	}
+
+	for start := 0; start < len(goals); {
+		end := start
+		for end < len(goals) && goals[end].MatchID == goals[start].MatchID {
+			end++
+		}
+		matchGoals := goals[start:end]
+		start = end
+		breakdown.Matches++
+
+		first := matchGoals[0]
+		home := first.HomeTeamID == teamID
+		goalsFor, goalsAgainst := first.HomeScore, first.AwayScore
+		if !home {
+			goalsFor, goalsAgainst = goalsAgainst, goalsFor
+		}
+
+		var scoredFirst, concededFirst, trailed, led bool
+		var running int
+		for _, goal := range matchGoals {
+			if goal.ScorerTeamID != goal.HomeTeamID && goal.ScorerTeamID != goal.AwayTeamID {
+				// the scorer has since moved to another club
+				continue
+			}
+			scored := goal.ScorerTeamID == teamID
+			bucket, half, stoppage := goalTimingBucket(goal)
+			countGoalTiming(breakdown.Buckets, bucket, scored)
+			countGoalTiming(breakdown.Halves, half, scored)
+			if stoppage {
+				countGoalTiming(breakdown.StoppageTime, half, scored)
+			}
+
+			if !scoredFirst && !concededFirst {
+				scoredFirst, concededFirst = scored, !scored
+			}
+			if scored {
+				running++
+			} else {
+				running--
+			}
+			trailed = trailed || running < 0
+			led = led || running > 0
+		}
+
+		won, drew := goalsFor > goalsAgainst, goalsFor == goalsAgainst
+		switch {
+		case scoredFirst:
+			breakdown.FirstGoal.ScoredFirst++
+			if won {
+				breakdown.FirstGoal.ScoredFirstWins++
+			} else if drew {
+				breakdown.FirstGoal.ScoredFirstDraws++
+			} else {
+				breakdown.FirstGoal.ScoredFirstLosses++
+			}
+		case concededFirst:
+			breakdown.FirstGoal.ConcededFirst++
+			if won {
+				breakdown.FirstGoal.ConcededFirstWins++
+			} else if drew {
+				breakdown.FirstGoal.ConcededFirstDraws++
+			} else {
+				breakdown.FirstGoal.ConcededFirstLosses++
+			}
+		}
+
+		if trailed && won {
+			breakdown.Comebacks.Wins++
+		} else if trailed && drew {
+			breakdown.Comebacks.Draws++
+		}
+		if led && !won && !drew {
+			breakdown.Comebacks.LeadsLost++
+		} else if led && drew {
+			breakdown.Comebacks.LeadsDrawn++
+		}
+	}
+
+	if n := breakdown.FirstGoal.ScoredFirst; n > 0 {
+		breakdown.FirstGoal.ScoredFirstWinRate = math.Round(float64(breakdown.FirstGoal.ScoredFirstWins)/float64(n)*10000) / 10000
+	}
+	if n := breakdown.FirstGoal.ConcededFirst; n > 0 {
+		breakdown.FirstGoal.ConcededFirstWinRate = math.Round(float64(breakdown.FirstGoal.ConcededFirstWins)/float64(n)*10000) / 10000
+	}
+
+	return breakdown
+}
+
+func (a *analyticsUseCaseImpl) GoalTiming(ctx context.Context, request *model.GoalTimingRequest) (*model.GoalTimingResponse, error) {
+	tx := a.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		a.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	team, err := a.TeamsRepo.FindByID(tx, request.TeamID)
+	if err != nil {
+		a.Log.Errorf("Failed to find team by ID %s: %v", request.TeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	response := &model.GoalTimingResponse{Team: model.TeamShort{ID: team.ID, Name: team.Name}}
+	if request.SeasonID != "" {
+		if _, err := a.SeasonsRepo.FindByID(tx, request.SeasonID); err != nil {
+			a.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+			tx.Rollback()
+			return nil, common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+		}
+		response.SeasonID = &request.SeasonID
+	}
+
+	goals, err := a.GoalsRepo.FindTeamGoalTimings(tx, team.ID, request.SeasonID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find goal timings")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		a.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	response.Overall = goalTimingBreakdown(team.ID, goals)
+
+	// goals come in kickoff order, so seasons are grouped in the order played
+	var order []string
+	seasons := map[string][]repository.GoalTiming{}
+	names := map[string]*string{}
+	for _, goal := range goals {
+		key := ""
+		if goal.SeasonID != nil {
+			key = *goal.SeasonID
+		}
+		if _, ok := seasons[key]; !ok {
+			order = append(order, key)
+			names[key] = goal.SeasonName
+		}
+		seasons[key] = append(seasons[key], goal)
+	}
+
+	response.Seasons = []model.SeasonGoalTiming{}
+	for _, key := range order {
+		season := model.SeasonGoalTiming{
+			SeasonName: names[key],
+			Breakdown:  goalTimingBreakdown(team.ID, seasons[key]),
+		}
+		if key != "" {
+			id := key
+			season.SeasonID = &id
+		}
+		response.Seasons = append(response.Seasons, season)
+	}
+
+	return response, nil
+}
+
+func (r *teamResult) addTo(record *model.SeasonRecord) {
+	record.Played++
+	switch r.Outcome() {
+	case "W":
+		record.Wins++
+	case "D":
+		record.Draws++
+	default:
+		record.Losses++
+	}
+	record.GoalsFor += r.GoalsFor
+	record.GoalsAgainst += r.GoalsAgainst
+	record.GoalDifference = record.GoalsFor - record.GoalsAgainst
+	record.Points += r.Points()
+}
+
+func averageAttendance(total, matches int) *float64 {
+	if matches == 0 {
+		return nil
+	}
+	average := math.Round(float64(total)/float64(matches)*100) / 100
+	return &average
+}
+
+func (a *analyticsUseCaseImpl) SeasonSummary(ctx context.Context, request *model.TeamSeasonSummaryRequest) (*model.TeamSeasonSummaryResponse, error) {
+	tx := a.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		a.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	team, err := a.TeamsRepo.FindByID(tx, request.TeamID)
+	if err != nil {
+		a.Log.Errorf("Failed to find team by ID %s: %v", request.TeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	season, err := a.SeasonsRepo.FindByID(tx, request.SeasonID)
+	if err != nil {
+		a.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+	}
+
+	matches, err := a.MatchesRepo.FindCompletedByTeamAndSeason(tx, team.ID, season.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find team results")
+	}
+
+	scorers, err := a.GoalsRepo.FindTeamSeasonScorers(tx, team.ID, season.ID, seasonSummaryPlayers)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find top scorers")
+	}
+
+	appearances, err := a.LineupsRepo.FindTeamSeasonAppearances(tx, team.ID, season.ID, seasonSummaryPlayers)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find player appearances")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		a.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	response := &model.TeamSeasonSummaryResponse{
+		Team:            model.TeamShort{ID: team.ID, Name: team.Name},
+		SeasonID:        season.ID,
+		SeasonName:      season.Name,
+		TopScorers:      []model.SeasonScorer{},
+		MostUsedPlayers: []model.PlayerAppearances{},
+	}
+
+	loc := common.LocationFromContext(ctx)
+	var biggestWin, biggestLoss *teamResult
+	var attendance, attended, homeAttendance, homeAttended int
+	for _, result := range teamResults(team.ID, matches) {
+		result.addTo(&response.Overall)
+		if result.Home {
+			result.addTo(&response.Home)
+		} else {
+			result.addTo(&response.Away)
+		}
+		if result.GoalsAgainst == 0 {
+			response.CleanSheets++
+		}
+		if result.GoalsFor == 0 {
+			response.FailedToScore++
+		}
+
+		margin := result.GoalsFor - result.GoalsAgainst
+		if margin > 0 && (biggestWin == nil || margin > biggestWin.GoalsFor-biggestWin.GoalsAgainst ||
+			(margin == biggestWin.GoalsFor-biggestWin.GoalsAgainst && result.GoalsFor > biggestWin.GoalsFor)) {
+			biggestWin = &result
+		}
+		if margin < 0 && (biggestLoss == nil || margin < biggestLoss.GoalsFor-biggestLoss.GoalsAgainst ||
+			(margin == biggestLoss.GoalsFor-biggestLoss.GoalsAgainst && result.GoalsAgainst > biggestLoss.GoalsAgainst)) {
+			biggestLoss = &result
+		}
+
+		if result.Match.Attendance != nil {
+			attendance += *result.Match.Attendance
+			attended++
+			if result.Home {
+				homeAttendance += *result.Match.Attendance
+				homeAttended++
+			}
+		}
+	}
+
+	if biggestWin != nil {
+		win := biggestWin.FormResult(loc)
+		response.BiggestWin = &win
+	}
+	if biggestLoss != nil {
+		loss := biggestLoss.FormResult(loc)
+		response.BiggestLoss = &loss
+	}
+	response.AverageAttendance = averageAttendance(attendance, attended)
+	response.AverageHomeAttendance = averageAttendance(homeAttendance, homeAttended)
+
+	for _, scorer := range scorers {
+		response.TopScorers = append(response.TopScorers, model.SeasonScorer{
+			PlayerID:   scorer.PlayerID,
+			PlayerName: scorer.PlayerName,
+			Goals:      scorer.Goals,
+		})
+	}
+	for _, player := range appearances {
+		response.MostUsedPlayers = append(response.MostUsedPlayers, model.PlayerAppearances{
+			PlayerID:    player.PlayerID,
+			PlayerName:  player.PlayerName,
+			Appearances: player.Appearances,
+			Starts:      player.Starts,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,611 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// The documents of an archive, in the order they are written and restored.
+const (
+	archiveManifest         = "manifest.json"
+	archiveSeasons          = "seasons"
+	archiveCompetitions     = "competitions"
+	archiveCompetitionTeams = "competition_teams"
+	archiveVenues           = "venues"
+	archiveTeams            = "teams"
+	archivePlayers          = "players"
+	archiveMatches          = "matches"
+	archiveGoals            = "goals"
+)
+
+// logoUploadDir is where TeamsController.UploadLogo stores logos, one
+// directory per team.
+const logoUploadDir = "uploads/logos"
+
+type ArchiveUseCase interface {
+	Export(ctx context.Context, request *model.ArchiveExportRequest, w io.Writer) error
+	Restore(ctx context.Context, request *model.ArchiveRestoreRequest) (*model.ArchiveRestoreResponse, error)
+}
+
+type archiveUseCaseImpl struct {
+	ArchiveRepo      repository.ArchiveRepository
+	SeasonsRepo      repository.SeasonsRepository
+	CompetitionsRepo repository.CompetitionsRepository
+	VenuesRepo       repository.VenuesRepository
+	TeamsRepo        repository.TeamsRepository
+	PlayersRepo      repository.PlayersRepository
+	MatchesRepo      repository.MatchesRepository
+	GoalsRepo        repository.GoalsRepository
+	LogsProducer     *messaging.LogProducer
+	DB               *gorm.DB
+	Log              *logrus.Logger
+}
+
+func NewArchiveUseCase(archiveRepo repository.ArchiveRepository, seasonsRepo repository.SeasonsRepository, competitionsRepo repository.CompetitionsRepository, venuesRepo repository.VenuesRepository, teamsRepo repository.TeamsRepository, playersRepo repository.PlayersRepository, matchesRepo repository.MatchesRepository, goalsRepo repository.GoalsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) ArchiveUseCase {
+	return &archiveUseCaseImpl{
+		ArchiveRepo:      archiveRepo,
+		SeasonsRepo:      seasonsRepo,
+		CompetitionsRepo: competitionsRepo,
+		VenuesRepo:       venuesRepo,
+		TeamsRepo:        teamsRepo,
+		PlayersRepo:      playersRepo,
+		MatchesRepo:      matchesRepo,
+		GoalsRepo:        goalsRepo,
+		LogsProducer:     logsProducer,
+		DB:               db,
+		Log:              log,
+	}
+}
+
+// writeArchiveDocument streams the rows found by find into name.json as a
+// JSON array and returns how many it wrote.
+func writeArchiveDocument[T, D any](zw *zip.Writer, name string, find func(fn func([]T) error) error, convert func(*T) D) (int, error) {
+	w, err := zw.Create(name + ".json")
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	err = find(func(batch []T) error {
+		for i := range batch {
+			data, err := json.Marshal(convert(&batch[i]))
+			if err != nil {
+				return err
+			}
+			separator := ",\n"
+			if count == 0 {
+				separator = "[\n"
+			}
+			if _, err := io.WriteString(w, separator); err != nil {
+				return err
+			}
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	end := "\n]\n"
+	if count == 0 {
+		end = "[]\n"
+	}
+	_, err = io.WriteString(w, end)
+	return count, err
+}
+
+// archiveLogo is an uploaded logo to copy into the archive.
+type archiveLogo struct {
+	source string
+	name   string
+}
+
+// Export writes a zip of JSON documents, one per table, with the uploaded team
+// logos under logos/ and a manifest describing the archive. Lineups, cards,
+// officials, ratings and the other match details are not archived.
+func (a *archiveUseCaseImpl) Export(ctx context.Context, request *model.ArchiveExportRequest, w io.Writer) error {
+	tx := a.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		a.Log.Warnf("Invalid request body: %+v", err)
+		tx.Rollback()
+		return err
+	}
+
+	manifest := &model.ArchiveManifest{
+		Version:   model.ArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+		Counts:    map[string]int{},
+	}
+	if request.SeasonID != "" {
+		season, err := a.SeasonsRepo.FindByID(tx, request.SeasonID)
+		if err != nil {
+			a.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+			tx.Rollback()
+			return common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+		}
+		archived := converter.ToArchiveSeason(season)
+		manifest.Season = &archived
+	}
+
+	seasonID := request.SeasonID
+	var logos []archiveLogo
+	zw := zip.NewWriter(w)
+	err := func() error {
+		var err error
+		if manifest.Counts[archiveSeasons], err = writeArchiveDocument(zw, archiveSeasons, func(fn func([]entity.Season) error) error {
+			return a.ArchiveRepo.FindSeasons(tx, seasonID, exportBatchSize, fn)
+		}, converter.ToArchiveSeason); err != nil {
+			return err
+		}
+		if manifest.Counts[archiveCompetitions], err = writeArchiveDocument(zw, archiveCompetitions, func(fn func([]entity.Competition) error) error {
+			return a.ArchiveRepo.FindCompetitions(tx, seasonID, exportBatchSize, fn)
+		}, converter.ToArchiveCompetition); err != nil {
+			return err
+		}
+		if manifest.Counts[archiveCompetitionTeams], err = writeArchiveDocument(zw, archiveCompetitionTeams, func(fn func([]entity.CompetitionTeam) error) error {
+			registrations, err := a.ArchiveRepo.FindCompetitionTeams(tx, seasonID)
+			if err != nil {
+				return err
+			}
+			return fn(registrations)
+		}, converter.ToArchiveCompetitionTeam); err != nil {
+			return err
+		}
+		if manifest.Counts[archiveVenues], err = writeArchiveDocument(zw, archiveVenues, func(fn func([]entity.Venue) error) error {
+			return a.ArchiveRepo.FindVenues(tx, seasonID, exportBatchSize, fn)
+		}, converter.ToArchiveVenue); err != nil {
+			return err
+		}
+		if manifest.Counts[archiveTeams], err = writeArchiveDocument(zw, archiveTeams, func(fn func([]entity.Team) error) error {
+			return a.ArchiveRepo.FindTeams(tx, seasonID, exportBatchSize, fn)
+		}, func(team *entity.Team) model.ArchiveTeam {
+			archived := converter.ToArchiveTeam(team)
+			if logo := filepath.ToSlash(team.Logo); strings.HasPrefix(logo, "uploads/") {
+				// the file is copied in once the document is complete
+				archived.Logo = path.Join("logos", team.ID, path.Base(logo))
+				logos = append(logos, archiveLogo{source: team.Logo, name: archived.Logo})
+			}
+			return archived
+		}); err != nil {
+			return err
+		}
+		if manifest.Counts[archivePlayers], err = writeArchiveDocument(zw, archivePlayers, func(fn func([]entity.Player) error) error {
+			return a.ArchiveRepo.FindPlayers(tx, seasonID, exportBatchSize, fn)
+		}, converter.ToArchivePlayer); err != nil {
+			return err
+		}
+		if manifest.Counts[archiveMatches], err = writeArchiveDocument(zw, archiveMatches, func(fn func([]entity.Match) error) error {
+			return a.ArchiveRepo.FindMatches(tx, seasonID, exportBatchSize, fn)
+		}, converter.ToArchiveMatch); err != nil {
+			return err
+		}
+		if manifest.Counts[archiveGoals], err = writeArchiveDocument(zw, archiveGoals, func(fn func([]entity.Goal) error) error {
+			return a.ArchiveRepo.FindGoals(tx, seasonID, exportBatchSize, fn)
+		}, converter.ToArchiveGoal); err != nil {
+			return err
+		}
+
+		for _, logo := range logos {
+			copied, err := copyArchiveLogo(zw, logo)
+			if err != nil {
+				return err
+			}
+			if !copied {
+				a.Log.Warnf("Logo %s is missing and was not archived", logo.source)
+				continue
+			}
+			manifest.Logos++
+		}
+
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return err
+		}
+		mw, err := zw.Create(archiveManifest)
+		if err != nil {
+			return err
+		}
+		if _, err := mw.Write(data); err != nil {
+			return err
+		}
+		return zw.Close()
+	}()
+	if err != nil {
+		a.Log.Errorf("Failed to export archive: %v", err)
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to export archive")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		a.Log.Errorf("Failed to commit transaction: %v", err)
+		return common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return nil
+}
+
+// copyArchiveLogo copies an uploaded logo into the archive, reporting false
+// when the file no longer exists.
+func copyArchiveLogo(zw *zip.Writer, logo archiveLogo) (bool, error) {
+	file, err := os.Open(logo.source)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	w, err := zw.Create(logo.name)
+	if err != nil {
+		return false, err
+	}
+	if _, err := io.Copy(w, file); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func readArchiveFile(files map[string]*zip.File, name string) ([]byte, error) {
+	file, ok := files[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is missing", name)
+	}
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func readArchiveDocument[D any](files map[string]*zip.File, name string) ([]D, error) {
+	data, err := readArchiveFile(files, name+".json")
+	if err != nil {
+		return nil, err
+	}
+	var documents []D
+	if err := json.Unmarshal(data, &documents); err != nil {
+		return nil, fmt.Errorf("%s.json: %w", name, err)
+	}
+	return documents, nil
+}
+
+// archiveContents are the documents of an archive being restored.
+type archiveContents struct {
+	manifest         model.ArchiveManifest
+	seasons          []model.ArchiveSeason
+	competitions     []model.ArchiveCompetition
+	competitionTeams []model.ArchiveCompetitionTeam
+	venues           []model.ArchiveVenue
+	teams            []model.ArchiveTeam
+	players          []model.ArchivePlayer
+	matches          []model.ArchiveMatch
+	goals            []model.ArchiveGoal
+	files            map[string]*zip.File
+}
+
+func readArchive(data []byte) (*archiveContents, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	contents := &archiveContents{files: map[string]*zip.File{}}
+	for _, file := range zr.File {
+		contents.files[file.Name] = file
+	}
+
+	manifest, err := readArchiveFile(contents.files, archiveManifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(manifest, &contents.manifest); err != nil {
+		return nil, fmt.Errorf("%s: %w", archiveManifest, err)
+	}
+	if contents.manifest.Version != model.ArchiveVersion {
+		return contents, nil
+	}
+
+	if contents.seasons, err = readArchiveDocument[model.ArchiveSeason](contents.files, archiveSeasons); err != nil {
+		return nil, err
+	}
+	if contents.competitions, err = readArchiveDocument[model.ArchiveCompetition](contents.files, archiveCompetitions); err != nil {
+		return nil, err
+	}
+	if contents.competitionTeams, err = readArchiveDocument[model.ArchiveCompetitionTeam](contents.files, archiveCompetitionTeams); err != nil {
+		return nil, err
+	}
+	if contents.venues, err = readArchiveDocument[model.ArchiveVenue](contents.files, archiveVenues); err != nil {
+		return nil, err
+	}
+	if contents.teams, err = readArchiveDocument[model.ArchiveTeam](contents.files, archiveTeams); err != nil {
+		return nil, err
+	}
+	if contents.players, err = readArchiveDocument[model.ArchivePlayer](contents.files, archivePlayers); err != nil {
+		return nil, err
+	}
+	if contents.matches, err = readArchiveDocument[model.ArchiveMatch](contents.files, archiveMatches); err != nil {
+		return nil, err
+	}
+	if contents.goals, err = readArchiveDocument[model.ArchiveGoal](contents.files, archiveGoals); err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+// remapID returns the new ID of an optional reference, or nil when the row it
+// refers to was not restored.
+func remapID(ids map[string]string, id *string) *string {
+	if id == nil {
+		return nil
+	}
+	if newID, ok := ids[*id]; ok {
+		return &newID
+	}
+	return nil
+}
+
+// Restore loads an archive into a database holding no league data, giving
+// every row a new ID. Rows whose required references are missing from the
+// archive are skipped; optional references that are missing are cleared.
+// Logos are written under uploads/logos and removed again if the restore
+// fails.
+func (a *archiveUseCaseImpl) Restore(ctx context.Context, request *model.ArchiveRestoreRequest) (*model.ArchiveRestoreResponse, error) {
+	tx := a.DB.WithContext(ctx).Begin()
+	var logoDirs []string
+	removeLogos := func() {
+		for _, dir := range logoDirs {
+			if err := os.RemoveAll(dir); err != nil {
+				a.Log.Errorf("Failed to remove restored logos in %s: %v", dir, err)
+			}
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			removeLogos()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		a.Log.Warnf("Invalid request body : %+v", err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	contents, err := readArchive(request.File)
+	if err != nil {
+		a.Log.Warnf("Invalid archive file: %v", err)
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Invalid archive file").WithDetail("file", err.Error())
+	}
+	if contents.manifest.Version != model.ArchiveVersion {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Unsupported archive version").
+			WithDetail("version", fmt.Sprintf("archive version %d, expected %d", contents.manifest.Version, model.ArchiveVersion))
+	}
+
+	rows, err := a.ArchiveRepo.CountLeagueRows(tx)
+	if err != nil {
+		a.Log.Errorf("Failed to count existing rows: %v", err)
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check database")
+	}
+	if rows > 0 {
+		tx.Rollback()
+		return nil, common.ErrConflict("Database already holds league data").
+			WithDetail("rows", fmt.Sprintf("%d rows found; archives restore into an empty database", rows))
+	}
+
+	response := &model.ArchiveRestoreResponse{
+		Version:  contents.manifest.Version,
+		Season:   contents.manifest.Season,
+		Restored: map[string]int{},
+		Skipped:  map[string]int{},
+	}
+	fail := func(document string, err error) (*model.ArchiveRestoreResponse, error) {
+		a.Log.Errorf("Failed to restore %s: %v", document, err)
+		tx.Rollback()
+		removeLogos()
+		return nil, common.ErrInternalServer(fmt.Sprintf("Failed to restore %s", document))
+	}
+
+	seasonIDs := map[string]string{}
+	for i := range contents.seasons {
+		season := converter.ToSeasonFromArchive(&contents.seasons[i])
+		if err := a.SeasonsRepo.Create(tx, season); err != nil {
+			return fail(archiveSeasons, err)
+		}
+		seasonIDs[contents.seasons[i].ID] = season.ID
+		response.Restored[archiveSeasons]++
+	}
+
+	competitionIDs := map[string]string{}
+	for i := range contents.competitions {
+		competition := converter.ToCompetitionFromArchive(&contents.competitions[i])
+		competition.SeasonID = remapID(seasonIDs, competition.SeasonID)
+		if err := a.CompetitionsRepo.Create(tx, competition); err != nil {
+			return fail(archiveCompetitions, err)
+		}
+		competitionIDs[contents.competitions[i].ID] = competition.ID
+		response.Restored[archiveCompetitions]++
+	}
+
+	venueIDs := map[string]string{}
+	for i := range contents.venues {
+		venue := converter.ToVenueFromArchive(&contents.venues[i])
+		if err := a.VenuesRepo.Create(tx, venue); err != nil {
+			return fail(archiveVenues, err)
+		}
+		venueIDs[contents.venues[i].ID] = venue.ID
+		response.Restored[archiveVenues]++
+	}
+
+	teamIDs := map[string]string{}
+	for i := range contents.teams {
+		team := converter.ToTeamFromArchive(&contents.teams[i])
+		// the logo directory is named after the team, so its ID is chosen here
+		team.ID = uuid.New().String()
+		team.HomeVenueID = remapID(venueIDs, team.HomeVenueID)
+		if strings.HasPrefix(team.Logo, "logos/") {
+			// the file is absent when the logo was missing at export
+			file, ok := contents.files[team.Logo]
+			team.Logo = ""
+			if ok {
+				dir := fmt.Sprintf("%s/%s", logoUploadDir, team.ID)
+				logoDirs = append(logoDirs, dir)
+				logo, err := restoreLogo(file, dir)
+				if err != nil {
+					return fail(archiveTeams, err)
+				}
+				team.Logo = logo
+				response.Logos++
+			}
+		}
+		if err := a.TeamsRepo.Create(tx, team); err != nil {
+			return fail(archiveTeams, err)
+		}
+		teamIDs[contents.teams[i].ID] = team.ID
+		response.Restored[archiveTeams]++
+	}
+
+	for _, registration := range contents.competitionTeams {
+		competitionID, ok := competitionIDs[registration.CompetitionID]
+		teamID, found := teamIDs[registration.TeamID]
+		if !ok || !found {
+			response.Skipped[archiveCompetitionTeams]++
+			continue
+		}
+		if err := a.CompetitionsRepo.RegisterTeam(tx, competitionID, teamID); err != nil {
+			return fail(archiveCompetitionTeams, err)
+		}
+		response.Restored[archiveCompetitionTeams]++
+	}
+
+	playerIDs := map[string]string{}
+	for i := range contents.players {
+		player := converter.ToPlayerFromArchive(&contents.players[i])
+		teamID, ok := teamIDs[player.TeamID]
+		if !ok {
+			response.Skipped[archivePlayers]++
+			continue
+		}
+		player.TeamID = teamID
+		if err := a.PlayersRepo.Create(tx, player); err != nil {
+			return fail(archivePlayers, err)
+		}
+		playerIDs[contents.players[i].ID] = player.ID
+		response.Restored[archivePlayers]++
+	}
+
+	matchIDs := map[string]string{}
+	for i := range contents.matches {
+		match := converter.ToMatchFromArchive(&contents.matches[i])
+		homeTeamID, ok := teamIDs[match.HomeTeamID]
+		awayTeamID, found := teamIDs[match.AwayTeamID]
+		if !ok || !found {
+			response.Skipped[archiveMatches]++
+			continue
+		}
+		match.HomeTeamID, match.AwayTeamID = homeTeamID, awayTeamID
+		match.CompetitionID = remapID(competitionIDs, match.CompetitionID)
+		match.VenueID = remapID(venueIDs, match.VenueID)
+		if err := a.MatchesRepo.Create(tx, match); err != nil {
+			return fail(archiveMatches, err)
+		}
+		matchIDs[contents.matches[i].ID] = match.ID
+		response.Restored[archiveMatches]++
+	}
+
+	for i := range contents.goals {
+		goal := converter.ToGoalFromArchive(&contents.goals[i])
+		matchID, ok := matchIDs[goal.MatchID]
+		playerID, found := playerIDs[goal.PlayerID]
+		if !ok || !found {
+			response.Skipped[archiveGoals]++
+			continue
+		}
+		goal.MatchID, goal.PlayerID = matchID, playerID
+		goal.AssistPlayerID = remapID(playerIDs, goal.AssistPlayerID)
+		if err := a.GoalsRepo.Create(tx, goal); err != nil {
+			return fail(archiveGoals, err)
+		}
+		response.Restored[archiveGoals]++
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		a.Log.Errorf("Failed to commit transaction: %v", err)
+		removeLogos()
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Restored archive with %d teams and %d matches", response.Restored[archiveTeams], response.Restored[archiveMatches]),
+		Service: "archive",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	a.Log.Infof("Sending log event: %+v", logEvent)
+	if err := a.LogsProducer.Send(logEvent); err != nil {
+		a.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return response, nil
+}
+
+// restoreLogo writes an archived logo into a team's logo directory and returns
+// its path.
+func restoreLogo(file *zip.File, dir string) (string, error) {
+	name := path.Base(file.Name)
+	if name == "." || name == ".." || name == "/" {
+		return "", fmt.Errorf("invalid logo name %q", file.Name)
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", err
+	}
+	logo := filepath.Join(dir, name)
+
+	r, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	w, err := os.Create(logo)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return "", err
+	}
+	return logo, w.Close()
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"gorm.io/gorm"
+)
+
+// checkPlayerAvailability collects the injuries and suspensions that keep a player
+// out of a match on the given date, with a readable reason for each of them.
+func checkPlayerAvailability(tx *gorm.DB, injuriesRepo repository.InjuriesRepository, suspensionsRepo repository.SuspensionsRepository, playerID string, date time.Time, competitionID *string) ([]string, []entity.PlayerInjury, []entity.PlayerSuspension, error) {
+	injuries, err := injuriesRepo.FindActiveByPlayerID(tx, playerID, date)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	suspensions, err := suspensionsRepo.FindActiveByPlayerID(tx, playerID, competitionID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var reasons []string
+	for _, injury := range injuries {
+		if injury.ExpectedReturn != nil {
+			reasons = append(reasons, fmt.Sprintf("Injured (%s) until %s", injury.InjuryType, injury.ExpectedReturn.Format("2006-01-02")))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("Injured (%s) with no expected return date", injury.InjuryType))
+		}
+	}
+	for _, suspension := range suspensions {
+		reasons = append(reasons, fmt.Sprintf("Suspended for %d more match(es): %s", suspension.MatchesRemaining, suspension.Reason))
+	}
+
+	return reasons, injuries, suspensions, nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CalendarUseCase interface {
+	TeamFixtures(ctx context.Context, request *model.CalendarRequestTeamFixtures) (string, error)
+	SeasonFixtures(ctx context.Context, request *model.CalendarRequestSeasonFixtures) (string, error)
+}
+
+type calendarUseCaseImpl struct {
+	MatchesRepo repository.MatchesRepository
+	TeamsRepo   repository.TeamsRepository
+	SeasonsRepo repository.SeasonsRepository
+	DB          *gorm.DB
+	Log         *logrus.Logger
+}
+
+func NewCalendarUseCase(matchesRepo repository.MatchesRepository, teamsRepo repository.TeamsRepository, seasonsRepo repository.SeasonsRepository, db *gorm.DB, log *logrus.Logger) CalendarUseCase {
+	return &calendarUseCaseImpl{
+		MatchesRepo: matchesRepo,
+		TeamsRepo:   teamsRepo,
+		SeasonsRepo: seasonsRepo,
+		DB:          db,
+		Log:         log,
+	}
+}
+
+func (c *calendarUseCaseImpl) TeamFixtures(ctx context.Context, request *model.CalendarRequestTeamFixtures) (string, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body: %+v", err)
+		return "", err
+	}
+
+	team, err := c.TeamsRepo.FindByID(tx, request.TeamID)
+	if err != nil {
+		c.Log.Errorf("Failed to find team by ID %s: %v", request.TeamID, err)
+		tx.Rollback()
+		return "", common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	matches, err := c.MatchesRepo.FindFixturesByTeamID(tx, team.ID)
+	if err != nil {
+		tx.Rollback()
+		return "", common.ErrInternalServer("Failed to find team fixtures")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return "", common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToFixturesCalendar(fmt.Sprintf("%s fixtures", team.Name), matches), nil
+}
+
+func (c *calendarUseCaseImpl) SeasonFixtures(ctx context.Context, request *model.CalendarRequestSeasonFixtures) (string, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body: %+v", err)
+		return "", err
+	}
+
+	season, err := c.SeasonsRepo.FindByID(tx, request.SeasonID)
+	if err != nil {
+		c.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+		tx.Rollback()
+		return "", common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+	}
+
+	matches, err := c.MatchesRepo.FindFixturesBySeasonID(tx, season.ID)
+	if err != nil {
+		tx.Rollback()
+		return "", common.ErrInternalServer("Failed to find season fixtures")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return "", common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToFixturesCalendar(fmt.Sprintf("%s fixtures", season.Name), matches), nil
+}
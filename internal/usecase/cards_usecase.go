@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CardsUseCase interface {
+	FindByID(ctx context.Context, request *model.CardRequestFindByID) (*model.CardResponse, error)
+	FindByMatchID(ctx context.Context, request *model.CardRequestFindByMatchID) ([]model.CardResponse, error)
+	Create(ctx context.Context, request *model.CardRequestCreate) (*model.CardResponse, error)
+	SoftDelete(ctx context.Context, request *model.CardRequestSoftDelete) (*model.CardResponse, error)
+}
+
+type cardsUseCaseImpl struct {
+	CardsRepo        repository.CardsRepository
+	MatchesRepo      repository.MatchesRepository
+	PlayersRepo      repository.PlayersRepository
+	LeaderboardsRepo repository.LeaderboardsRepository
+	Leaderboards     *leaderboardCache
+	LogsProducer     *messaging.LogProducer
+	DB               *gorm.DB
+	Log              *logrus.Logger
+}
+
+func NewCardsUseCase(cardsRepo repository.CardsRepository, matchesRepo repository.MatchesRepository, playersRepo repository.PlayersRepository, leaderboardsRepo repository.LeaderboardsRepository, redisClient *redis.Client, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) CardsUseCase {
+	return &cardsUseCaseImpl{
+		CardsRepo:        cardsRepo,
+		MatchesRepo:      matchesRepo,
+		PlayersRepo:      playersRepo,
+		LeaderboardsRepo: leaderboardsRepo,
+		Leaderboards:     newLeaderboardCache(redisClient, log),
+		LogsProducer:     logsProducer,
+		DB:               db,
+		Log:              log,
+	}
+}
+
+func cardLeaderboard(cardType string) string {
+	if cardType == "red" {
+		return repository.LeaderboardRedCards
+	}
+	return repository.LeaderboardYellowCards
+}
+
+func (c *cardsUseCaseImpl) FindByID(ctx context.Context, request *model.CardRequestFindByID) (*model.CardResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	card, err := c.CardsRepo.FindByIDWithRelations(tx, request.ID, "Player")
+	if err != nil {
+		c.Log.Errorf("Failed to find card by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Card not found").WithDetail("id", request.ID)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToCardResponse(card), nil
+}
+
+func (c *cardsUseCaseImpl) FindByMatchID(ctx context.Context, request *model.CardRequestFindByMatchID) ([]model.CardResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := c.MatchesRepo.FindByID(tx, request.MatchID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.MatchID)
+	}
+
+	cards, err := c.CardsRepo.FindByMatchID(tx, request.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find cards")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.CardResponse{}
+	for _, card := range cards {
+		responses = append(responses, *converter.ToCardResponse(&card))
+	}
+
+	return responses, nil
+}
+
+func (c *cardsUseCaseImpl) Create(ctx context.Context, request *model.CardRequestCreate) (*model.CardResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	match, err := c.MatchesRepo.FindByID(tx, request.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.MatchID)
+	}
+
+	player, err := c.PlayersRepo.FindByID(tx, request.PlayerID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Player not found").WithDetail("id", request.PlayerID)
+	}
+
+	if player.TeamID != match.HomeTeamID && player.TeamID != match.AwayTeamID {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Player does not belong to either team in the match").WithDetail("player_id", request.PlayerID)
+	}
+
+	card := &entity.MatchCard{
+		ID:       uuid.New().String(),
+		MatchID:  match.ID,
+		PlayerID: player.ID,
+		CardType: request.CardType,
+		Minute:   request.Minute,
+	}
+
+	if err := c.CardsRepo.Create(tx, card); err != nil {
+		tx.Rollback()
+		c.Log.Errorf("Failed to create card: %v", err)
+		return nil, common.ErrInternalServer("Failed to create card")
+	}
+
+	seasonID, err := c.LeaderboardsRepo.FindMatchSeasonID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	c.Leaderboards.Incr(ctx, seasonID, cardLeaderboard(card.CardType), card.PlayerID, 1)
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("%s card recorded for player %s in match %s", card.CardType, card.PlayerID, card.MatchID),
+		Service: "cards",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	c.Log.Infof("Sending log event: %+v", logEvent)
+	if err := c.LogsProducer.Send(logEvent); err != nil {
+		c.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToCardResponse(card), nil
+}
+
+func (c *cardsUseCaseImpl) SoftDelete(ctx context.Context, request *model.CardRequestSoftDelete) (*model.CardResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	card, err := c.CardsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		c.Log.Errorf("Failed to find card by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Card not found").WithDetail("id", request.ID)
+	}
+
+	if err := c.CardsRepo.SoftDelete(tx, card.ID); err != nil {
+		tx.Rollback()
+		c.Log.Errorf("Failed to soft delete card: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete card")
+	}
+
+	seasonID, err := c.LeaderboardsRepo.FindMatchSeasonID(tx, card.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	c.Leaderboards.Incr(ctx, seasonID, cardLeaderboard(card.CardType), card.PlayerID, -1)
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Card with ID %s soft deleted successfully", card.ID),
+		Service: "cards",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	c.Log.Infof("Sending log event: %+v", logEvent)
+	if err := c.LogsProducer.Send(logEvent); err != nil {
+		c.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToCardResponse(card), nil
+}
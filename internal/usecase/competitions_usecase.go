@@ -0,0 +1,516 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CompetitionsUseCase interface {
+	FindAll(ctx context.Context) ([]model.CompetitionResponse, error)
+	FindByID(ctx context.Context, request *model.CompetitionRequestFindByID) (*model.CompetitionResponse, error)
+	Create(ctx context.Context, request *model.CompetitionRequestCreate) (*model.CompetitionResponse, error)
+	Update(ctx context.Context, request *model.CompetitionRequestUpdate) (*model.CompetitionResponse, error)
+	SoftDelete(ctx context.Context, request *model.CompetitionRequestSoftDelete) (*model.CompetitionResponse, error)
+	RegisterTeam(ctx context.Context, request *model.CompetitionRequestRegisterTeam) (*model.CompetitionResponse, error)
+	FindIneligiblePlayers(ctx context.Context, request *model.CompetitionRequestFindByID) ([]model.IneligiblePlayerResponse, error)
+	GetStandings(ctx context.Context, request *model.CompetitionRequestFindByID) ([]model.StandingResponse, error)
+}
+
+type competitionsUseCaseImpl struct {
+	CompetitionsRepo      repository.CompetitionsRepository
+	TeamsRepo             repository.TeamsRepository
+	MatchesRepo           repository.MatchesRepository
+	CardsRepo             repository.CardsRepository
+	DisciplinaryRulesRepo repository.DisciplinaryRulesRepository
+	SeasonsRepo           repository.SeasonsRepository
+	LogsProducer          *messaging.LogProducer
+	DB                    *gorm.DB
+	Log                   *logrus.Logger
+}
+
+func NewCompetitionsUseCase(competitionsRepo repository.CompetitionsRepository, teamsRepo repository.TeamsRepository, matchesRepo repository.MatchesRepository, cardsRepo repository.CardsRepository, disciplinaryRulesRepo repository.DisciplinaryRulesRepository, seasonsRepo repository.SeasonsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) CompetitionsUseCase {
+	return &competitionsUseCaseImpl{
+		CompetitionsRepo:      competitionsRepo,
+		TeamsRepo:             teamsRepo,
+		MatchesRepo:           matchesRepo,
+		CardsRepo:             cardsRepo,
+		DisciplinaryRulesRepo: disciplinaryRulesRepo,
+		SeasonsRepo:           seasonsRepo,
+		LogsProducer:          logsProducer,
+		DB:                    db,
+		Log:                   log,
+	}
+}
+
+// checkPlayerEligibility reports whether a player satisfies the competition's
+// age-category rule. Competitions without a rule accept every player.
+func checkPlayerEligibility(competition *entity.Competition, player *entity.Player) (bool, string) {
+	if competition == nil || competition.EligibleBornOnOrAfter == nil {
+		return true, ""
+	}
+	if player.DateOfBirth == nil {
+		return false, "Date of birth is not recorded"
+	}
+	if player.DateOfBirth.Before(*competition.EligibleBornOnOrAfter) {
+		return false, fmt.Sprintf("Born before %s", competition.EligibleBornOnOrAfter.Format("2006-01-02"))
+	}
+	return true, ""
+}
+
+func (c *competitionsUseCaseImpl) FindAll(ctx context.Context) ([]model.CompetitionResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	competitions, err := c.CompetitionsRepo.FindAll(tx)
+	if err != nil {
+		c.Log.Errorf("Failed to find all competitions: %v", err)
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find competitions")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	var responses []model.CompetitionResponse
+	for _, competition := range competitions {
+		responses = append(responses, *converter.ToCompetitionResponse(&competition))
+	}
+
+	return responses, nil
+}
+
+func (c *competitionsUseCaseImpl) FindByID(ctx context.Context, request *model.CompetitionRequestFindByID) (*model.CompetitionResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	competition, err := c.CompetitionsRepo.FindByIDWithRelations(tx, request.ID, "Teams")
+	if err != nil {
+		c.Log.Errorf("Failed to find competition by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.ID)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToCompetitionResponse(competition), nil
+}
+
+func (c *competitionsUseCaseImpl) Create(ctx context.Context, request *model.CompetitionRequestCreate) (*model.CompetitionResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	competition := &entity.Competition{
+		ID:                    uuid.New().String(),
+		Name:                  request.Name,
+		AgeCategory:           request.AgeCategory,
+		EligibleBornOnOrAfter: common.ConvertStringToDatePointer(request.EligibleBornOnOrAfter),
+	}
+
+	if request.SeasonID != "" {
+		if _, err := c.SeasonsRepo.FindByID(tx, request.SeasonID); err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Season not found").WithDetail("season_id", request.SeasonID)
+		}
+		competition.SeasonID = &request.SeasonID
+	}
+
+	if err := c.CompetitionsRepo.Create(tx, competition); err != nil {
+		tx.Rollback()
+		c.Log.Errorf("Failed to create competition: %v", err)
+		return nil, common.ErrInternalServer("Failed to create competition")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Competition with ID %s created successfully", competition.ID),
+		Service: "competitions",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	c.Log.Infof("Sending log event: %+v", logEvent)
+	if err := c.LogsProducer.Send(logEvent); err != nil {
+		c.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToCompetitionResponse(competition), nil
+}
+
+func (c *competitionsUseCaseImpl) Update(ctx context.Context, request *model.CompetitionRequestUpdate) (*model.CompetitionResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	competition, err := c.CompetitionsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		c.Log.Errorf("Failed to find competition by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.ID)
+	}
+
+	if request.Name != "" {
+		competition.Name = request.Name
+	}
+	if request.AgeCategory != "" {
+		competition.AgeCategory = request.AgeCategory
+	}
+	if request.EligibleBornOnOrAfter != "" {
+		competition.EligibleBornOnOrAfter = common.ConvertStringToDatePointer(request.EligibleBornOnOrAfter)
+	}
+	if request.SeasonID != "" {
+		if _, err := c.SeasonsRepo.FindByID(tx, request.SeasonID); err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Season not found").WithDetail("season_id", request.SeasonID)
+		}
+		competition.SeasonID = &request.SeasonID
+	}
+
+	if err := c.CompetitionsRepo.Update(tx, competition); err != nil {
+		tx.Rollback()
+		c.Log.Errorf("Failed to update competition: %v", err)
+		return nil, common.ErrInternalServer("Failed to update competition")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Competition with ID %s updated successfully", competition.ID),
+		Service: "competitions",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	c.Log.Infof("Sending log event: %+v", logEvent)
+	if err := c.LogsProducer.Send(logEvent); err != nil {
+		c.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToCompetitionResponse(competition), nil
+}
+
+func (c *competitionsUseCaseImpl) SoftDelete(ctx context.Context, request *model.CompetitionRequestSoftDelete) (*model.CompetitionResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	competition, err := c.CompetitionsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		c.Log.Errorf("Failed to find competition by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.ID)
+	}
+
+	if err := c.CompetitionsRepo.SoftDelete(tx, competition.ID); err != nil {
+		tx.Rollback()
+		c.Log.Errorf("Failed to soft delete competition: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete competition")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Competition with ID %s soft deleted successfully", competition.ID),
+		Service: "competitions",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	c.Log.Infof("Sending log event: %+v", logEvent)
+	if err := c.LogsProducer.Send(logEvent); err != nil {
+		c.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToCompetitionResponse(competition), nil
+}
+
+func (c *competitionsUseCaseImpl) RegisterTeam(ctx context.Context, request *model.CompetitionRequestRegisterTeam) (*model.CompetitionResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	competition, err := c.CompetitionsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		c.Log.Errorf("Failed to find competition by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.ID)
+	}
+
+	exists, err := c.TeamsRepo.CheckTeamExistsByTeamID(tx, request.TeamID)
+	if err != nil {
+		tx.Rollback()
+		c.Log.Errorf("Failed to check team existence: %v", err)
+		return nil, common.ErrInternalServer("Failed to check team existence")
+	}
+	if !exists {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("team_id", request.TeamID)
+	}
+
+	registered, err := c.CompetitionsRepo.CheckTeamRegistered(tx, competition.ID, request.TeamID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check team registration")
+	}
+	if registered {
+		tx.Rollback()
+		return nil, common.ErrConflict("Team is already registered in this competition").WithDetail("team_id", request.TeamID)
+	}
+
+	if err := c.CompetitionsRepo.RegisterTeam(tx, competition.ID, request.TeamID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to register team")
+	}
+
+	competition, err = c.CompetitionsRepo.FindByIDWithRelations(tx, competition.ID, "Teams")
+	if err != nil {
+		tx.Rollback()
+		c.Log.Errorf("Failed to reload competition %s: %v", request.ID, err)
+		return nil, common.ErrInternalServer("Failed to reload competition")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Team %s registered in competition %s", request.TeamID, competition.ID),
+		Service: "competitions",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	c.Log.Infof("Sending log event: %+v", logEvent)
+	if err := c.LogsProducer.Send(logEvent); err != nil {
+		c.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToCompetitionResponse(competition), nil
+}
+
+func (c *competitionsUseCaseImpl) FindIneligiblePlayers(ctx context.Context, request *model.CompetitionRequestFindByID) ([]model.IneligiblePlayerResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	competition, err := c.CompetitionsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		c.Log.Errorf("Failed to find competition by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.ID)
+	}
+
+	// without an eligibility rule every registered player is eligible
+	responses := []model.IneligiblePlayerResponse{}
+	if competition.EligibleBornOnOrAfter == nil {
+		tx.Rollback()
+		return responses, nil
+	}
+
+	players, err := c.CompetitionsRepo.FindIneligiblePlayers(tx, competition.ID, *competition.EligibleBornOnOrAfter)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find ineligible players")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	for _, player := range players {
+		_, reason := checkPlayerEligibility(competition, &player)
+		responses = append(responses, *converter.ToIneligiblePlayerResponse(&player, reason))
+	}
+
+	return responses, nil
+}
+
+// GetStandings builds the league table from the competition's completed matches.
+// Teams are ordered by points, goal difference and goals scored, with the
+// fair-play table deciding any remaining tie.
+func (c *competitionsUseCaseImpl) GetStandings(ctx context.Context, request *model.CompetitionRequestFindByID) ([]model.StandingResponse, error) {
+	tx := c.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		c.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	competition, err := c.CompetitionsRepo.FindByIDWithRelations(tx, request.ID, "Teams")
+	if err != nil {
+		c.Log.Errorf("Failed to find competition by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.ID)
+	}
+
+	matches, err := c.MatchesRepo.FindCompletedByCompetitionID(tx, competition.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find competition matches")
+	}
+
+	rule, err := findDisciplinaryRule(tx, c.DisciplinaryRulesRepo, &competition.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find disciplinary rules")
+	}
+
+	fairPlay, err := buildFairPlayTable(tx, c.CardsRepo, competition, rule)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to build fair-play table")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		c.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	rows := map[string]*model.StandingResponse{}
+	for _, team := range competition.Teams {
+		rows[team.ID] = &model.StandingResponse{TeamID: team.ID, TeamName: team.Name}
+	}
+	for _, entry := range fairPlay {
+		rows[entry.TeamID].FairPlayPoints = entry.Points
+	}
+
+	for _, match := range matches {
+		home, away := rows[match.HomeTeamID], rows[match.AwayTeamID]
+		if home == nil || away == nil {
+			continue
+		}
+		recordResult(home, *match.HomeScore, *match.AwayScore)
+		recordResult(away, *match.AwayScore, *match.HomeScore)
+	}
+
+	standings := []model.StandingResponse{}
+	for _, row := range rows {
+		standings = append(standings, *row)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.GoalDifference != b.GoalDifference {
+			return a.GoalDifference > b.GoalDifference
+		}
+		if a.GoalsFor != b.GoalsFor {
+			return a.GoalsFor > b.GoalsFor
+		}
+		if a.FairPlayPoints != b.FairPlayPoints {
+			return a.FairPlayPoints < b.FairPlayPoints
+		}
+		return a.TeamName < b.TeamName
+	})
+	for i := range standings {
+		standings[i].Position = i + 1
+	}
+
+	return standings, nil
+}
+
+// recordResult adds one result to a team's row, with three points for a win.
+func recordResult(row *model.StandingResponse, scored, conceded int) {
+	row.Played++
+	row.GoalsFor += scored
+	row.GoalsAgainst += conceded
+	row.GoalDifference = row.GoalsFor - row.GoalsAgainst
+	switch {
+	case scored > conceded:
+		row.Won++
+		row.Points += 3
+	case scored == conceded:
+		row.Drawn++
+		row.Points++
+	default:
+		row.Lost++
+	}
+}
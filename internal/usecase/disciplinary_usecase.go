@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type DisciplinaryUseCase interface {
+	FindRules(ctx context.Context, request *model.DisciplinaryRuleRequestFindByCompetitionID) (*model.DisciplinaryRuleResponse, error)
+	UpdateRules(ctx context.Context, request *model.DisciplinaryRuleRequestUpdate) (*model.DisciplinaryRuleResponse, error)
+	GetFairPlayTable(ctx context.Context, request *model.DisciplinaryRuleRequestFindByCompetitionID) ([]model.FairPlayResponse, error)
+}
+
+type disciplinaryUseCaseImpl struct {
+	DisciplinaryRulesRepo repository.DisciplinaryRulesRepository
+	CompetitionsRepo      repository.CompetitionsRepository
+	CardsRepo             repository.CardsRepository
+	LogsProducer          *messaging.LogProducer
+	DB                    *gorm.DB
+	Log                   *logrus.Logger
+}
+
+func NewDisciplinaryUseCase(disciplinaryRulesRepo repository.DisciplinaryRulesRepository, competitionsRepo repository.CompetitionsRepository, cardsRepo repository.CardsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) DisciplinaryUseCase {
+	return &disciplinaryUseCaseImpl{
+		DisciplinaryRulesRepo: disciplinaryRulesRepo,
+		CompetitionsRepo:      competitionsRepo,
+		CardsRepo:             cardsRepo,
+		LogsProducer:          logsProducer,
+		DB:                    db,
+		Log:                   log,
+	}
+}
+
+// defaultDisciplinaryRule is applied to competitions without configured rules and
+// to matches played outside any competition.
+func defaultDisciplinaryRule(competitionID string) *entity.CompetitionDisciplinaryRule {
+	return &entity.CompetitionDisciplinaryRule{
+		CompetitionID:         competitionID,
+		YellowCardsPerBan:     5,
+		YellowAccumulationBan: 1,
+		StraightRedBan:        1,
+		SecondYellowBan:       1,
+		YellowCardPoints:      1,
+		RedCardPoints:         3,
+	}
+}
+
+// findDisciplinaryRule loads the competition's rules, falling back to the defaults.
+func findDisciplinaryRule(tx *gorm.DB, repo repository.DisciplinaryRulesRepository, competitionID *string) (*entity.CompetitionDisciplinaryRule, error) {
+	if competitionID == nil {
+		return defaultDisciplinaryRule(""), nil
+	}
+
+	rule, err := repo.FindByCompetitionID(tx, *competitionID)
+	if err == gorm.ErrRecordNotFound {
+		return defaultDisciplinaryRule(*competitionID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// yellowCardWindow returns the matchday range whose yellow cards count towards the
+// match's accumulation. Without a reset, or without a matchday, every match counts.
+func yellowCardWindow(rule *entity.CompetitionDisciplinaryRule, match *entity.Match) (afterMatchday, uptoMatchday *int) {
+	if rule.YellowResetAfterMatchday == nil || match.Matchday == nil {
+		return nil, nil
+	}
+	if *match.Matchday > *rule.YellowResetAfterMatchday {
+		return rule.YellowResetAfterMatchday, nil
+	}
+	return nil, rule.YellowResetAfterMatchday
+}
+
+// evaluateCardSuspensions applies the rules to the cards shown in a match.
+// yellowTotals holds each booked player's yellow cards in the current window,
+// including the one from this match. A player with two yellows in the match
+// was sent off: that earns the second yellow ban instead of a red card ban,
+// and neither yellow counts towards accumulation.
+func evaluateCardSuspensions(rule *entity.CompetitionDisciplinaryRule, match *entity.Match, cards []entity.MatchCard, yellowTotals map[string]int64) []*entity.PlayerSuspension {
+	var suspensions []*entity.PlayerSuspension
+
+	yellowsInMatch := map[string]int64{}
+	for _, card := range cards {
+		if card.CardType == "yellow" {
+			yellowsInMatch[card.PlayerID]++
+		}
+	}
+
+	yellowsSeen := map[string]int64{}
+	for _, card := range cards {
+		if card.CardType == "yellow" {
+			yellowsSeen[card.PlayerID]++
+			if yellowsSeen[card.PlayerID] == 2 && rule.SecondYellowBan > 0 {
+				suspensions = append(suspensions, &entity.PlayerSuspension{
+					ID:               uuid.New().String(),
+					PlayerID:         card.PlayerID,
+					CompetitionID:    match.CompetitionID,
+					MatchID:          &match.ID,
+					Reason:           "Second yellow card",
+					MatchesRemaining: rule.SecondYellowBan,
+				})
+			}
+			continue
+		}
+		// the red card shown for a second yellow is not a straight red
+		if yellowsInMatch[card.PlayerID] >= 2 {
+			continue
+		}
+		if rule.StraightRedBan > 0 {
+			suspensions = append(suspensions, &entity.PlayerSuspension{
+				ID:               uuid.New().String(),
+				PlayerID:         card.PlayerID,
+				CompetitionID:    match.CompetitionID,
+				MatchID:          &match.ID,
+				Reason:           "Red card",
+				MatchesRemaining: rule.StraightRedBan,
+			})
+		}
+	}
+
+	if rule.YellowCardsPerBan <= 0 || rule.YellowAccumulationBan <= 0 {
+		return suspensions
+	}
+
+	// iterate in a stable order so the created suspensions are deterministic
+	playerIDs := make([]string, 0, len(yellowsInMatch))
+	for playerID, yellows := range yellowsInMatch {
+		if yellows == 1 {
+			playerIDs = append(playerIDs, playerID)
+		}
+	}
+	sort.Strings(playerIDs)
+
+	perBan := int64(rule.YellowCardsPerBan)
+	for _, playerID := range playerIDs {
+		total := yellowTotals[playerID]
+		before := total - 1
+		if before/perBan < total/perBan {
+			suspensions = append(suspensions, &entity.PlayerSuspension{
+				ID:               uuid.New().String(),
+				PlayerID:         playerID,
+				CompetitionID:    match.CompetitionID,
+				MatchID:          &match.ID,
+				Reason:           fmt.Sprintf("%d yellow cards accumulated", total),
+				MatchesRemaining: rule.YellowAccumulationBan,
+			})
+		}
+	}
+
+	return suspensions
+}
+
+// buildFairPlayTable ranks the competition's registered teams by disciplinary
+// points, lowest first. Teams on equal points share a rank.
+func buildFairPlayTable(tx *gorm.DB, cardsRepo repository.CardsRepository, competition *entity.Competition, rule *entity.CompetitionDisciplinaryRule) ([]model.FairPlayResponse, error) {
+	counts, err := cardsRepo.CountByTeamAndCompetition(tx, competition.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	countsByTeam := map[string]repository.TeamCardCount{}
+	for _, count := range counts {
+		countsByTeam[count.TeamID] = count
+	}
+
+	table := []model.FairPlayResponse{}
+	for _, team := range competition.Teams {
+		count := countsByTeam[team.ID]
+		table = append(table, model.FairPlayResponse{
+			TeamID:      team.ID,
+			TeamName:    team.Name,
+			YellowCards: count.YellowCards,
+			RedCards:    count.RedCards,
+			Points:      count.YellowCards*int64(rule.YellowCardPoints) + count.RedCards*int64(rule.RedCardPoints),
+		})
+	}
+
+	sort.SliceStable(table, func(i, j int) bool {
+		if table[i].Points != table[j].Points {
+			return table[i].Points < table[j].Points
+		}
+		return table[i].TeamName < table[j].TeamName
+	})
+	for i := range table {
+		if i > 0 && table[i].Points == table[i-1].Points {
+			table[i].Rank = table[i-1].Rank
+		} else {
+			table[i].Rank = i + 1
+		}
+	}
+
+	return table, nil
+}
+
+func (d *disciplinaryUseCaseImpl) FindRules(ctx context.Context, request *model.DisciplinaryRuleRequestFindByCompetitionID) (*model.DisciplinaryRuleResponse, error) {
+	tx := d.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		d.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := d.CompetitionsRepo.FindByID(tx, request.CompetitionID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.CompetitionID)
+	}
+
+	rule, err := findDisciplinaryRule(tx, d.DisciplinaryRulesRepo, &request.CompetitionID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find disciplinary rules")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		d.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToDisciplinaryRuleResponse(rule), nil
+}
+
+func (d *disciplinaryUseCaseImpl) UpdateRules(ctx context.Context, request *model.DisciplinaryRuleRequestUpdate) (*model.DisciplinaryRuleResponse, error) {
+	tx := d.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		d.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	if _, err := d.CompetitionsRepo.FindByID(tx, request.CompetitionID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.CompetitionID)
+	}
+
+	rule, err := findDisciplinaryRule(tx, d.DisciplinaryRulesRepo, &request.CompetitionID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find disciplinary rules")
+	}
+
+	if request.YellowCardsPerBan != nil {
+		rule.YellowCardsPerBan = *request.YellowCardsPerBan
+	}
+	if request.YellowAccumulationBan != nil {
+		rule.YellowAccumulationBan = *request.YellowAccumulationBan
+	}
+	if request.StraightRedBan != nil {
+		rule.StraightRedBan = *request.StraightRedBan
+	}
+	if request.SecondYellowBan != nil {
+		rule.SecondYellowBan = *request.SecondYellowBan
+	}
+	if request.YellowResetAfterMatchday != nil {
+		if *request.YellowResetAfterMatchday == 0 {
+			rule.YellowResetAfterMatchday = nil
+		} else {
+			rule.YellowResetAfterMatchday = request.YellowResetAfterMatchday
+		}
+	}
+	if request.YellowCardPoints != nil {
+		rule.YellowCardPoints = *request.YellowCardPoints
+	}
+	if request.RedCardPoints != nil {
+		rule.RedCardPoints = *request.RedCardPoints
+	}
+
+	if err := d.DisciplinaryRulesRepo.Save(tx, rule); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to save disciplinary rules")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		d.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Disciplinary rules for competition %s updated successfully", rule.CompetitionID),
+		Service: "disciplinary",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	d.Log.Infof("Sending log event: %+v", logEvent)
+	if err := d.LogsProducer.Send(logEvent); err != nil {
+		d.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToDisciplinaryRuleResponse(rule), nil
+}
+
+func (d *disciplinaryUseCaseImpl) GetFairPlayTable(ctx context.Context, request *model.DisciplinaryRuleRequestFindByCompetitionID) ([]model.FairPlayResponse, error) {
+	tx := d.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		d.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	competition, err := d.CompetitionsRepo.FindByIDWithRelations(tx, request.CompetitionID, "Teams")
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.CompetitionID)
+	}
+
+	rule, err := findDisciplinaryRule(tx, d.DisciplinaryRulesRepo, &competition.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find disciplinary rules")
+	}
+
+	table, err := buildFairPlayTable(tx, d.CardsRepo, competition, rule)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to build fair-play table")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		d.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return table, nil
+}
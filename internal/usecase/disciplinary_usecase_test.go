@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+)
+
+func TestEvaluateCardSuspensions(t *testing.T) {
+	type suspension struct {
+		PlayerID string
+		Reason   string
+		Matches  int
+	}
+	yellow := func(playerID string, minute int16) entity.MatchCard {
+		return entity.MatchCard{PlayerID: playerID, CardType: "yellow", Minute: minute}
+	}
+	red := func(playerID string, minute int16) entity.MatchCard {
+		return entity.MatchCard{PlayerID: playerID, CardType: "red", Minute: minute}
+	}
+	rule := func(change func(*entity.CompetitionDisciplinaryRule)) *entity.CompetitionDisciplinaryRule {
+		r := defaultDisciplinaryRule("competition")
+		if change != nil {
+			change(r)
+		}
+		return r
+	}
+
+	tests := []struct {
+		name         string
+		rule         *entity.CompetitionDisciplinaryRule
+		cards        []entity.MatchCard
+		yellowTotals map[string]int64
+		want         []suspension
+	}{
+		{
+			name:  "no cards",
+			rule:  rule(nil),
+			cards: nil,
+			want:  nil,
+		},
+		{
+			name:  "straight red",
+			rule:  rule(nil),
+			cards: []entity.MatchCard{red("a", 30)},
+			want:  []suspension{{"a", "Red card", 1}},
+		},
+		{
+			name:  "straight red ban of zero",
+			rule:  rule(func(r *entity.CompetitionDisciplinaryRule) { r.StraightRedBan = 0 }),
+			cards: []entity.MatchCard{red("a", 30)},
+			want:  nil,
+		},
+		{
+			name:         "yellow below the threshold",
+			rule:         rule(nil),
+			cards:        []entity.MatchCard{yellow("a", 10)},
+			yellowTotals: map[string]int64{"a": 4},
+			want:         nil,
+		},
+		{
+			name:         "yellow reaching the threshold",
+			rule:         rule(nil),
+			cards:        []entity.MatchCard{yellow("a", 10)},
+			yellowTotals: map[string]int64{"a": 5},
+			want:         []suspension{{"a", "5 yellow cards accumulated", 1}},
+		},
+		{
+			name:         "yellow reaching the second threshold",
+			rule:         rule(func(r *entity.CompetitionDisciplinaryRule) { r.YellowCardsPerBan = 3; r.YellowAccumulationBan = 2 }),
+			cards:        []entity.MatchCard{yellow("a", 10)},
+			yellowTotals: map[string]int64{"a": 6},
+			want:         []suspension{{"a", "6 yellow cards accumulated", 2}},
+		},
+		{
+			name:         "yellow past the threshold",
+			rule:         rule(nil),
+			cards:        []entity.MatchCard{yellow("a", 10)},
+			yellowTotals: map[string]int64{"a": 6},
+			want:         nil,
+		},
+		{
+			name:         "accumulation ban of zero",
+			rule:         rule(func(r *entity.CompetitionDisciplinaryRule) { r.YellowAccumulationBan = 0 }),
+			cards:        []entity.MatchCard{yellow("a", 10)},
+			yellowTotals: map[string]int64{"a": 5},
+			want:         nil,
+		},
+		{
+			name:         "second yellow followed by a red",
+			rule:         rule(nil),
+			cards:        []entity.MatchCard{yellow("a", 10), yellow("a", 60), red("a", 60)},
+			yellowTotals: map[string]int64{"a": 4},
+			want:         []suspension{{"a", "Second yellow card", 1}},
+		},
+		{
+			name:         "second yellow recorded without a red",
+			rule:         rule(func(r *entity.CompetitionDisciplinaryRule) { r.SecondYellowBan = 2 }),
+			cards:        []entity.MatchCard{yellow("a", 10), yellow("a", 60)},
+			yellowTotals: map[string]int64{"a": 4},
+			want:         []suspension{{"a", "Second yellow card", 2}},
+		},
+		{
+			name:         "second yellow does not count towards accumulation",
+			rule:         rule(nil),
+			cards:        []entity.MatchCard{yellow("a", 10), yellow("a", 60), red("a", 60)},
+			yellowTotals: map[string]int64{"a": 5},
+			want:         []suspension{{"a", "Second yellow card", 1}},
+		},
+		{
+			name:         "second yellow ban of zero",
+			rule:         rule(func(r *entity.CompetitionDisciplinaryRule) { r.SecondYellowBan = 0 }),
+			cards:        []entity.MatchCard{yellow("a", 10), yellow("a", 60), red("a", 60)},
+			yellowTotals: map[string]int64{"a": 4},
+			want:         nil,
+		},
+		{
+			name:         "yellow then straight red",
+			rule:         rule(nil),
+			cards:        []entity.MatchCard{yellow("a", 10), red("a", 70)},
+			yellowTotals: map[string]int64{"a": 5},
+			want:         []suspension{{"a", "Red card", 1}, {"a", "5 yellow cards accumulated", 1}},
+		},
+		{
+			name:         "several players",
+			rule:         rule(nil),
+			cards:        []entity.MatchCard{yellow("c", 5), yellow("b", 20), red("d", 40), yellow("c", 75), red("c", 75), yellow("a", 80)},
+			yellowTotals: map[string]int64{"a": 10, "b": 5, "c": 1},
+			want: []suspension{
+				{"d", "Red card", 1},
+				{"c", "Second yellow card", 1},
+				{"a", "10 yellow cards accumulated", 1},
+				{"b", "5 yellow cards accumulated", 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			competitionID := "competition"
+			match := &entity.Match{ID: "match", CompetitionID: &competitionID}
+			var got []suspension
+			for _, s := range evaluateCardSuspensions(tt.rule, match, tt.cards, tt.yellowTotals) {
+				if s.MatchID == nil || *s.MatchID != match.ID || s.CompetitionID != match.CompetitionID {
+					t.Errorf("suspension of %s not tied to the match and competition", s.PlayerID)
+				}
+				got = append(got, suspension{s.PlayerID, s.Reason, s.MatchesRemaining})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("evaluateCardSuspensions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
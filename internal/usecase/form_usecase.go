@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultFormMatches = 5
+	// formRollingWindow is the number of results the rolling points per game
+	// is computed over.
+	formRollingWindow = 5
+)
+
+type FormUseCase interface {
+	Find(ctx context.Context, request *model.TeamFormRequest) (*model.TeamFormResponse, error)
+}
+
+type formUseCaseImpl struct {
+	MatchesRepo repository.MatchesRepository
+	TeamsRepo   repository.TeamsRepository
+	DB          *gorm.DB
+	Log         *logrus.Logger
+}
+
+func NewFormUseCase(matchesRepo repository.MatchesRepository, teamsRepo repository.TeamsRepository, db *gorm.DB, log *logrus.Logger) FormUseCase {
+	return &formUseCaseImpl{
+		MatchesRepo: matchesRepo,
+		TeamsRepo:   teamsRepo,
+		DB:          db,
+		Log:         log,
+	}
+}
+
+// teamResult is a completed match seen from one team.
+type teamResult struct {
+	Match        *entity.Match
+	Home         bool
+	GoalsFor     int
+	GoalsAgainst int
+}
+
+func (r teamResult) Outcome() string {
+	switch {
+	case r.GoalsFor > r.GoalsAgainst:
+		return "W"
+	case r.GoalsFor < r.GoalsAgainst:
+		return "L"
+	default:
+		return "D"
+	}
+}
+
+// Points gives three points for a win and one for a draw.
+func (r teamResult) Points() int {
+	switch r.Outcome() {
+	case "W":
+		return 3
+	case "D":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (r teamResult) SeasonID() *string {
+	if r.Match.Competition == nil {
+		return nil
+	}
+	return r.Match.Competition.SeasonID
+}
+
+func (r teamResult) SeasonName() *string {
+	if r.Match.Competition == nil || r.Match.Competition.Season == nil {
+		return nil
+	}
+	return &r.Match.Competition.Season.Name
+}
+
+func (r teamResult) FormResult(loc *time.Location) model.FormResult {
+	opponent, side := r.Match.AwayTeam, "home"
+	if !r.Home {
+		opponent, side = r.Match.HomeTeam, "away"
+	}
+	return model.FormResult{
+		MatchID:       r.Match.ID,
+		MatchDate:     converter.ToLocalMatchDate(r.Match, loc),
+		CompetitionID: r.Match.CompetitionID,
+		Opponent:      model.TeamShort{ID: opponent.ID, Name: opponent.Name},
+		Side:          side,
+		GoalsFor:      r.GoalsFor,
+		GoalsAgainst:  r.GoalsAgainst,
+		Result:        r.Outcome(),
+	}
+}
+
+// teamResults turns the team's completed matches, in kickoff order, into results.
+func teamResults(teamID string, matches []entity.Match) []teamResult {
+	results := make([]teamResult, 0, len(matches))
+	for i := range matches {
+		match := &matches[i]
+		result := teamResult{Match: match, Home: match.HomeTeamID == teamID}
+		if result.Home {
+			result.GoalsFor, result.GoalsAgainst = *match.HomeScore, *match.AwayScore
+		} else {
+			result.GoalsFor, result.GoalsAgainst = *match.AwayScore, *match.HomeScore
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// formString lists the outcomes of the last n results, most recent first. Side
+// is "home" or "away" to only count those matches, or empty for all.
+func formString(results []teamResult, n int, side string) string {
+	var b strings.Builder
+	for i := len(results) - 1; i >= 0 && b.Len() < n; i-- {
+		if (side == "home" && !results[i].Home) || (side == "away" && results[i].Home) {
+			continue
+		}
+		b.WriteString(results[i].Outcome())
+	}
+	return b.String()
+}
+
+// formStreaks walks the results in kickoff order and returns the streaks still
+// running after the last result and the longest ones reached.
+func formStreaks(results []teamResult) (current, longest model.FormStreaks) {
+	step := func(count *int, best *int, ok bool) {
+		if ok {
+			*count++
+		} else {
+			*count = 0
+		}
+		if *count > *best {
+			*best = *count
+		}
+	}
+
+	for _, result := range results {
+		outcome := result.Outcome()
+		step(&current.Winning, &longest.Winning, outcome == "W")
+		step(&current.Unbeaten, &longest.Unbeaten, outcome != "L")
+		step(&current.Winless, &longest.Winless, outcome != "W")
+		step(&current.Losing, &longest.Losing, outcome == "L")
+		step(&current.CleanSheets, &longest.CleanSheets, result.GoalsAgainst == 0)
+		step(&current.Scoring, &longest.Scoring, result.GoalsFor > 0)
+	}
+	return current, longest
+}
+
+// buildTeamForm summarises the last n results and the current streaks.
+func buildTeamForm(results []teamResult, n int) model.TeamForm {
+	current, _ := formStreaks(results)
+	return model.TeamForm{
+		Overall:        formString(results, n, ""),
+		Home:           formString(results, n, "home"),
+		Away:           formString(results, n, "away"),
+		CurrentStreaks: current,
+	}
+}
+
+// groupBySeason splits the results per season, keeping kickoff order. Results
+// outside a season are grouped together.
+func groupBySeason(results []teamResult) [][]teamResult {
+	var groups [][]teamResult
+	index := map[string]int{}
+	for _, result := range results {
+		key := ""
+		if seasonID := result.SeasonID(); seasonID != nil {
+			key = *seasonID
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], result)
+	}
+	return groups
+}
+
+func pointsPerGame(results []teamResult) float64 {
+	if len(results) == 0 {
+		return 0
+	}
+	points := 0
+	for _, result := range results {
+		points += result.Points()
+	}
+	return math.Round(float64(points)/float64(len(results))*100) / 100
+}
+
+// pointsPerGameTrend compares the points per game overall, over the last n
+// results and per season, with a rolling figure for each of the last n results.
+func pointsPerGameTrend(results []teamResult, n int, loc *time.Location) model.PointsPerGameTrend {
+	trend := model.PointsPerGameTrend{
+		Overall: pointsPerGame(results),
+		Recent:  pointsPerGame(results[max(len(results)-n, 0):]),
+		Seasons: []model.SeasonPointsPerGame{},
+		Rolling: []model.RollingPointsPerGame{},
+	}
+
+	for _, season := range groupBySeason(results) {
+		points := 0
+		for _, result := range season {
+			points += result.Points()
+		}
+		trend.Seasons = append(trend.Seasons, model.SeasonPointsPerGame{
+			SeasonID:      season[0].SeasonID(),
+			SeasonName:    season[0].SeasonName(),
+			Played:        len(season),
+			Points:        points,
+			PointsPerGame: pointsPerGame(season),
+		})
+	}
+
+	for i := max(len(results)-n, 0); i < len(results); i++ {
+		match := results[i].Match
+		trend.Rolling = append(trend.Rolling, model.RollingPointsPerGame{
+			MatchID:       match.ID,
+			MatchDate:     converter.ToLocalMatchDate(match, loc),
+			PointsPerGame: pointsPerGame(results[max(i+1-formRollingWindow, 0) : i+1]),
+		})
+	}
+
+	return trend
+}
+
+func (f *formUseCaseImpl) Find(ctx context.Context, request *model.TeamFormRequest) (*model.TeamFormResponse, error) {
+	tx := f.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		f.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	team, err := f.TeamsRepo.FindByID(tx, request.TeamID)
+	if err != nil {
+		f.Log.Errorf("Failed to find team by ID %s: %v", request.TeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	matches, err := f.MatchesRepo.FindCompletedByTeamID(tx, team.ID, nil)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find team results")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		f.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	last := request.Last
+	if last == 0 {
+		last = defaultFormMatches
+	}
+
+	loc := common.LocationFromContext(ctx)
+	results := teamResults(team.ID, matches)
+
+	recent := []model.FormResult{}
+	for i := len(results) - 1; i >= 0 && len(recent) < last; i-- {
+		recent = append(recent, results[i].FormResult(loc))
+	}
+
+	longest := []model.SeasonFormStreaks{}
+	for _, season := range groupBySeason(results) {
+		_, streaks := formStreaks(season)
+		longest = append(longest, model.SeasonFormStreaks{
+			SeasonID:   season[0].SeasonID(),
+			SeasonName: season[0].SeasonName(),
+			Streaks:    streaks,
+		})
+	}
+
+	return &model.TeamFormResponse{
+		Team:           model.TeamShort{ID: team.ID, Name: team.Name},
+		Form:           buildTeamForm(results, last),
+		Results:        recent,
+		LongestStreaks: longest,
+		PointsPerGame:  pointsPerGameTrend(results, last, loc),
+	}, nil
+}
@@ -12,12 +12,14 @@ import (
 	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
 	"github.com/Fadlihardiyanto/football-api/internal/repository"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 type GoalsUseCase interface {
 	FindAll(ctx context.Context) ([]model.GoalResponse, error)
+	Export(ctx context.Context, w common.ExportWriter) error
 	FindByID(ctx context.Context, request *model.GoalRequestFindByID) (*model.GoalResponse, error)
 	Create(ctx context.Context, request *model.GoalRequestCreate) (*model.GoalResponse, error)
 	Update(ctx context.Context, request *model.GoalRequestUpdate) (*model.GoalResponse, error)
@@ -25,22 +27,95 @@ type GoalsUseCase interface {
 }
 
 type goalsUseCaseImpl struct {
-	GoalsRepo    repository.GoalsRepository
-	MatchesRepo  repository.MatchesRepository
-	PlayersRepo  repository.PlayersRepository
-	LogsProducer *messaging.LogProducer
-	DB           *gorm.DB
-	Log          *logrus.Logger
+	GoalsRepo           repository.GoalsRepository
+	MatchesRepo         repository.MatchesRepository
+	PlayersRepo         repository.PlayersRepository
+	CompetitionsRepo    repository.CompetitionsRepository
+	LeaderboardsRepo    repository.LeaderboardsRepository
+	RecordsRepo         repository.RecordsRepository
+	Leaderboards        *leaderboardCache
+	LogsProducer        *messaging.LogProducer
+	RecordEventProducer *messaging.RecordEventProducer
+	DB                  *gorm.DB
+	Log                 *logrus.Logger
 }
 
-func NewGoalsUseCase(goalsRepo repository.GoalsRepository, matchesRepo repository.MatchesRepository, playersRepo repository.PlayersRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) GoalsUseCase {
+func NewGoalsUseCase(goalsRepo repository.GoalsRepository, matchesRepo repository.MatchesRepository, playersRepo repository.PlayersRepository, competitionsRepo repository.CompetitionsRepository, leaderboardsRepo repository.LeaderboardsRepository, recordsRepo repository.RecordsRepository, redisClient *redis.Client, logsProducer *messaging.LogProducer, recordEventProducer *messaging.RecordEventProducer, db *gorm.DB, log *logrus.Logger) GoalsUseCase {
 	return &goalsUseCaseImpl{
-		GoalsRepo:    goalsRepo,
-		MatchesRepo:  matchesRepo,
-		PlayersRepo:  playersRepo,
-		LogsProducer: logsProducer,
-		DB:           db,
-		Log:          log,
+		GoalsRepo:           goalsRepo,
+		MatchesRepo:         matchesRepo,
+		PlayersRepo:         playersRepo,
+		CompetitionsRepo:    competitionsRepo,
+		LeaderboardsRepo:    leaderboardsRepo,
+		RecordsRepo:         recordsRepo,
+		Leaderboards:        newLeaderboardCache(redisClient, log),
+		LogsProducer:        logsProducer,
+		RecordEventProducer: recordEventProducer,
+		DB:                  db,
+		Log:                 log,
+	}
+}
+
+// checkEligibility rejects goals by players who do not satisfy the age-category
+// rule of the match's competition.
+func (g *goalsUseCaseImpl) checkEligibility(tx *gorm.DB, match *entity.Match, player *entity.Player) error {
+	if match.CompetitionID == nil {
+		return nil
+	}
+
+	competition, err := g.CompetitionsRepo.FindByID(tx, *match.CompetitionID)
+	if err != nil {
+		g.Log.Errorf("Failed to find competition %s for match %s: %v", *match.CompetitionID, match.ID, err)
+		return common.ErrInternalServer("Failed to find competition for match")
+	}
+
+	if eligible, reason := checkPlayerEligibility(competition, player); !eligible {
+		g.Log.Warnf("Player %s is not eligible for competition %s: %s", player.ID, competition.ID, reason)
+		return common.ErrInvalidInput("Player is not eligible for this competition").WithDetail("player_id", player.ID).WithDetail("reason", reason)
+	}
+
+	return nil
+}
+
+// checkAddedTime only allows stoppage time at the end of a half or of a period
+// of extra time.
+func checkAddedTime(goal *entity.Goal) error {
+	if goal.AddedTime == nil || *goal.AddedTime == 0 {
+		return nil
+	}
+	switch goal.GoalTime {
+	case 45, 90, 105, 120:
+		return nil
+	}
+	return common.ErrInvalidInput("Added time is only allowed at 45, 90, 105 or 120 minutes").WithDetail("goal_time", fmt.Sprintf("%d", goal.GoalTime))
+}
+
+// checkAssist rejects an assist by the scorer or by a player outside the
+// scorer's team.
+func (g *goalsUseCaseImpl) checkAssist(tx *gorm.DB, goal *entity.Goal, scorer *entity.Player) error {
+	if goal.AssistPlayerID == nil {
+		return nil
+	}
+	if *goal.AssistPlayerID == goal.PlayerID {
+		return common.ErrInvalidInput("A player cannot assist their own goal").WithDetail("assist_player_id", *goal.AssistPlayerID)
+	}
+
+	assist, err := g.PlayersRepo.FindByID(tx, *goal.AssistPlayerID)
+	if err != nil {
+		return common.ErrNotFound("Assist player not found").WithDetail("id", *goal.AssistPlayerID)
+	}
+	if assist.TeamID != scorer.TeamID {
+		return common.ErrInvalidInput("Assist player does not belong to the scorer's team").WithDetail("assist_player_id", assist.ID)
+	}
+
+	return nil
+}
+
+// creditLeaderboards moves the goal and its assist on the season leaderboards.
+func (g *goalsUseCaseImpl) creditLeaderboards(ctx context.Context, seasonID *string, goal *entity.Goal, delta int64) {
+	g.Leaderboards.Incr(ctx, seasonID, repository.LeaderboardGoals, goal.PlayerID, delta)
+	if goal.AssistPlayerID != nil {
+		g.Leaderboards.Incr(ctx, seasonID, repository.LeaderboardAssists, *goal.AssistPlayerID, delta)
 	}
 }
 
@@ -72,6 +147,41 @@ func (g *goalsUseCaseImpl) FindAll(ctx context.Context) ([]model.GoalResponse, e
 	return responses, nil
 }
 
+// Export streams every goal to w, reading exportBatchSize rows at a time.
+func (g *goalsUseCaseImpl) Export(ctx context.Context, w common.ExportWriter) error {
+	tx := g.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := w.Write(converter.GoalExportHeader...); err != nil {
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to write goals export")
+	}
+	err := g.GoalsRepo.FindAllInBatches(tx, exportBatchSize, func(batch []entity.Goal) error {
+		for _, goal := range batch {
+			if err := w.Write(converter.ToGoalExportRow(converter.ToGoalResponse(&goal))...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, "Match", "Player", "Match.HomeTeam", "Match.AwayTeam")
+	if err != nil {
+		g.Log.Errorf("Failed to export goals: %v", err)
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to export goals")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		g.Log.Errorf("Failed to commit transaction: %v", err)
+		return common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return nil
+}
+
 func (g *goalsUseCaseImpl) FindByID(ctx context.Context, request *model.GoalRequestFindByID) (*model.GoalResponse, error) {
 	tx := g.DB.WithContext(ctx).Begin()
 	defer func() {
@@ -114,10 +224,14 @@ func (g *goalsUseCaseImpl) Create(ctx context.Context, request *model.GoalReques
 	}
 
 	goal := &entity.Goal{
-		ID:       uuid.New().String(),
-		MatchID:  request.MatchID,
-		PlayerID: request.PlayerID,
-		GoalTime: request.GoalTime,
+		ID:        uuid.New().String(),
+		MatchID:   request.MatchID,
+		PlayerID:  request.PlayerID,
+		GoalTime:  request.GoalTime,
+		AddedTime: request.AddedTime,
+	}
+	if request.AssistPlayerID != "" {
+		goal.AssistPlayerID = &request.AssistPlayerID
 	}
 
 	// Validation for GoalTime
@@ -125,6 +239,10 @@ func (g *goalsUseCaseImpl) Create(ctx context.Context, request *model.GoalReques
 		tx.Rollback()
 		return nil, common.ErrInvalidInput("Goal time must be between 0 and 120 minutes").WithDetail("goal_time", fmt.Sprintf("%d", request.GoalTime))
 	}
+	if err := checkAddedTime(goal); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
 	// Check if Match exists
 	match, err := g.MatchesRepo.FindByID(tx, request.MatchID)
@@ -146,6 +264,17 @@ func (g *goalsUseCaseImpl) Create(ctx context.Context, request *model.GoalReques
 		return nil, common.ErrInvalidInput("Player does not belong to either team in the match").WithDetail("player_id", request.PlayerID)
 	}
 
+	// Check the competition's eligibility rule
+	if err := g.checkEligibility(tx, match, player); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := g.checkAssist(tx, goal, player); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	// Update score in the match
 	if player.TeamID == match.HomeTeamID {
 		if match.HomeScore == nil {
@@ -173,11 +302,30 @@ func (g *goalsUseCaseImpl) Create(ctx context.Context, request *model.GoalReques
 		return nil, common.ErrInternalServer("Failed to create goal")
 	}
 
+	records, err := detectGoalRecords(tx, g.RecordsRepo, match, goal, player)
+	if err != nil {
+		tx.Rollback()
+		g.Log.Errorf("Failed to detect records for goal %s: %v", goal.ID, err)
+		return nil, common.ErrInternalServer("Failed to detect records")
+	}
+
+	seasonID, err := g.LeaderboardsRepo.FindMatchSeasonID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		g.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
 
+	g.creditLeaderboards(ctx, seasonID, goal, 1)
+	// a goal in a finished match can cost the other side's keeper a clean sheet
+	if match.Status == "completed" {
+		g.Leaderboards.Invalidate(ctx, seasonID, repository.LeaderboardCleanSheets)
+	}
+
 	// Send log event
 	logEvent := &model.LogEvent{
 		Level:   "info",
@@ -191,6 +339,10 @@ func (g *goalsUseCaseImpl) Create(ctx context.Context, request *model.GoalReques
 		return nil, common.ErrInternalServer("Failed to send log event")
 	}
 
+	if err := publishRecordEvents(g.RecordEventProducer, g.Log, records); err != nil {
+		return nil, common.ErrInternalServer("Failed to send record event")
+	}
+
 	return converter.ToGoalResponse(goal), nil
 }
 
@@ -214,15 +366,69 @@ func (g *goalsUseCaseImpl) Update(ctx context.Context, request *model.GoalReques
 		return nil, common.ErrNotFound("Goal not found").WithDetail("id", request.ID)
 	}
 
+	previous := *goal
+	oldSeasonID, err := g.LeaderboardsRepo.FindMatchSeasonID(tx, goal.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
 	if request.MatchID != "" {
 		goal.MatchID = request.MatchID
 	}
 	if request.PlayerID != "" {
 		goal.PlayerID = request.PlayerID
 	}
+	if request.AssistPlayerID != nil {
+		if *request.AssistPlayerID == "" {
+			goal.AssistPlayerID = nil
+		} else if _, err := uuid.Parse(*request.AssistPlayerID); err != nil {
+			tx.Rollback()
+			return nil, common.ErrInvalidInput("Invalid assist player ID").WithDetail("assist_player_id", *request.AssistPlayerID)
+		} else {
+			goal.AssistPlayerID = request.AssistPlayerID
+		}
+	}
+
+	// re-check eligibility when the scorer or the match changes
+	if request.MatchID != "" || request.PlayerID != "" || request.AssistPlayerID != nil {
+		match, err := g.MatchesRepo.FindByID(tx, goal.MatchID)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Match not found").WithDetail("id", goal.MatchID)
+		}
+		player, err := g.PlayersRepo.FindByID(tx, goal.PlayerID)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Player not found").WithDetail("id", goal.PlayerID)
+		}
+		if request.MatchID != "" || request.PlayerID != "" {
+			if err := g.checkEligibility(tx, match, player); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		if err := g.checkAssist(tx, goal, player); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	newSeasonID, err := g.LeaderboardsRepo.FindMatchSeasonID(tx, goal.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
 	if request.GoalTime >= 0 {
 		goal.GoalTime = request.GoalTime
 	}
+	if request.AddedTime != nil {
+		goal.AddedTime = request.AddedTime
+	}
+	if err := checkAddedTime(goal); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
 	if err := g.GoalsRepo.Update(tx, goal); err != nil {
 		tx.Rollback()
@@ -234,6 +440,9 @@ func (g *goalsUseCaseImpl) Update(ctx context.Context, request *model.GoalReques
 		g.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
+
+	g.creditLeaderboards(ctx, oldSeasonID, &previous, -1)
+	g.creditLeaderboards(ctx, newSeasonID, goal, 1)
 	// Send log event
 	logEvent := &model.LogEvent{
 		Level:   "info",
@@ -276,10 +485,24 @@ func (g *goalsUseCaseImpl) SoftDelete(ctx context.Context, request *model.GoalRe
 		return nil, common.ErrInternalServer("Failed to soft delete goal")
 	}
 
+	if err := withdrawGoalRecords(tx, g.RecordsRepo, goal); err != nil {
+		tx.Rollback()
+		g.Log.Errorf("Failed to withdraw records for goal %s: %v", goal.ID, err)
+		return nil, common.ErrInternalServer("Failed to update records")
+	}
+
+	seasonID, err := g.LeaderboardsRepo.FindMatchSeasonID(tx, goal.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		g.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
+
+	g.creditLeaderboards(ctx, seasonID, goal, -1)
 	// Send log event
 	logEvent := &model.LogEvent{
 		Level:   "info",
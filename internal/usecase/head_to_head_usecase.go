@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	headToHeadBiggestWinsLimit = 3
+	headToHeadMeetingsLimit    = 5
+	headToHeadScorersLimit     = 5
+)
+
+type HeadToHeadUseCase interface {
+	Find(ctx context.Context, request *model.HeadToHeadRequest) (*model.HeadToHeadResponse, error)
+}
+
+type headToHeadUseCaseImpl struct {
+	MatchesRepo repository.MatchesRepository
+	TeamsRepo   repository.TeamsRepository
+	DB          *gorm.DB
+	Log         *logrus.Logger
+}
+
+func NewHeadToHeadUseCase(matchesRepo repository.MatchesRepository, teamsRepo repository.TeamsRepository, db *gorm.DB, log *logrus.Logger) HeadToHeadUseCase {
+	return &headToHeadUseCaseImpl{
+		MatchesRepo: matchesRepo,
+		TeamsRepo:   teamsRepo,
+		DB:          db,
+		Log:         log,
+	}
+}
+
+// sumHeadToHead adds up the per-season records into one.
+func sumHeadToHead(records []repository.HeadToHeadRecord) model.HeadToHeadRecord {
+	var total model.HeadToHeadRecord
+	for _, record := range records {
+		total.Played += record.Played
+		total.Wins += record.Wins
+		total.Draws += record.Draws
+		total.Losses += record.Losses
+		total.GoalsFor += record.GoalsFor
+		total.GoalsAgainst += record.GoalsAgainst
+	}
+	return total
+}
+
+func (h *headToHeadUseCaseImpl) Find(ctx context.Context, request *model.HeadToHeadRequest) (*model.HeadToHeadResponse, error) {
+	tx := h.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		h.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	team, err := h.TeamsRepo.FindByID(tx, request.TeamID)
+	if err != nil {
+		h.Log.Errorf("Failed to find team by ID %s: %v", request.TeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	opponent, err := h.TeamsRepo.FindByID(tx, request.OtherTeamID)
+	if err != nil {
+		h.Log.Errorf("Failed to find team by ID %s: %v", request.OtherTeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("other_id", request.OtherTeamID)
+	}
+
+	records, err := h.MatchesRepo.FindHeadToHeadRecords(tx, team.ID, opponent.ID, nil)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to aggregate head-to-head record")
+	}
+
+	biggestWins, err := h.MatchesRepo.FindHeadToHeadBiggestWins(tx, team.ID, opponent.ID, headToHeadBiggestWinsLimit)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find biggest wins")
+	}
+
+	biggestLosses, err := h.MatchesRepo.FindHeadToHeadBiggestWins(tx, opponent.ID, team.ID, headToHeadBiggestWinsLimit)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find biggest losses")
+	}
+
+	meetings, err := h.MatchesRepo.FindHeadToHeadMeetings(tx, team.ID, opponent.ID, headToHeadMeetingsLimit)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find recent meetings")
+	}
+
+	scorers, err := h.MatchesRepo.FindHeadToHeadScorers(tx, team.ID, opponent.ID, headToHeadScorersLimit)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find head-to-head scorers")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		h.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	seasons := []model.HeadToHeadSeasonRecord{}
+	for _, record := range records {
+		seasons = append(seasons, model.HeadToHeadSeasonRecord{
+			SeasonID:   record.SeasonID,
+			SeasonName: record.SeasonName,
+			Record:     sumHeadToHead([]repository.HeadToHeadRecord{record}),
+		})
+	}
+
+	topScorers := []model.HeadToHeadScorer{}
+	for _, scorer := range scorers {
+		topScorers = append(topScorers, model.HeadToHeadScorer{
+			PlayerID:   scorer.PlayerID,
+			PlayerName: scorer.PlayerName,
+			TeamID:     scorer.TeamID,
+			Goals:      scorer.Goals,
+		})
+	}
+
+	loc := common.LocationFromContext(ctx)
+	return &model.HeadToHeadResponse{
+		Team:           model.TeamShort{ID: team.ID, Name: team.Name},
+		Opponent:       model.TeamShort{ID: opponent.ID, Name: opponent.Name},
+		AllTime:        sumHeadToHead(records),
+		Seasons:        seasons,
+		BiggestWins:    converter.ToHeadToHeadMatches(biggestWins, loc),
+		BiggestLosses:  converter.ToHeadToHeadMatches(biggestLosses, loc),
+		RecentMeetings: converter.ToHeadToHeadMatches(meetings, loc),
+		TopScorers:     topScorers,
+	}, nil
+}
@@ -0,0 +1,673 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// maxImportRows bounds a file so a committed import stays one reasonable
+// transaction.
+const maxImportRows = 2000
+
+type ImportUseCase interface {
+	Teams(ctx context.Context, request *model.ImportRequest) (*model.ImportResponse, error)
+	Players(ctx context.Context, request *model.ImportRequest) (*model.ImportResponse, error)
+	Results(ctx context.Context, request *model.ResultsImportRequest) (*model.ResultsImportResponse, error)
+}
+
+type importUseCaseImpl struct {
+	TeamsRepo        repository.TeamsRepository
+	PlayersRepo      repository.PlayersRepository
+	VenuesRepo       repository.VenuesRepository
+	MatchesRepo      repository.MatchesRepository
+	GoalsRepo        repository.GoalsRepository
+	CompetitionsRepo repository.CompetitionsRepository
+	Leaderboards     *leaderboardCache
+	LogsProducer     *messaging.LogProducer
+	DB               *gorm.DB
+	Log              *logrus.Logger
+}
+
+func NewImportUseCase(teamsRepo repository.TeamsRepository, playersRepo repository.PlayersRepository, venuesRepo repository.VenuesRepository, matchesRepo repository.MatchesRepository, goalsRepo repository.GoalsRepository, competitionsRepo repository.CompetitionsRepository, redisClient *redis.Client, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) ImportUseCase {
+	return &importUseCaseImpl{
+		TeamsRepo:        teamsRepo,
+		PlayersRepo:      playersRepo,
+		VenuesRepo:       venuesRepo,
+		MatchesRepo:      matchesRepo,
+		GoalsRepo:        goalsRepo,
+		CompetitionsRepo: competitionsRepo,
+		Leaderboards:     newLeaderboardCache(redisClient, log),
+		LogsProducer:     logsProducer,
+		DB:               db,
+		Log:              log,
+	}
+}
+
+// readImport validates the request and reads its rows.
+func (i *importUseCaseImpl) readImport(request *model.ImportRequest, columns []string) ([]model.ImportRecord, error) {
+	if err := common.ValidateStruct(request); err != nil {
+		i.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	records, err := converter.ReadImportCSV(request.File, columns)
+	if err != nil {
+		i.Log.Warnf("Invalid import file: %v", err)
+		return nil, common.ErrInvalidInput("Invalid CSV file").WithDetail("file", err.Error())
+	}
+	if len(records) == 0 {
+		return nil, common.ErrInvalidInput("Import file has no rows")
+	}
+	if len(records) > maxImportRows {
+		return nil, common.ErrInvalidInput("Import file has too many rows").WithDetail("rows", fmt.Sprintf("at most %d rows are allowed", maxImportRows))
+	}
+	return records, nil
+}
+
+// addRow records the outcome of checking one row.
+func addRow(response *model.ImportResponse, line int, errs []model.ImportRowError) {
+	row := model.ImportRowResult{Row: line, Status: "valid", Errors: []model.ImportRowError{}}
+	if len(errs) > 0 {
+		row.Status = "invalid"
+		row.Errors = errs
+		response.Invalid++
+	} else {
+		response.Valid++
+	}
+	response.Rows = append(response.Rows, row)
+}
+
+// rowErrorDetails names each error of a row after its line in the file.
+func rowErrorDetails(row int, errs []model.ImportRowError) []common.ErrorDetail {
+	details := make([]common.ErrorDetail, len(errs))
+	for i, err := range errs {
+		details[i] = common.ErrorDetail{Field: fmt.Sprintf("row %d: %s", row, err.Field), Message: err.Message}
+	}
+	return details
+}
+
+// sendImportLog reports a committed import.
+func (i *importUseCaseImpl) sendImportLog(service, message string) error {
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: message,
+		Service: service,
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	i.Log.Infof("Sending log event: %+v", logEvent)
+	if err := i.LogsProducer.Send(logEvent); err != nil {
+		i.Log.Errorf("Failed to send log event: %v", err)
+		return common.ErrInternalServer("Failed to send log event")
+	}
+	return nil
+}
+
+// finishImport ends a checked import. A dry run just returns the report. A
+// commit calls create for every row and keeps the rows only if all of them
+// are valid and created.
+func (i *importUseCaseImpl) finishImport(tx *gorm.DB, response *model.ImportResponse, service string, create func(n int) (string, error)) (*model.ImportResponse, error) {
+	if response.Mode == "dry_run" {
+		tx.Rollback()
+		return response, nil
+	}
+
+	if response.Invalid > 0 {
+		tx.Rollback()
+		var details []common.ErrorDetail
+		for _, row := range response.Rows {
+			details = append(details, rowErrorDetails(row.Row, row.Errors)...)
+		}
+		return nil, common.ErrMultipleValidation(details)
+	}
+
+	for n := range response.Rows {
+		id, err := create(n)
+		if err != nil {
+			i.Log.Errorf("Failed to import %s row %d: %v", service, response.Rows[n].Row, err)
+			tx.Rollback()
+			return nil, common.ErrInternalServer(fmt.Sprintf("Failed to import %s", service)).WithDetail("row", fmt.Sprintf("%d", response.Rows[n].Row))
+		}
+		response.Rows[n].ID = &id
+		response.Rows[n].Status = "imported"
+		response.Imported++
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		i.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	if err := i.sendImportLog(service, fmt.Sprintf("Imported %d %s", response.Imported, service)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Teams checks each row as TeamsUseCase.Create would, and also rejects names
+// repeated within the file.
+func (i *importUseCaseImpl) Teams(ctx context.Context, request *model.ImportRequest) (*model.ImportResponse, error) {
+	tx := i.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	records, err := i.readImport(request, converter.TeamImportColumns)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	response := &model.ImportResponse{Mode: request.Mode, Total: len(records), Rows: []model.ImportRowResult{}}
+	teams := make([]*entity.Team, len(records))
+	names := map[string]int{}
+	currentYear := time.Now().Year()
+	for n, record := range records {
+		req, errs := converter.ToTeamImportRequest(&record)
+		if len(errs) == 0 {
+			if appErr := common.ValidateStruct(req); appErr != nil {
+				errs = converter.ToImportRowErrors(appErr.Details)
+			}
+		}
+
+		if len(errs) == 0 {
+			if line, ok := names[req.Name]; ok {
+				errs = append(errs, model.ImportRowError{Field: "name", Message: fmt.Sprintf("Team with this name is already on row %d", line)})
+			} else {
+				names[req.Name] = record.Line
+				exists, err := i.TeamsRepo.CheckTeamExistsByName(tx, req.Name)
+				if err != nil {
+					tx.Rollback()
+					return nil, common.ErrInternalServer("Failed to check team existence")
+				}
+				if exists {
+					errs = append(errs, model.ImportRowError{Field: "name", Message: "Team with this name already exists"})
+				}
+			}
+
+			if req.FoundedYear > currentYear {
+				errs = append(errs, model.ImportRowError{Field: "founded_year", Message: "Invalid founded year"})
+			}
+
+			if req.HomeVenueID != "" {
+				if _, err := i.VenuesRepo.FindByID(tx, req.HomeVenueID); err != nil {
+					errs = append(errs, model.ImportRowError{Field: "home_venue_id", Message: "Venue not found"})
+				}
+			}
+		}
+
+		addRow(response, record.Line, errs)
+		if len(errs) == 0 {
+			teams[n] = &entity.Team{
+				ID:                  uuid.New().String(),
+				Name:                req.Name,
+				Logo:                req.Logo,
+				FoundedYear:         req.FoundedYear,
+				HeadquartersAddress: req.HeadquartersAddress,
+				HeadquartersCity:    req.HeadquartersCity,
+			}
+			if req.HomeVenueID != "" {
+				teams[n].HomeVenueID = &req.HomeVenueID
+			}
+		}
+	}
+
+	return i.finishImport(tx, response, "teams", func(n int) (string, error) {
+		return teams[n].ID, i.TeamsRepo.Create(tx, teams[n])
+	})
+}
+
+// Players checks each row as PlayersUseCase.Create would, and also rejects
+// jersey numbers repeated within a team in the file. A row may name its team
+// with team_name instead of team_id.
+func (i *importUseCaseImpl) Players(ctx context.Context, request *model.ImportRequest) (*model.ImportResponse, error) {
+	tx := i.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	records, err := i.readImport(request, converter.PlayerImportColumns)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	response := &model.ImportResponse{Mode: request.Mode, Total: len(records), Rows: []model.ImportRowResult{}}
+	players := make([]*entity.Player, len(records))
+	teamsByName := map[string]*entity.Team{}
+	teamExists := map[string]bool{}
+	jerseys := map[string]int{}
+	for n, record := range records {
+		req, errs := converter.ToPlayerImportRequest(&record)
+
+		if name := record.Values["team_name"]; req.TeamID == "" && name != "" {
+			team, ok := teamsByName[name]
+			if !ok {
+				team, err = i.TeamsRepo.FindByName(tx, name)
+				if err != nil {
+					tx.Rollback()
+					return nil, common.ErrInternalServer("Failed to find team")
+				}
+				teamsByName[name] = team
+			}
+			if team == nil {
+				errs = append(errs, model.ImportRowError{Field: "team_name", Message: "Team not found"})
+			} else {
+				req.TeamID = team.ID
+				teamExists[team.ID] = true
+			}
+		}
+
+		if len(errs) == 0 {
+			if appErr := common.ValidateStruct(req); appErr != nil {
+				errs = converter.ToImportRowErrors(appErr.Details)
+			}
+		}
+
+		if len(errs) == 0 {
+			exists, ok := teamExists[req.TeamID]
+			if !ok {
+				exists, err = i.TeamsRepo.CheckTeamExistsByTeamID(tx, req.TeamID)
+				if err != nil {
+					tx.Rollback()
+					return nil, common.ErrInternalServer("Failed to check team existence")
+				}
+				teamExists[req.TeamID] = exists
+			}
+			if !exists {
+				errs = append(errs, model.ImportRowError{Field: "team_id", Message: "Team not found"})
+			}
+		}
+
+		if len(errs) == 0 {
+			key := fmt.Sprintf("%s#%d", req.TeamID, req.JerseyNumber)
+			if line, ok := jerseys[key]; ok {
+				errs = append(errs, model.ImportRowError{Field: "jersey_number", Message: fmt.Sprintf("Jersey number is already taken on row %d", line)})
+			} else {
+				jerseys[key] = record.Line
+				taken, err := i.PlayersRepo.CheckNumberJerseyByNumberAndTeamID(tx, req.JerseyNumber, req.TeamID)
+				if err != nil {
+					tx.Rollback()
+					return nil, common.ErrInternalServer("Failed to check jersey number")
+				}
+				if taken {
+					errs = append(errs, model.ImportRowError{Field: "jersey_number", Message: "Jersey number already taken by another player in the same team"})
+				}
+			}
+		}
+
+		addRow(response, record.Line, errs)
+		if len(errs) == 0 {
+			players[n] = &entity.Player{
+				ID:           uuid.New().String(),
+				Name:         req.Name,
+				Position:     req.Position,
+				TeamID:       req.TeamID,
+				Height:       req.Height,
+				Weight:       req.Weight,
+				JerseyNumber: req.JerseyNumber,
+				DateOfBirth:  common.ConvertStringToDatePointer(req.DateOfBirth),
+			}
+		}
+	}
+
+	return i.finishImport(tx, response, "players", func(n int) (string, error) {
+		return players[n].ID, i.PlayersRepo.Create(tx, players[n])
+	})
+}
+
+// plannedResult is a match of a results file waiting to be created.
+type plannedResult struct {
+	match *entity.Match
+	goals []*entity.Goal
+}
+
+// Results imports finished matches from a football-data or openfootball file.
+// Team names are matched fuzzily against existing teams; unmatched ones are
+// created when the request asks for it, as placeholders holding only the name
+// until their details are filled in through the teams API. A match already stored for the same
+// teams and date is left alone, so importing a file again adds nothing.
+// Scorers are matched by name against their team's players, and goals whose
+// scorer is not found, including own goals, are skipped.
+func (i *importUseCaseImpl) Results(ctx context.Context, request *model.ResultsImportRequest) (*model.ResultsImportResponse, error) {
+	tx := i.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		i.Log.Warnf("Invalid request body : %+v", err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(request.Timezone)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Invalid timezone").WithDetail("timezone", request.Timezone)
+	}
+
+	format := request.Format
+	if format == "" {
+		format = converter.DetectResultsFormat(request.File)
+	}
+	results, err := converter.ReadHistoricalResults(format, request.File)
+	if err != nil {
+		i.Log.Warnf("Invalid results file: %v", err)
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Invalid results file").WithDetail("file", err.Error())
+	}
+	if len(results) == 0 {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Results file has no finished matches")
+	}
+	if len(results) > maxImportRows {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Results file has too many matches").WithDetail("rows", fmt.Sprintf("at most %d matches are allowed", maxImportRows))
+	}
+
+	var competitionID, seasonID *string
+	if request.CompetitionID != "" {
+		competition, err := i.CompetitionsRepo.FindByID(tx, request.CompetitionID)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Competition not found").WithDetail("competition_id", request.CompetitionID)
+		}
+		competitionID, seasonID = &request.CompetitionID, competition.SeasonID
+	}
+
+	existingTeams, err := i.TeamsRepo.FindAll(tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find teams")
+	}
+	matcher := newTeamMatcher(existingTeams)
+
+	response := &model.ResultsImportResponse{
+		Mode:   request.Mode,
+		Format: format,
+		Total:  len(results),
+		Teams:  []model.ResultsImportTeam{},
+		Rows:   []model.ResultsImportRow{},
+	}
+
+	// each name in the file is matched once
+	teamsByName := map[string]*entity.Team{}
+	var newTeams []*entity.Team
+	newTeamIDs := map[string]bool{}
+	resolve := func(name string) *entity.Team {
+		if team, ok := teamsByName[name]; ok {
+			return team
+		}
+		report := model.ResultsImportTeam{Name: name, Match: "unmatched"}
+		team, score := matcher.match(name)
+		report.Score = score
+		switch {
+		case team != nil:
+			report.Match = "fuzzy"
+			if score == 1 {
+				report.Match = "exact"
+			}
+			report.TeamID, report.TeamName = &team.ID, &team.Name
+		case request.CreateTeams:
+			team = &entity.Team{ID: uuid.New().String(), Name: name, Placeholder: true}
+			matcher.add(*team)
+			newTeams = append(newTeams, team)
+			newTeamIDs[team.ID] = true
+			report.Match = "created"
+			report.TeamName = &team.Name
+		}
+		teamsByName[name] = team
+		response.Teams = append(response.Teams, report)
+		return team
+	}
+
+	// teams of imported matches have to be registered in the competition to
+	// count in its standings
+	var unregistered []*entity.Team
+	registering := map[string]bool{}
+	register := func(team *entity.Team) error {
+		if competitionID == nil || registering[team.ID] {
+			return nil
+		}
+		if !newTeamIDs[team.ID] {
+			registered, err := i.CompetitionsRepo.CheckTeamRegistered(tx, *competitionID, team.ID)
+			if err != nil || registered {
+				return err
+			}
+		}
+		registering[team.ID] = true
+		unregistered = append(unregistered, team)
+		return nil
+	}
+
+	squads := map[string][]entity.Player{}
+	squad := func(teamID string) ([]entity.Player, error) {
+		if players, ok := squads[teamID]; ok || newTeamIDs[teamID] {
+			return players, nil
+		}
+		players, err := i.PlayersRepo.FindByTeamID(tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		squads[teamID] = players
+		return players, nil
+	}
+
+	planned := make([]*plannedResult, len(results))
+	seen := map[string]int{}
+	for n, result := range results {
+		row := model.ResultsImportRow{
+			Row:          result.Row,
+			MatchDate:    result.Date.Format("2006-01-02"),
+			HomeTeam:     result.HomeTeam,
+			AwayTeam:     result.AwayTeam,
+			HomeScore:    result.HomeScore,
+			AwayScore:    result.AwayScore,
+			Status:       "valid",
+			SkippedGoals: []string{},
+			Errors:       []model.ImportRowError{},
+		}
+
+		home, away := resolve(result.HomeTeam), resolve(result.AwayTeam)
+		if home == nil {
+			row.Errors = append(row.Errors, model.ImportRowError{Field: "home_team", Message: "Team not found"})
+		}
+		if away == nil {
+			row.Errors = append(row.Errors, model.ImportRowError{Field: "away_team", Message: "Team not found"})
+		}
+		if home != nil && away != nil && home.ID == away.ID {
+			row.Errors = append(row.Errors, model.ImportRowError{Field: "away_team", Message: "Home and away team must be different"})
+		}
+
+		if len(row.Errors) == 0 {
+			key := home.ID + "|" + away.ID + "|" + row.MatchDate
+			if line, ok := seen[key]; ok {
+				row.Errors = append(row.Errors, model.ImportRowError{Field: "match", Message: fmt.Sprintf("Match is already on row %d", line)})
+			} else {
+				seen[key] = result.Row
+			}
+		}
+
+		if len(row.Errors) > 0 {
+			row.Status = "invalid"
+			response.Invalid++
+			response.Rows = append(response.Rows, row)
+			continue
+		}
+
+		if !newTeamIDs[home.ID] && !newTeamIDs[away.ID] {
+			existing, err := i.MatchesRepo.FindByTeamsAndDate(tx, home.ID, away.ID, result.Date)
+			if err != nil {
+				tx.Rollback()
+				return nil, common.ErrInternalServer("Failed to find existing match")
+			}
+			if existing != nil {
+				row.Status = "existing"
+				row.MatchID = &existing.ID
+				response.Existing++
+				response.Rows = append(response.Rows, row)
+				continue
+			}
+		}
+
+		// files without kickoff times get midnight
+		matchTime := "00:00"
+		kickoff, err := common.ParseMatchTime(result.Time)
+		if err == nil {
+			matchTime = result.Time
+		}
+		homeScore, awayScore := result.HomeScore, result.AwayScore
+		match := &entity.Match{
+			ID:            uuid.New().String(),
+			MatchDate:     result.Date,
+			MatchTime:     matchTime,
+			KickoffAt:     time.Date(result.Date.Year(), result.Date.Month(), result.Date.Day(), kickoff.Hour(), kickoff.Minute(), 0, 0, loc),
+			Timezone:      request.Timezone,
+			HomeTeamID:    home.ID,
+			AwayTeamID:    away.ID,
+			HomeScore:     &homeScore,
+			AwayScore:     &awayScore,
+			Status:        "completed",
+			CompetitionID: competitionID,
+			Matchday:      result.Matchday,
+		}
+
+		for _, team := range []*entity.Team{home, away} {
+			if err := register(team); err != nil {
+				tx.Rollback()
+				return nil, common.ErrInternalServer("Failed to check team registration")
+			}
+		}
+
+		plan := &plannedResult{match: match}
+		for _, goal := range result.Goals {
+			if goal.OwnGoal {
+				row.SkippedGoals = append(row.SkippedGoals, fmt.Sprintf("%s %d' (own goal)", goal.Player, goal.Minute))
+				continue
+			}
+			team := away
+			if goal.Home {
+				team = home
+			}
+			players, err := squad(team.ID)
+			if err != nil {
+				tx.Rollback()
+				return nil, common.ErrInternalServer("Failed to find players")
+			}
+			player := matchPlayer(goal.Player, players)
+			if player == nil {
+				row.SkippedGoals = append(row.SkippedGoals, fmt.Sprintf("%s %d'", goal.Player, goal.Minute))
+				continue
+			}
+			scored := &entity.Goal{
+				ID:       uuid.New().String(),
+				MatchID:  match.ID,
+				PlayerID: player.ID,
+				GoalTime: int16(goal.Minute),
+			}
+			if goal.Offset != nil {
+				addedTime := int16(*goal.Offset)
+				scored.AddedTime = &addedTime
+			}
+			plan.goals = append(plan.goals, scored)
+		}
+		row.Goals = len(plan.goals)
+
+		planned[n] = plan
+		response.Valid++
+		response.Rows = append(response.Rows, row)
+	}
+
+	for n := range response.Teams {
+		if team := teamsByName[response.Teams[n].Name]; team != nil {
+			response.Teams[n].Registered = registering[team.ID]
+		}
+	}
+
+	if request.Mode == "dry_run" {
+		tx.Rollback()
+		return response, nil
+	}
+
+	if response.Invalid > 0 {
+		tx.Rollback()
+		var details []common.ErrorDetail
+		for _, row := range response.Rows {
+			details = append(details, rowErrorDetails(row.Row, row.Errors)...)
+		}
+		return nil, common.ErrMultipleValidation(details)
+	}
+
+	for _, team := range newTeams {
+		if err := i.TeamsRepo.Create(tx, team); err != nil {
+			i.Log.Errorf("Failed to create team %s: %v", team.Name, err)
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to create team").WithDetail("name", team.Name)
+		}
+	}
+	for n := range response.Teams {
+		if team := teamsByName[response.Teams[n].Name]; team != nil {
+			response.Teams[n].TeamID = &team.ID
+		}
+	}
+	for _, team := range unregistered {
+		if err := i.CompetitionsRepo.RegisterTeam(tx, *competitionID, team.ID); err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to register team").WithDetail("team_id", team.ID)
+		}
+	}
+
+	for n, plan := range planned {
+		if plan == nil {
+			continue
+		}
+		if err := i.MatchesRepo.Create(tx, plan.match); err != nil {
+			i.Log.Errorf("Failed to import match on row %d: %v", response.Rows[n].Row, err)
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to import match").WithDetail("row", fmt.Sprintf("%d", response.Rows[n].Row))
+		}
+		for _, goal := range plan.goals {
+			if err := i.GoalsRepo.Create(tx, goal); err != nil {
+				i.Log.Errorf("Failed to import goal on row %d: %v", response.Rows[n].Row, err)
+				tx.Rollback()
+				return nil, common.ErrInternalServer("Failed to import goal").WithDetail("row", fmt.Sprintf("%d", response.Rows[n].Row))
+			}
+		}
+		response.Rows[n].MatchID = &plan.match.ID
+		response.Rows[n].Status = "imported"
+		response.Imported++
+		response.Goals += len(plan.goals)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		i.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+	i.Leaderboards.Invalidate(ctx, seasonID, repository.LeaderboardBoards...)
+
+	message := fmt.Sprintf("Imported %d matches, %d goals and %d new teams from a %s file", response.Imported, response.Goals, len(newTeams), format)
+	if err := i.sendImportLog("matches", message); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type InjuriesUseCase interface {
+	FindByPlayerID(ctx context.Context, request *model.InjuryRequestFindByPlayerID) ([]model.InjuryResponse, error)
+	Create(ctx context.Context, request *model.InjuryRequestCreate) (*model.InjuryResponse, error)
+	Update(ctx context.Context, request *model.InjuryRequestUpdate) (*model.InjuryResponse, error)
+	SoftDelete(ctx context.Context, request *model.InjuryRequestSoftDelete) (*model.InjuryResponse, error)
+}
+
+type injuriesUseCaseImpl struct {
+	InjuriesRepo repository.InjuriesRepository
+	PlayersRepo  repository.PlayersRepository
+	LogsProducer *messaging.LogProducer
+	DB           *gorm.DB
+	Log          *logrus.Logger
+}
+
+func NewInjuriesUseCase(injuriesRepo repository.InjuriesRepository, playersRepo repository.PlayersRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) InjuriesUseCase {
+	return &injuriesUseCaseImpl{
+		InjuriesRepo: injuriesRepo,
+		PlayersRepo:  playersRepo,
+		LogsProducer: logsProducer,
+		DB:           db,
+		Log:          log,
+	}
+}
+
+func (i *injuriesUseCaseImpl) FindByPlayerID(ctx context.Context, request *model.InjuryRequestFindByPlayerID) ([]model.InjuryResponse, error) {
+	tx := i.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		i.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := i.PlayersRepo.FindByID(tx, request.PlayerID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Player not found").WithDetail("id", request.PlayerID)
+	}
+
+	injuries, err := i.InjuriesRepo.FindByPlayerID(tx, request.PlayerID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find injuries")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		i.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.InjuryResponse{}
+	for _, injury := range injuries {
+		responses = append(responses, *converter.ToInjuryResponse(&injury))
+	}
+
+	return responses, nil
+}
+
+func (i *injuriesUseCaseImpl) Create(ctx context.Context, request *model.InjuryRequestCreate) (*model.InjuryResponse, error) {
+	tx := i.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		i.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	if _, err := i.PlayersRepo.FindByID(tx, request.PlayerID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Player not found").WithDetail("id", request.PlayerID)
+	}
+
+	injury := &entity.PlayerInjury{
+		ID:             uuid.New().String(),
+		PlayerID:       request.PlayerID,
+		InjuryType:     request.InjuryType,
+		StartDate:      common.ConvertStringToDate(request.StartDate),
+		ExpectedReturn: common.ConvertStringToDatePointer(request.ExpectedReturn),
+		Notes:          request.Notes,
+	}
+
+	if injury.ExpectedReturn != nil && injury.ExpectedReturn.Before(injury.StartDate) {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Expected return cannot be before the start date").WithDetail("expected_return", request.ExpectedReturn)
+	}
+
+	if err := i.InjuriesRepo.Create(tx, injury); err != nil {
+		tx.Rollback()
+		i.Log.Errorf("Failed to create injury: %v", err)
+		return nil, common.ErrInternalServer("Failed to create injury")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		i.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Injury recorded for player %s", injury.PlayerID),
+		Service: "injuries",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	i.Log.Infof("Sending log event: %+v", logEvent)
+	if err := i.LogsProducer.Send(logEvent); err != nil {
+		i.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToInjuryResponse(injury), nil
+}
+
+func (i *injuriesUseCaseImpl) Update(ctx context.Context, request *model.InjuryRequestUpdate) (*model.InjuryResponse, error) {
+	tx := i.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		i.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	injury, err := i.InjuriesRepo.FindByID(tx, request.ID)
+	if err != nil {
+		i.Log.Errorf("Failed to find injury by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Injury not found").WithDetail("id", request.ID)
+	}
+
+	if request.InjuryType != "" {
+		injury.InjuryType = request.InjuryType
+	}
+	if request.StartDate != "" {
+		injury.StartDate = common.ConvertStringToDate(request.StartDate)
+	}
+	if request.ExpectedReturn != "" {
+		injury.ExpectedReturn = common.ConvertStringToDatePointer(request.ExpectedReturn)
+	}
+	if request.Notes != "" {
+		injury.Notes = request.Notes
+	}
+
+	if injury.ExpectedReturn != nil && injury.ExpectedReturn.Before(injury.StartDate) {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Expected return cannot be before the start date").WithDetail("expected_return", injury.ExpectedReturn.Format("2006-01-02"))
+	}
+
+	if err := i.InjuriesRepo.Update(tx, injury); err != nil {
+		tx.Rollback()
+		i.Log.Errorf("Failed to update injury: %v", err)
+		return nil, common.ErrInternalServer("Failed to update injury")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		i.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Injury with ID %s updated successfully", injury.ID),
+		Service: "injuries",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	i.Log.Infof("Sending log event: %+v", logEvent)
+	if err := i.LogsProducer.Send(logEvent); err != nil {
+		i.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToInjuryResponse(injury), nil
+}
+
+func (i *injuriesUseCaseImpl) SoftDelete(ctx context.Context, request *model.InjuryRequestSoftDelete) (*model.InjuryResponse, error) {
+	tx := i.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		i.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	injury, err := i.InjuriesRepo.FindByID(tx, request.ID)
+	if err != nil {
+		i.Log.Errorf("Failed to find injury by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Injury not found").WithDetail("id", request.ID)
+	}
+
+	if err := i.InjuriesRepo.SoftDelete(tx, injury.ID); err != nil {
+		tx.Rollback()
+		i.Log.Errorf("Failed to soft delete injury: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete injury")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		i.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Injury with ID %s soft deleted successfully", injury.ID),
+		Service: "injuries",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	i.Log.Infof("Sending log event: %+v", logEvent)
+	if err := i.LogsProducer.Send(logEvent); err != nil {
+		i.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToInjuryResponse(injury), nil
+}
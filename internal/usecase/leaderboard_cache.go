@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+// leaderboardTTL bounds how long a leaderboard may drift from the database
+// before it is rebuilt.
+const leaderboardTTL = 24 * time.Hour
+
+// leaderboardRebuildAttempts bounds how often a read retries a rebuild that
+// lost the race against a concurrent write.
+const leaderboardRebuildAttempts = 3
+
+// leaderboardIncrScript only moves a score on a leaderboard that is already
+// built, so a missing leaderboard is rebuilt from the database on the next read.
+// Players dropping to zero leave the leaderboard. Every write bumps the version
+// so that a rebuild computed before the write is not swapped in.
+var leaderboardIncrScript = redis.NewScript(`
+redis.call('INCR', KEYS[2])
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+local score = redis.call('ZINCRBY', KEYS[1], ARGV[1], ARGV[2])
+if tonumber(score) <= 0 then
+	redis.call('ZREM', KEYS[1], ARGV[2])
+end
+return 1
+`)
+
+// leaderboardSwapScript moves a rebuilt leaderboard into place unless the
+// version moved since the rebuild started, in which case the rebuild is thrown
+// away and 0 is returned.
+var leaderboardSwapScript = redis.NewScript(`
+if (redis.call('GET', KEYS[3]) or '0') ~= ARGV[1] then
+	redis.call('DEL', KEYS[2])
+	return 0
+end
+if redis.call('EXISTS', KEYS[2]) == 1 then
+	redis.call('RENAME', KEYS[2], KEYS[1])
+	redis.call('EXPIRE', KEYS[1], ARGV[2])
+else
+	redis.call('DEL', KEYS[1])
+end
+return 1
+`)
+
+// leaderboardCache keeps the season leaderboards in Redis sorted sets, scored by
+// the statistic and keyed by player.
+type leaderboardCache struct {
+	Redis *redis.Client
+	Log   *logrus.Logger
+}
+
+func newLeaderboardCache(redisClient *redis.Client, log *logrus.Logger) *leaderboardCache {
+	return &leaderboardCache{
+		Redis: redisClient,
+		Log:   log,
+	}
+}
+
+func leaderboardKey(seasonID, board string) string {
+	return fmt.Sprintf("leaderboard:%s:%s", seasonID, board)
+}
+
+func leaderboardVersionKey(seasonID, board string) string {
+	return leaderboardKey(seasonID, board) + ":version"
+}
+
+// Incr moves the player's score on the board. Failures drop the leaderboard so
+// it is rebuilt rather than left wrong.
+func (l *leaderboardCache) Incr(ctx context.Context, seasonID *string, board, playerID string, delta int64) {
+	if seasonID == nil || delta == 0 {
+		return
+	}
+	key := leaderboardKey(*seasonID, board)
+	keys := []string{key, leaderboardVersionKey(*seasonID, board)}
+	if err := leaderboardIncrScript.Run(ctx, l.Redis, keys, delta, playerID, int64(leaderboardTTL.Seconds())).Err(); err != nil {
+		l.Log.Warnf("Failed to update leaderboard %s: %v", key, err)
+		l.Invalidate(ctx, seasonID, board)
+	}
+}
+
+// Invalidate drops the boards so they are rebuilt on the next read, and bumps
+// their versions so a rebuild already running is not swapped in.
+func (l *leaderboardCache) Invalidate(ctx context.Context, seasonID *string, boards ...string) {
+	if seasonID == nil {
+		return
+	}
+	keys := make([]string, len(boards))
+	pipe := l.Redis.TxPipeline()
+	for i, board := range boards {
+		keys[i] = leaderboardKey(*seasonID, board)
+		versionKey := leaderboardVersionKey(*seasonID, board)
+		pipe.Incr(ctx, versionKey)
+		pipe.Expire(ctx, versionKey, leaderboardTTL)
+	}
+	pipe.Del(ctx, keys...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		l.Log.Errorf("Failed to invalidate leaderboards %v: %v", keys, err)
+	}
+}
+
+// Rebuild stores the members returned by find as the board. A write racing
+// with the rebuild discards it, and find is called again.
+func (l *leaderboardCache) Rebuild(ctx context.Context, seasonID, board string, find func() ([]redis.Z, error)) error {
+	key := leaderboardKey(seasonID, board)
+	versionKey := leaderboardVersionKey(seasonID, board)
+	tmp := key + ":rebuild"
+	for attempt := 0; attempt < leaderboardRebuildAttempts; attempt++ {
+		version, err := l.Redis.Get(ctx, versionKey).Result()
+		if err == redis.Nil {
+			version = "0"
+		} else if err != nil {
+			l.Log.Errorf("Failed to read leaderboard version %s: %v", versionKey, err)
+			return err
+		}
+
+		members, err := find()
+		if err != nil {
+			return err
+		}
+
+		pipe := l.Redis.TxPipeline()
+		pipe.Del(ctx, tmp)
+		if len(members) > 0 {
+			pipe.ZAdd(ctx, tmp, members...)
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			l.Log.Errorf("Failed to store leaderboard %s: %v", key, err)
+			return err
+		}
+
+		swapped, err := leaderboardSwapScript.Run(ctx, l.Redis, []string{key, tmp, versionKey}, version, int64(leaderboardTTL.Seconds())).Int()
+		if err != nil {
+			l.Log.Errorf("Failed to store leaderboard %s: %v", key, err)
+			return err
+		}
+		if swapped == 1 {
+			return nil
+		}
+		l.Log.Infof("Leaderboard %s changed while rebuilding, retrying", key)
+	}
+	return fmt.Errorf("leaderboard %s kept changing while rebuilding", key)
+}
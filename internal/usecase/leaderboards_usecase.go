@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type LeaderboardsUseCase interface {
+	Find(ctx context.Context, request *model.LeaderboardRequest) (*model.LeaderboardResponse, *model.Pagination, error)
+	Export(ctx context.Context, request *model.LeaderboardExportRequest, w common.ExportWriter) error
+}
+
+type leaderboardsUseCaseImpl struct {
+	LeaderboardsRepo repository.LeaderboardsRepository
+	SeasonsRepo      repository.SeasonsRepository
+	Leaderboards     *leaderboardCache
+	DB               *gorm.DB
+	Log              *logrus.Logger
+}
+
+func NewLeaderboardsUseCase(leaderboardsRepo repository.LeaderboardsRepository, seasonsRepo repository.SeasonsRepository, redisClient *redis.Client, db *gorm.DB, log *logrus.Logger) LeaderboardsUseCase {
+	return &leaderboardsUseCaseImpl{
+		LeaderboardsRepo: leaderboardsRepo,
+		SeasonsRepo:      seasonsRepo,
+		Leaderboards:     newLeaderboardCache(redisClient, log),
+		DB:               db,
+		Log:              log,
+	}
+}
+
+// rebuild recomputes the leaderboard from the database and swaps it in.
+func (l *leaderboardsUseCaseImpl) rebuild(ctx context.Context, tx *gorm.DB, seasonID, board string) error {
+	return l.Leaderboards.Rebuild(ctx, seasonID, board, func() ([]redis.Z, error) {
+		scores, err := l.LeaderboardsRepo.FindScores(tx, seasonID, board)
+		if err != nil {
+			return nil, err
+		}
+		members := make([]redis.Z, len(scores))
+		for i, score := range scores {
+			members[i] = redis.Z{Score: float64(score.Score), Member: score.PlayerID}
+		}
+		return members, nil
+	})
+}
+
+// load makes sure the season's board is in Redis and returns its key.
+func (l *leaderboardsUseCaseImpl) load(ctx context.Context, tx *gorm.DB, seasonID, board string) (string, error) {
+	key := leaderboardKey(seasonID, board)
+	exists, err := l.Leaderboards.Redis.Exists(ctx, key).Result()
+	if err != nil {
+		l.Log.Errorf("Failed to check leaderboard %s: %v", key, err)
+		return "", common.ErrInternalServer("Failed to read leaderboard")
+	}
+	if exists == 0 {
+		if err := l.rebuild(ctx, tx, seasonID, board); err != nil {
+			return "", common.ErrInternalServer("Failed to build leaderboard")
+		}
+	}
+	return key, nil
+}
+
+// entries reads the players ranked start to stop, counting from zero, on the
+// board at key. ranks caches the rank of each value across calls.
+func (l *leaderboardsUseCaseImpl) entries(ctx context.Context, tx *gorm.DB, key string, start, stop int64, ranks map[int64]int64) ([]model.LeaderboardEntry, error) {
+	scores, err := l.Leaderboards.Redis.ZRevRangeWithScores(ctx, key, start, stop).Result()
+	if err != nil {
+		return nil, common.ErrInternalServer("Failed to read leaderboard")
+	}
+
+	// players on the same value share the rank after everyone ahead of them
+	var playerIDs []string
+	for _, score := range scores {
+		value := int64(score.Score)
+		playerIDs = append(playerIDs, score.Member.(string))
+		if _, ok := ranks[value]; ok {
+			continue
+		}
+		ahead, err := l.Leaderboards.Redis.ZCount(ctx, key, fmt.Sprintf("(%d", value), "+inf").Result()
+		if err != nil {
+			return nil, common.ErrInternalServer("Failed to read leaderboard")
+		}
+		ranks[value] = ahead + 1
+	}
+
+	players := map[string]entity.Player{}
+	if len(playerIDs) > 0 {
+		found, err := l.LeaderboardsRepo.FindPlayers(tx, playerIDs)
+		if err != nil {
+			return nil, common.ErrInternalServer("Failed to find leaderboard players")
+		}
+		for _, player := range found {
+			players[player.ID] = player
+		}
+	}
+
+	entries := []model.LeaderboardEntry{}
+	for _, score := range scores {
+		value := int64(score.Score)
+		player := players[score.Member.(string)]
+		entry := model.LeaderboardEntry{
+			Rank:       ranks[value],
+			PlayerID:   score.Member.(string),
+			PlayerName: player.Name,
+			TeamID:     player.TeamID,
+			Value:      value,
+		}
+		if player.Team != nil {
+			entry.TeamName = &player.Team.Name
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (l *leaderboardsUseCaseImpl) Find(ctx context.Context, request *model.LeaderboardRequest) (*model.LeaderboardResponse, *model.Pagination, error) {
+	tx := l.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		l.Log.Warnf("Invalid request body: %+v", err)
+		return nil, nil, err
+	}
+
+	season, err := l.SeasonsRepo.FindByID(tx, request.SeasonID)
+	if err != nil {
+		l.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+		tx.Rollback()
+		return nil, nil, common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+	}
+
+	key, err := l.load(ctx, tx, season.ID, request.Board)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+
+	total, err := l.Leaderboards.Redis.ZCard(ctx, key).Result()
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, common.ErrInternalServer("Failed to read leaderboard")
+	}
+
+	start := int64((request.Page - 1) * request.Limit)
+	entries, err := l.entries(ctx, tx, key, start, start+int64(request.Limit)-1, map[int64]int64{})
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		l.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	pagination := &model.Pagination{
+		Page:       request.Page,
+		Limit:      request.Limit,
+		Total:      total,
+		TotalPages: (total + int64(request.Limit) - 1) / int64(request.Limit),
+	}
+
+	return &model.LeaderboardResponse{
+		SeasonID: season.ID,
+		Board:    request.Board,
+		Entries:  entries,
+	}, pagination, nil
+}
+
+// Export streams the whole board to w, exportBatchSize players at a time.
+func (l *leaderboardsUseCaseImpl) Export(ctx context.Context, request *model.LeaderboardExportRequest, w common.ExportWriter) error {
+	tx := l.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		l.Log.Warnf("Invalid request body: %+v", err)
+		return err
+	}
+
+	season, err := l.SeasonsRepo.FindByID(tx, request.SeasonID)
+	if err != nil {
+		l.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+		tx.Rollback()
+		return common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+	}
+
+	key, err := l.load(ctx, tx, season.ID, request.Board)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := w.Write(converter.LeaderboardExportHeader...); err != nil {
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to write leaderboard export")
+	}
+	ranks := map[int64]int64{}
+	for start := int64(0); ; start += exportBatchSize {
+		entries, err := l.entries(ctx, tx, key, start, start+exportBatchSize-1, ranks)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		for _, entry := range entries {
+			if err := w.Write(converter.ToLeaderboardExportRow(&entry)...); err != nil {
+				tx.Rollback()
+				return common.ErrInternalServer("Failed to write leaderboard export")
+			}
+		}
+		if len(entries) < exportBatchSize {
+			break
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		l.Log.Errorf("Failed to commit transaction: %v", err)
+		return common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return nil
+}
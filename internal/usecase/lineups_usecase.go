@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type LineupsUseCase interface {
+	FindByMatchID(ctx context.Context, request *model.LineupRequestFindByMatchID) ([]model.LineupResponse, error)
+	Submit(ctx context.Context, request *model.LineupRequestSubmit) ([]model.LineupResponse, error)
+}
+
+type lineupsUseCaseImpl struct {
+	LineupsRepo     repository.LineupsRepository
+	MatchesRepo     repository.MatchesRepository
+	PlayersRepo     repository.PlayersRepository
+	InjuriesRepo    repository.InjuriesRepository
+	SuspensionsRepo repository.SuspensionsRepository
+	LogsProducer    *messaging.LogProducer
+	DB              *gorm.DB
+	Log             *logrus.Logger
+}
+
+func NewLineupsUseCase(lineupsRepo repository.LineupsRepository, matchesRepo repository.MatchesRepository, playersRepo repository.PlayersRepository, injuriesRepo repository.InjuriesRepository, suspensionsRepo repository.SuspensionsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) LineupsUseCase {
+	return &lineupsUseCaseImpl{
+		LineupsRepo:     lineupsRepo,
+		MatchesRepo:     matchesRepo,
+		PlayersRepo:     playersRepo,
+		InjuriesRepo:    injuriesRepo,
+		SuspensionsRepo: suspensionsRepo,
+		LogsProducer:    logsProducer,
+		DB:              db,
+		Log:             log,
+	}
+}
+
+func (l *lineupsUseCaseImpl) FindByMatchID(ctx context.Context, request *model.LineupRequestFindByMatchID) ([]model.LineupResponse, error) {
+	tx := l.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		l.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := l.MatchesRepo.FindByID(tx, request.MatchID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.MatchID)
+	}
+
+	lineups, err := l.LineupsRepo.FindByMatchID(tx, request.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find lineups")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		l.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.LineupResponse{}
+	for _, lineup := range lineups {
+		responses = append(responses, *converter.ToLineupResponse(&lineup))
+	}
+
+	return responses, nil
+}
+
+func (l *lineupsUseCaseImpl) Submit(ctx context.Context, request *model.LineupRequestSubmit) ([]model.LineupResponse, error) {
+	tx := l.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		l.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	match, err := l.MatchesRepo.FindByIDWithRelations(tx, request.MatchID, "Competition")
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.MatchID)
+	}
+
+	if request.TeamID != match.HomeTeamID && request.TeamID != match.AwayTeamID {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Team does not play in this match").WithDetail("team_id", request.TeamID)
+	}
+
+	var lineups []*entity.MatchLineup
+	var ineligible []common.ErrorDetail
+	var unavailable []common.ErrorDetail
+	seen := map[string]bool{}
+	for _, item := range request.Players {
+		if seen[item.PlayerID] {
+			tx.Rollback()
+			return nil, common.ErrInvalidInput("Player is listed more than once").WithDetail("player_id", item.PlayerID)
+		}
+		seen[item.PlayerID] = true
+
+		player, err := l.PlayersRepo.FindByID(tx, item.PlayerID)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Player not found").WithDetail("player_id", item.PlayerID)
+		}
+		if player.TeamID != request.TeamID {
+			tx.Rollback()
+			return nil, common.ErrInvalidInput("Player does not belong to the team").WithDetail("player_id", item.PlayerID)
+		}
+
+		// check the competition's age-category rule
+		if eligible, reason := checkPlayerEligibility(match.Competition, player); !eligible {
+			ineligible = append(ineligible, common.ErrorDetail{Field: player.ID, Message: fmt.Sprintf("%s: %s", player.Name, reason)})
+			continue
+		}
+
+		// check injuries and suspensions on the match date
+		reasons, _, _, err := checkPlayerAvailability(tx, l.InjuriesRepo, l.SuspensionsRepo, player.ID, match.MatchDate, match.CompetitionID)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to check player availability")
+		}
+		for _, reason := range reasons {
+			unavailable = append(unavailable, common.ErrorDetail{Field: player.ID, Message: fmt.Sprintf("%s: %s", player.Name, reason)})
+		}
+		if len(reasons) > 0 {
+			continue
+		}
+
+		lineups = append(lineups, &entity.MatchLineup{
+			ID:            uuid.New().String(),
+			MatchID:       match.ID,
+			TeamID:        request.TeamID,
+			PlayerID:      player.ID,
+			IsStarter:     item.IsStarter,
+			MinutesPlayed: item.MinutesPlayed,
+		})
+	}
+
+	if len(ineligible) > 0 {
+		tx.Rollback()
+		l.Log.Warnf("Lineup for match %s contains %d ineligible players", match.ID, len(ineligible))
+		return nil, common.ErrInvalidInput("Lineup contains players who are not eligible for this competition").WithDetails(ineligible)
+	}
+
+	if len(unavailable) > 0 {
+		tx.Rollback()
+		l.Log.Warnf("Lineup for match %s contains %d unavailable players", match.ID, len(unavailable))
+		return nil, common.ErrInvalidInput("Lineup contains injured or suspended players").WithDetails(unavailable)
+	}
+
+	// a new submission replaces the team's previous lineup
+	if err := l.LineupsRepo.SoftDeleteByMatchIDAndTeamID(tx, match.ID, request.TeamID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to replace previous lineup")
+	}
+
+	for _, lineup := range lineups {
+		if err := l.LineupsRepo.Create(tx, lineup); err != nil {
+			tx.Rollback()
+			l.Log.Errorf("Failed to create lineup entry: %v", err)
+			return nil, common.ErrInternalServer("Failed to create lineup")
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		l.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Lineup for team %s in match %s submitted successfully", request.TeamID, match.ID),
+		Service: "lineups",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	l.Log.Infof("Sending log event: %+v", logEvent)
+	if err := l.LogsProducer.Send(logEvent); err != nil {
+		l.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	responses := []model.LineupResponse{}
+	for _, lineup := range lineups {
+		responses = append(responses, *converter.ToLineupResponse(lineup))
+	}
+
+	return responses, nil
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/Fadlihardiyanto/football-api/internal/common"
@@ -12,36 +13,247 @@ import (
 	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
 	"github.com/Fadlihardiyanto/football-api/internal/repository"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 type MatchesUseCase interface {
 	FindAll(ctx context.Context) ([]model.MatchResponse, error)
+	Export(ctx context.Context, w common.ExportWriter) error
 	FindByID(ctx context.Context, request *model.MatchRequestFindByID) (*model.MatchResponse, error)
 	Create(ctx context.Context, request *model.MatchRequestCreate) (*model.MatchResponse, error)
 	Update(ctx context.Context, request *model.MatchRequestUpdate) (*model.MatchResponse, error)
 	SoftDelete(ctx context.Context, request *model.MatchRequestSoftDelete) (*model.MatchResponse, error)
 	GetMatchReport(ctx context.Context, request *model.MatchRequestFindByID) (*model.MatchReportResponse, error)
 	FinishMatch(ctx context.Context, request *model.MatchRequestFinish) (*model.MatchResponse, error)
+	GenerateFixtures(ctx context.Context, request *model.FixtureRequestGenerate) ([]model.MatchResponse, error)
+	Postpone(ctx context.Context, request *model.MatchRequestPostpone) (*model.MatchResponse, error)
+	Reschedule(ctx context.Context, request *model.MatchRequestReschedule) (*model.MatchResponse, error)
+	FindReschedules(ctx context.Context, request *model.MatchRequestFindByID) ([]model.MatchRescheduleResponse, error)
 }
 
 type matchesUseCaseImpl struct {
-	MatchesRepo  repository.MatchesRepository
-	LogsProducer *messaging.LogProducer
-	DB           *gorm.DB
-	Log          *logrus.Logger
+	MatchesRepo           repository.MatchesRepository
+	CompetitionsRepo      repository.CompetitionsRepository
+	CardsRepo             repository.CardsRepository
+	LineupsRepo           repository.LineupsRepository
+	SuspensionsRepo       repository.SuspensionsRepository
+	DisciplinaryRulesRepo repository.DisciplinaryRulesRepository
+	StaffRepo             repository.StaffRepository
+	VenuesRepo            repository.VenuesRepository
+	TeamsRepo             repository.TeamsRepository
+	OfficialsRepo         repository.OfficialsRepository
+	SchedulingRepo        repository.SchedulingRepository
+	LeaderboardsRepo      repository.LeaderboardsRepository
+	Leaderboards          *leaderboardCache
+	RatingsRepo           repository.RatingsRepository
+	RecordsRepo           repository.RecordsRepository
+	SchedulingConfig      *model.SchedulingConfig
+	RatingConfig          *model.RatingConfig
+	LogsProducer          *messaging.LogProducer
+	MatchEventProducer    *messaging.MatchEventProducer
+	RecordEventProducer   *messaging.RecordEventProducer
+	DB                    *gorm.DB
+	Log                   *logrus.Logger
 }
 
-func NewMatchesUseCase(matchesRepo repository.MatchesRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) MatchesUseCase {
+func NewMatchesUseCase(matchesRepo repository.MatchesRepository, competitionsRepo repository.CompetitionsRepository, cardsRepo repository.CardsRepository, lineupsRepo repository.LineupsRepository, suspensionsRepo repository.SuspensionsRepository, disciplinaryRulesRepo repository.DisciplinaryRulesRepository, staffRepo repository.StaffRepository, venuesRepo repository.VenuesRepository, teamsRepo repository.TeamsRepository, officialsRepo repository.OfficialsRepository, schedulingRepo repository.SchedulingRepository, leaderboardsRepo repository.LeaderboardsRepository, redisClient *redis.Client, ratingsRepo repository.RatingsRepository, recordsRepo repository.RecordsRepository, schedulingConfig *model.SchedulingConfig, ratingConfig *model.RatingConfig, logsProducer *messaging.LogProducer, matchEventProducer *messaging.MatchEventProducer, recordEventProducer *messaging.RecordEventProducer, db *gorm.DB, log *logrus.Logger) MatchesUseCase {
 	return &matchesUseCaseImpl{
-		MatchesRepo:  matchesRepo,
-		LogsProducer: logsProducer,
-		DB:           db,
-		Log:          log,
+		MatchesRepo:           matchesRepo,
+		CompetitionsRepo:      competitionsRepo,
+		CardsRepo:             cardsRepo,
+		LineupsRepo:           lineupsRepo,
+		SuspensionsRepo:       suspensionsRepo,
+		DisciplinaryRulesRepo: disciplinaryRulesRepo,
+		StaffRepo:             staffRepo,
+		VenuesRepo:            venuesRepo,
+		TeamsRepo:             teamsRepo,
+		OfficialsRepo:         officialsRepo,
+		SchedulingRepo:        schedulingRepo,
+		LeaderboardsRepo:      leaderboardsRepo,
+		Leaderboards:          newLeaderboardCache(redisClient, log),
+		RatingsRepo:           ratingsRepo,
+		RecordsRepo:           recordsRepo,
+		SchedulingConfig:      schedulingConfig,
+		RatingConfig:          ratingConfig,
+		LogsProducer:          logsProducer,
+		MatchEventProducer:    matchEventProducer,
+		RecordEventProducer:   recordEventProducer,
+		DB:                    db,
+		Log:                   log,
 	}
 }
 
+// checkCompetition makes sure the competition exists and both teams are registered in it.
+func (m *matchesUseCaseImpl) checkCompetition(tx *gorm.DB, match *entity.Match) error {
+	if match.CompetitionID == nil {
+		return nil
+	}
+
+	if _, err := m.CompetitionsRepo.FindByID(tx, *match.CompetitionID); err != nil {
+		return common.ErrNotFound("Competition not found").WithDetail("competition_id", *match.CompetitionID)
+	}
+
+	for _, teamID := range []string{match.HomeTeamID, match.AwayTeamID} {
+		registered, err := m.CompetitionsRepo.CheckTeamRegistered(tx, *match.CompetitionID, teamID)
+		if err != nil {
+			return common.ErrInternalServer("Failed to check team registration")
+		}
+		if !registered {
+			return common.ErrInvalidInput("Team is not registered in the competition").WithDetail("team_id", teamID)
+		}
+	}
+
+	return nil
+}
+
+// setKickoff stores the kickoff as an instant together with the timezone of
+// the venue the match is played at; matches without a venue use the default
+// timezone. The match date and time are read in loc when the client asked for
+// a timezone, otherwise in the venue's, and are then stored as the venue's
+// local date and time of the kickoff.
+func (m *matchesUseCaseImpl) setKickoff(tx *gorm.DB, match *entity.Match, loc *time.Location) error {
+	timezone := m.SchedulingConfig.DefaultTimezone
+	venueID, err := matchVenueID(tx, m.TeamsRepo, match)
+	if err != nil {
+		return common.ErrNotFound("Home team not found").WithDetail("home_team_id", match.HomeTeamID)
+	}
+	if venueID != nil {
+		venue, err := m.VenuesRepo.FindByID(tx, *venueID)
+		if err != nil {
+			return common.ErrNotFound("Venue not found").WithDetail("venue_id", *venueID)
+		}
+		timezone = venue.Timezone
+	}
+
+	venueLoc, err := time.LoadLocation(timezone)
+	if err != nil {
+		m.Log.Errorf("Failed to load timezone %s: %v", timezone, err)
+		return common.ErrInternalServer("Invalid venue timezone").WithDetail("timezone", timezone)
+	}
+	if loc == nil {
+		loc = venueLoc
+	}
+
+	kickoff, err := common.ParseMatchTime(match.MatchTime)
+	if err != nil {
+		return common.ErrInvalidInput("Invalid match time").WithDetail("match_time", match.MatchTime)
+	}
+
+	match.Timezone = timezone
+	match.KickoffAt = time.Date(match.MatchDate.Year(), match.MatchDate.Month(), match.MatchDate.Day(), kickoff.Hour(), kickoff.Minute(), kickoff.Second(), 0, loc)
+	match.MatchDate, match.MatchTime = localMatchDateTime(match.KickoffAt, venueLoc)
+	return nil
+}
+
+// localMatchDateTime splits a kickoff into the date and time columns, which
+// hold the kickoff's wall clock in loc.
+func localMatchDateTime(kickoffAt time.Time, loc *time.Location) (time.Time, string) {
+	local := kickoffAt.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC), local.Format("15:04:05")
+}
+
+// checkVenue makes sure the venue override exists.
+func (m *matchesUseCaseImpl) checkVenue(tx *gorm.DB, match *entity.Match) error {
+	if match.VenueID == nil {
+		return nil
+	}
+
+	if _, err := m.VenuesRepo.FindByID(tx, *match.VenueID); err != nil {
+		return common.ErrNotFound("Venue not found").WithDetail("venue_id", *match.VenueID)
+	}
+
+	return nil
+}
+
+// checkSchedule rejects a scheduled match that breaks the scheduling constraints
+// of its competition.
+func (m *matchesUseCaseImpl) checkSchedule(tx *gorm.DB, match *entity.Match) error {
+	violations, err := findSchedulingViolations(tx, m.SchedulingRepo, m.MatchesRepo, m.TeamsRepo, m.SchedulingConfig, match)
+	if err != nil {
+		return common.ErrInternalServer("Failed to check scheduling constraints")
+	}
+	if len(violations) > 0 {
+		return schedulingConflictError(violations)
+	}
+	return nil
+}
+
+// roundRobinRounds pairs every team with every other team once using the circle
+// method, returning the home and away team of each pairing per round. With an
+// odd number of teams one team sits out each round. A double round robin adds
+// the reverse fixtures as a second leg.
+func roundRobinRounds(teamIDs []string, doubleRoundRobin bool) [][][2]string {
+	circle := append([]string{}, teamIDs...)
+	if len(circle)%2 == 1 {
+		circle = append(circle, "")
+	}
+	n := len(circle)
+
+	var rounds [][][2]string
+	for r := 0; r < n-1; r++ {
+		var pairs [][2]string
+		for i := 0; i < n/2; i++ {
+			home, away := circle[i], circle[n-1-i]
+			if home == "" || away == "" {
+				continue
+			}
+			// alternate the fixed team between home and away
+			if i == 0 && r%2 == 1 {
+				home, away = away, home
+			}
+			pairs = append(pairs, [2]string{home, away})
+		}
+		rounds = append(rounds, pairs)
+
+		// keep the first team fixed and rotate the others
+		circle = append([]string{circle[0], circle[n-1]}, circle[1:n-1]...)
+	}
+
+	if doubleRoundRobin {
+		legs := len(rounds)
+		for r := 0; r < legs; r++ {
+			var pairs [][2]string
+			for _, pair := range rounds[r] {
+				pairs = append(pairs, [2]string{pair[1], pair[0]})
+			}
+			rounds = append(rounds, pairs)
+		}
+	}
+
+	return rounds
+}
+
+// checkCompletedMatchUpdate rejects a change to the status, score or teams of
+// a completed match. Finishing the match issued suspensions, ratings and
+// records from its result, and those would no longer match it.
+func checkCompletedMatchUpdate(match *entity.Match, request *model.MatchRequestUpdate) error {
+	if match.Status != "completed" {
+		return nil
+	}
+
+	changed := func(value *int, current *int) bool {
+		return value != nil && (current == nil || *value != *current)
+	}
+	var field string
+	switch {
+	case request.Status != "" && request.Status != match.Status:
+		field = "status"
+	case request.HomeTeamID != "" && request.HomeTeamID != match.HomeTeamID:
+		field = "home_team_id"
+	case request.AwayTeamID != "" && request.AwayTeamID != match.AwayTeamID:
+		field = "away_team_id"
+	case changed(request.HomeScore, match.HomeScore):
+		field = "home_score"
+	case changed(request.AwayScore, match.AwayScore):
+		field = "away_score"
+	default:
+		return nil
+	}
+	return common.ErrInvalidInput("Match is completed").WithDetail(field, "cannot be changed once the match is completed")
+}
+
 func (m *matchesUseCaseImpl) FindAll(ctx context.Context) ([]model.MatchResponse, error) {
 	tx := m.DB.WithContext(ctx).Begin()
 	defer func() {
@@ -64,12 +276,48 @@ func (m *matchesUseCaseImpl) FindAll(ctx context.Context) ([]model.MatchResponse
 
 	var responses []model.MatchResponse
 	for _, match := range matches {
-		responses = append(responses, *converter.ToMatchResponse(&match))
+		responses = append(responses, *converter.ToMatchResponse(&match, common.LocationFromContext(ctx)))
 	}
 
 	return responses, nil
 }
 
+// Export streams every match to w, reading exportBatchSize rows at a time.
+func (m *matchesUseCaseImpl) Export(ctx context.Context, w common.ExportWriter) error {
+	tx := m.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	loc := common.LocationFromContext(ctx)
+	if err := w.Write(converter.MatchExportHeader...); err != nil {
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to write matches export")
+	}
+	err := m.MatchesRepo.FindAllInBatches(tx, exportBatchSize, func(batch []entity.Match) error {
+		for _, match := range batch {
+			if err := w.Write(converter.ToMatchExportRow(converter.ToMatchResponse(&match, loc))...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, "HomeTeam", "AwayTeam")
+	if err != nil {
+		m.Log.Errorf("Failed to export matches: %v", err)
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to export matches")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		m.Log.Errorf("Failed to commit transaction: %v", err)
+		return common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return nil
+}
+
 func (m *matchesUseCaseImpl) FindByID(ctx context.Context, request *model.MatchRequestFindByID) (*model.MatchResponse, error) {
 	tx := m.DB.WithContext(ctx).Begin()
 	defer func() {
@@ -90,12 +338,18 @@ func (m *matchesUseCaseImpl) FindByID(ctx context.Context, request *model.MatchR
 		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
 	}
 
+	match.Reschedules, err = m.MatchesRepo.FindReschedulesByMatchID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find match reschedules")
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		m.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
 
-	return converter.ToMatchResponse(match), nil
+	return converter.ToMatchResponse(match, common.LocationFromContext(ctx)), nil
 }
 
 func (m *matchesUseCaseImpl) Create(ctx context.Context, request *model.MatchRequestCreate) (*model.MatchResponse, error) {
@@ -120,6 +374,14 @@ func (m *matchesUseCaseImpl) Create(ctx context.Context, request *model.MatchReq
 		HomeScore:  request.HomeScore,
 		AwayScore:  request.AwayScore,
 		Status:     request.Status,
+		Matchday:   request.Matchday,
+		Attendance: request.Attendance,
+	}
+	if request.CompetitionID != "" {
+		match.CompetitionID = &request.CompetitionID
+	}
+	if request.VenueID != "" {
+		match.VenueID = &request.VenueID
 	}
 
 	// check if home team and away team are the same
@@ -129,13 +391,33 @@ func (m *matchesUseCaseImpl) Create(ctx context.Context, request *model.MatchReq
 		return nil, common.ErrInvalidInput("Home team and away team cannot be the same").WithDetail("home_team_id", match.HomeTeamID)
 	}
 
+	if err := m.checkCompetition(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := m.checkVenue(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := m.setKickoff(tx, match, common.LocationFromContext(ctx)); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	// check if match date is in the past
-	if match.MatchDate.Before(time.Now()) {
+	if match.KickoffAt.Before(time.Now()) {
 		tx.Rollback()
-		m.Log.Warnf("Match date cannot be in the past: %s", match.MatchDate)
+		m.Log.Warnf("Match date cannot be in the past: %s", match.KickoffAt)
 		return nil, common.ErrInvalidInput("Match date cannot be in the past").WithDetail("match_date", fmt.Sprintf("%s %s", match.MatchDate.Format("2006-01-02"), match.MatchTime))
 	}
 
+	if err := m.checkSchedule(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	m.Log.Infof("Creating match: %+v", match)
 
 	if err := m.MatchesRepo.Create(tx, match); err != nil {
@@ -162,7 +444,7 @@ func (m *matchesUseCaseImpl) Create(ctx context.Context, request *model.MatchReq
 		return nil, common.ErrInternalServer("Failed to send log event")
 	}
 
-	return converter.ToMatchResponse(match), nil
+	return converter.ToMatchResponse(match, common.LocationFromContext(ctx)), nil
 }
 
 func (m *matchesUseCaseImpl) Update(ctx context.Context, request *model.MatchRequestUpdate) (*model.MatchResponse, error) {
@@ -185,6 +467,12 @@ func (m *matchesUseCaseImpl) Update(ctx context.Context, request *model.MatchReq
 		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
 	}
 
+	if err := checkCompletedMatchUpdate(match, request); err != nil {
+		tx.Rollback()
+		m.Log.Warnf("Match with ID %s is completed", request.ID)
+		return nil, err
+	}
+
 	// check if home team and away team are the same
 	if match.HomeTeamID == match.AwayTeamID {
 		tx.Rollback()
@@ -192,6 +480,22 @@ func (m *matchesUseCaseImpl) Update(ctx context.Context, request *model.MatchReq
 		return nil, common.ErrInvalidInput("Home team and away team cannot be the same").WithDetail("home_team_id", match.HomeTeamID)
 	}
 
+	originalDate, originalTime := match.MatchDate, match.MatchTime
+	originalKickoff := match.KickoffAt
+
+	// a date or time given without the other is combined with the current
+	// kickoff as seen in the requested timezone
+	loc := common.LocationFromContext(ctx)
+	if loc != nil {
+		match.MatchDate, match.MatchTime = localMatchDateTime(match.KickoffAt, loc)
+	}
+
+	oldSeasonID, err := m.LeaderboardsRepo.FindMatchSeasonID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
 	if request.HomeTeamID != "" {
 		match.HomeTeamID = request.HomeTeamID
 	}
@@ -213,6 +517,38 @@ func (m *matchesUseCaseImpl) Update(ctx context.Context, request *model.MatchReq
 	if request.Status != "" {
 		match.Status = request.Status
 	}
+	if request.CompetitionID != "" {
+		match.CompetitionID = &request.CompetitionID
+	}
+	if request.Matchday != nil {
+		match.Matchday = request.Matchday
+	}
+	if request.VenueID != "" {
+		match.VenueID = &request.VenueID
+	}
+	if request.Attendance != nil {
+		match.Attendance = request.Attendance
+	}
+
+	if err := m.checkCompetition(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := m.checkVenue(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := m.setKickoff(tx, match, loc); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := m.checkSchedule(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
 	if err := m.MatchesRepo.Update(tx, match); err != nil {
 		tx.Rollback()
@@ -220,11 +556,42 @@ func (m *matchesUseCaseImpl) Update(ctx context.Context, request *model.MatchReq
 		return nil, common.ErrInternalServer("Failed to update match")
 	}
 
+	// keep the history when a scheduled kickoff is edited in place
+	var reschedule *entity.MatchReschedule
+	if match.Status == "scheduled" && !match.KickoffAt.Equal(originalKickoff) {
+		now := time.Now()
+		newDate, newTime := match.MatchDate, match.MatchTime
+		reschedule = &entity.MatchReschedule{
+			ID:            uuid.New().String(),
+			MatchID:       match.ID,
+			Reason:        "Kickoff changed by match update",
+			OriginalDate:  originalDate,
+			OriginalTime:  originalTime,
+			NewDate:       &newDate,
+			NewTime:       &newTime,
+			RescheduledAt: &now,
+		}
+		if err := m.MatchesRepo.SaveReschedule(tx, reschedule); err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to record match reschedule")
+		}
+	}
+
+	newSeasonID, err := m.LeaderboardsRepo.FindMatchSeasonID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		m.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
 
+	// the competition, status or score may have moved, so rebuild both seasons
+	m.Leaderboards.Invalidate(ctx, oldSeasonID, repository.LeaderboardBoards...)
+	m.Leaderboards.Invalidate(ctx, newSeasonID, repository.LeaderboardBoards...)
+
 	m.Log.Infof("Match updated successfully: %+v", match)
 	event := &model.LogEvent{
 		Level:   "info",
@@ -237,7 +604,13 @@ func (m *matchesUseCaseImpl) Update(ctx context.Context, request *model.MatchReq
 		return nil, common.ErrInternalServer("Failed to send log event")
 	}
 
-	return converter.ToMatchResponse(match), nil
+	if reschedule != nil {
+		if err := m.publishMatchEvent("match.rescheduled", match, reschedule); err != nil {
+			return nil, common.ErrInternalServer("Failed to send match event")
+		}
+	}
+
+	return converter.ToMatchResponse(match, common.LocationFromContext(ctx)), nil
 }
 
 func (m *matchesUseCaseImpl) SoftDelete(ctx context.Context, request *model.MatchRequestSoftDelete) (*model.MatchResponse, error) {
@@ -260,6 +633,12 @@ func (m *matchesUseCaseImpl) SoftDelete(ctx context.Context, request *model.Matc
 		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
 	}
 
+	seasonID, err := m.LeaderboardsRepo.FindMatchSeasonID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+
 	if err := m.MatchesRepo.SoftDelete(tx, match.ID); err != nil {
 		tx.Rollback()
 		m.Log.Errorf("Failed to soft delete match: %v", err)
@@ -270,6 +649,7 @@ func (m *matchesUseCaseImpl) SoftDelete(ctx context.Context, request *model.Matc
 		m.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
+	m.Leaderboards.Invalidate(ctx, seasonID, repository.LeaderboardBoards...)
 	m.Log.Infof("Match with ID %s soft deleted successfully", match.ID)
 	event := &model.LogEvent{
 		Level:   "info",
@@ -282,7 +662,7 @@ func (m *matchesUseCaseImpl) SoftDelete(ctx context.Context, request *model.Matc
 		return nil, common.ErrInternalServer("Failed to send log event")
 	}
 
-	return converter.ToMatchResponse(match), nil
+	return converter.ToMatchResponse(match, common.LocationFromContext(ctx)), nil
 }
 
 func (m *matchesUseCaseImpl) GetMatchReport(ctx context.Context, request *model.MatchRequestFindByID) (*model.MatchReportResponse, error) {
@@ -310,18 +690,64 @@ func (m *matchesUseCaseImpl) GetMatchReport(ctx context.Context, request *model.
 		return nil, common.ErrInternalServer("Failed to get goals for match")
 	}
 
-	pastMatches, err := m.MatchesRepo.FindAllBeforeDate(tx, match.MatchDate)
+	headToHead, err := m.MatchesRepo.FindHeadToHeadRecords(tx, match.HomeTeamID, match.AwayTeamID, &match.KickoffAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to get head-to-head record")
+	}
+
+	homeCoach, err := m.StaffRepo.FindHeadCoachOnDate(tx, match.HomeTeamID, match.MatchDate)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to get home head coach")
+	}
+
+	awayCoach, err := m.StaffRepo.FindHeadCoachOnDate(tx, match.AwayTeamID, match.MatchDate)
 	if err != nil {
-		m.Log.Errorf("Failed to get past matches: %v", err)
 		tx.Rollback()
-		return nil, common.ErrInternalServer("Failed to get past matches")
+		return nil, common.ErrInternalServer("Failed to get away head coach")
+	}
+
+	officials, err := m.OfficialsRepo.FindAssignmentsByMatchID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to get match officials")
+	}
+
+	lineups, err := m.LineupsRepo.FindByMatchID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to get match lineups")
+	}
+
+	cards, err := m.CardsRepo.FindByMatchID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to get match cards")
+	}
+
+	// form going into the match
+	homeResults, err := m.MatchesRepo.FindCompletedByTeamID(tx, match.HomeTeamID, &match.KickoffAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to get home team form")
+	}
+
+	awayResults, err := m.MatchesRepo.FindCompletedByTeamID(tx, match.AwayTeamID, &match.KickoffAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to get away team form")
 	}
 
 	if err := tx.Commit().Error; err != nil {
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
 
-	return converter.ToMatchReportResponse(match, goals, pastMatches), nil
+	report := converter.ToMatchReportResponse(match, goals, lineups, cards, sumHeadToHead(headToHead), homeCoach, awayCoach, officials, common.LocationFromContext(ctx))
+	report.HomeForm = buildTeamForm(teamResults(match.HomeTeamID, homeResults), defaultFormMatches)
+	report.AwayForm = buildTeamForm(teamResults(match.AwayTeamID, awayResults), defaultFormMatches)
+
+	return report, nil
 }
 
 func (m *matchesUseCaseImpl) FinishMatch(ctx context.Context, request *model.MatchRequestFinish) (*model.MatchResponse, error) {
@@ -342,21 +768,499 @@ func (m *matchesUseCaseImpl) FinishMatch(ctx context.Context, request *model.Mat
 		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
 	}
 
-	if match.Status != "Scheduled" {
+	if match.Status != "scheduled" {
 		tx.Rollback()
 		m.Log.Warnf("Match with ID %s is not scheduled", request.ID)
 		return nil, common.ErrInvalidInput("Match is not scheduled").WithDetail("id", request.ID)
 	}
-	match.Status = "Finished"
+	match.Status = "completed"
+	if request.Attendance != nil {
+		match.Attendance = request.Attendance
+	}
 
 	if err := m.MatchesRepo.Update(tx, match); err != nil {
 		tx.Rollback()
 		m.Log.Errorf("Failed to update match: %v", err)
 		return nil, common.ErrInternalServer("Failed to update match")
 	}
+
+	// serve running suspensions before new ones are issued for this match
+	if err := m.SuspensionsRepo.ServeForMatch(tx, match); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to update suspensions")
+	}
+
+	if err := m.issueCardSuspensions(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := rateMatch(tx, m.RatingsRepo, m.RatingConfig, match); err != nil {
+		m.Log.Errorf("Failed to rate match %s: %v", match.ID, err)
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to update team ratings")
+	}
+
+	records, err := detectMatchRecords(tx, m.MatchesRepo, m.RecordsRepo, match)
+	if err != nil {
+		m.Log.Errorf("Failed to detect records for match %s: %v", match.ID, err)
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to detect records")
+	}
+
+	seasonID, err := m.LeaderboardsRepo.FindMatchSeasonID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season of match")
+	}
+	keepers, err := m.LeaderboardsRepo.FindCleanSheetKeepers(tx, match)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find clean sheets")
+	}
 	if err := tx.Commit().Error; err != nil {
 		m.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
 	}
-	return converter.ToMatchResponse(match), nil
+	for _, keeper := range keepers {
+		m.Leaderboards.Incr(ctx, seasonID, repository.LeaderboardCleanSheets, keeper, 1)
+	}
+	if err := publishRecordEvents(m.RecordEventProducer, m.Log, records); err != nil {
+		return nil, common.ErrInternalServer("Failed to send record event")
+	}
+	return converter.ToMatchResponse(match, common.LocationFromContext(ctx)), nil
+}
+
+// issueCardSuspensions evaluates the competition's disciplinary rules against the
+// cards shown in the match and stores the resulting suspensions.
+func (m *matchesUseCaseImpl) issueCardSuspensions(tx *gorm.DB, match *entity.Match) error {
+	rule, err := findDisciplinaryRule(tx, m.DisciplinaryRulesRepo, match.CompetitionID)
+	if err != nil {
+		return common.ErrInternalServer("Failed to find disciplinary rules")
+	}
+
+	cards, err := m.CardsRepo.FindByMatchID(tx, match.ID)
+	if err != nil {
+		return common.ErrInternalServer("Failed to find cards for match")
+	}
+
+	afterMatchday, uptoMatchday := yellowCardWindow(rule, match)
+	yellowTotals := map[string]int64{}
+	for _, card := range cards {
+		if card.CardType != "yellow" {
+			continue
+		}
+		if _, ok := yellowTotals[card.PlayerID]; ok {
+			continue
+		}
+		total, err := m.CardsRepo.CountAccumulatedYellows(tx, card.PlayerID, match.CompetitionID, afterMatchday, uptoMatchday)
+		if err != nil {
+			return common.ErrInternalServer("Failed to count yellow cards")
+		}
+		yellowTotals[card.PlayerID] = total
+	}
+
+	for _, suspension := range evaluateCardSuspensions(rule, match, cards, yellowTotals) {
+		if err := m.SuspensionsRepo.Create(tx, suspension); err != nil {
+			m.Log.Errorf("Failed to create suspension for player %s: %v", suspension.PlayerID, err)
+			return common.ErrInternalServer("Failed to create suspension")
+		}
+		m.Log.Infof("Player %s suspended for %d match(es): %s", suspension.PlayerID, suspension.MatchesRemaining, suspension.Reason)
+	}
+
+	return nil
+}
+
+// GenerateFixtures creates a round-robin schedule for the competition's teams,
+// one round every days_between_rounds days. A round that falls on a blackout
+// date moves to the next free day. Nothing is created if any fixture breaks a
+// scheduling constraint.
+func (m *matchesUseCaseImpl) GenerateFixtures(ctx context.Context, request *model.FixtureRequestGenerate) ([]model.MatchResponse, error) {
+	tx := m.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		m.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	competition, err := m.CompetitionsRepo.FindByIDWithRelations(tx, request.CompetitionID, "Teams")
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.CompetitionID)
+	}
+
+	teams := map[string]entity.Team{}
+	var teamIDs []string
+	for _, team := range competition.Teams {
+		if team.DeletedAt != nil {
+			continue
+		}
+		teams[team.ID] = team
+		teamIDs = append(teamIDs, team.ID)
+	}
+	if len(teamIDs) < 2 {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Competition needs at least two registered teams").WithDetail("id", competition.ID)
+	}
+	sort.Slice(teamIDs, func(i, j int) bool {
+		return teams[teamIDs[i]].Name < teams[teamIDs[j]].Name
+	})
+
+	existing, err := m.MatchesRepo.CountByCompetitionID(tx, competition.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to count competition matches")
+	}
+	if existing > 0 {
+		tx.Rollback()
+		return nil, common.ErrConflict("Competition already has fixtures").WithDetail("id", competition.ID)
+	}
+
+	date := common.ConvertStringToDate(request.StartDate)
+	if date.Before(time.Now()) {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Start date cannot be in the past").WithDetail("start_date", request.StartDate)
+	}
+
+	daysBetweenRounds := request.DaysBetweenRounds
+	if daysBetweenRounds == 0 {
+		daysBetweenRounds = 7
+	}
+
+	var matches []*entity.Match
+	var violations []common.ErrorDetail
+	for round, pairs := range roundRobinRounds(teamIDs, request.DoubleRoundRobin) {
+		for {
+			blackout, err := m.SchedulingRepo.FindBlackoutDate(tx, competition.ID, date)
+			if err != nil {
+				tx.Rollback()
+				return nil, common.ErrInternalServer("Failed to check blackout dates")
+			}
+			if blackout == nil {
+				break
+			}
+			date = date.AddDate(0, 0, 1)
+		}
+
+		matchday := round + 1
+		for _, pair := range pairs {
+			match := &entity.Match{
+				ID:            uuid.New().String(),
+				MatchDate:     date,
+				MatchTime:     request.MatchTime,
+				HomeTeamID:    pair[0],
+				AwayTeamID:    pair[1],
+				Status:        "scheduled",
+				CompetitionID: &competition.ID,
+				Matchday:      &matchday,
+			}
+			if err := m.setKickoff(tx, match, common.LocationFromContext(ctx)); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+
+			found, err := findSchedulingViolations(tx, m.SchedulingRepo, m.MatchesRepo, m.TeamsRepo, m.SchedulingConfig, match)
+			if err != nil {
+				tx.Rollback()
+				return nil, common.ErrInternalServer("Failed to check scheduling constraints")
+			}
+			for _, violation := range found {
+				violations = append(violations, common.ErrorDetail{
+					Field:   fmt.Sprintf("matchday %d", matchday),
+					Message: fmt.Sprintf("%s vs %s: %s", teams[pair[0]].Name, teams[pair[1]].Name, violation.Message),
+				})
+			}
+
+			// later fixtures are checked against the ones created before them
+			if err := m.MatchesRepo.Create(tx, match); err != nil {
+				tx.Rollback()
+				m.Log.Errorf("Failed to create fixture: %v", err)
+				return nil, common.ErrInternalServer("Failed to create fixture")
+			}
+			match.HomeTeam = teams[pair[0]]
+			match.AwayTeam = teams[pair[1]]
+			matches = append(matches, match)
+		}
+
+		date = date.AddDate(0, 0, daysBetweenRounds)
+	}
+
+	if len(violations) > 0 {
+		tx.Rollback()
+		m.Log.Warnf("Fixtures for competition %s break %d scheduling constraints", competition.ID, len(violations))
+		return nil, common.ErrConflict("Generated fixtures violate scheduling constraints").WithDetails(violations)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		m.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	event := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("%d fixtures generated for competition %s", len(matches), competition.ID),
+		Service: "matches",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	if err := m.LogsProducer.Send(event); err != nil {
+		m.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	responses := []model.MatchResponse{}
+	for _, match := range matches {
+		responses = append(responses, *converter.ToMatchResponse(match, common.LocationFromContext(ctx)))
+	}
+
+	return responses, nil
+}
+
+// publishMatchEvent tells subscribers that the match's kickoff changed.
+func (m *matchesUseCaseImpl) publishMatchEvent(eventType string, match *entity.Match, reschedule *entity.MatchReschedule) error {
+	event := &model.MatchEvent{
+		Type:            eventType,
+		MatchID:         match.ID,
+		CompetitionID:   match.CompetitionID,
+		HomeTeamID:      match.HomeTeamID,
+		AwayTeamID:      match.AwayTeamID,
+		Status:          match.Status,
+		Reason:          reschedule.Reason,
+		OriginalKickoff: fmt.Sprintf("%s %s", reschedule.OriginalDate.Format("2006-01-02"), reschedule.OriginalTime),
+		Time:            time.Now().Format(time.RFC3339),
+	}
+	if reschedule.NewDate != nil && reschedule.NewTime != nil {
+		newKickoff := fmt.Sprintf("%s %s", reschedule.NewDate.Format("2006-01-02"), *reschedule.NewTime)
+		event.NewKickoff = &newKickoff
+	}
+
+	m.Log.Infof("Sending match event: %+v", event)
+	if err := m.MatchEventProducer.Send(event); err != nil {
+		m.Log.Errorf("Failed to send match event: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (m *matchesUseCaseImpl) Postpone(ctx context.Context, request *model.MatchRequestPostpone) (*model.MatchResponse, error) {
+	tx := m.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		m.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	match, err := m.MatchesRepo.FindByID(tx, request.ID)
+	if err != nil {
+		m.Log.Errorf("Failed to find match by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
+	}
+
+	if match.Status != "scheduled" {
+		tx.Rollback()
+		return nil, common.ErrConflict("Only scheduled matches can be postponed").WithDetail("status", match.Status)
+	}
+
+	now := time.Now()
+	reschedule := &entity.MatchReschedule{
+		ID:           uuid.New().String(),
+		MatchID:      match.ID,
+		Reason:       request.Reason,
+		OriginalDate: match.MatchDate,
+		OriginalTime: match.MatchTime,
+		PostponedAt:  &now,
+	}
+	if err := m.MatchesRepo.SaveReschedule(tx, reschedule); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to record match postponement")
+	}
+
+	match.Status = "postponed"
+	if err := m.MatchesRepo.Update(tx, match); err != nil {
+		tx.Rollback()
+		m.Log.Errorf("Failed to postpone match: %v", err)
+		return nil, common.ErrInternalServer("Failed to postpone match")
+	}
+
+	match.Reschedules, err = m.MatchesRepo.FindReschedulesByMatchID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find match reschedules")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		m.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	event := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Match with ID %s postponed: %s", match.ID, request.Reason),
+		Service: "matches",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	if err := m.LogsProducer.Send(event); err != nil {
+		m.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	if err := m.publishMatchEvent("match.postponed", match, reschedule); err != nil {
+		return nil, common.ErrInternalServer("Failed to send match event")
+	}
+
+	return converter.ToMatchResponse(match, common.LocationFromContext(ctx)), nil
+}
+
+// Reschedule gives a postponed or scheduled match a new kickoff and moves it
+// back to scheduled. The new kickoff has to satisfy the scheduling constraints.
+func (m *matchesUseCaseImpl) Reschedule(ctx context.Context, request *model.MatchRequestReschedule) (*model.MatchResponse, error) {
+	tx := m.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		m.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	match, err := m.MatchesRepo.FindByID(tx, request.ID)
+	if err != nil {
+		m.Log.Errorf("Failed to find match by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
+	}
+
+	if match.Status != "scheduled" && match.Status != "postponed" {
+		tx.Rollback()
+		return nil, common.ErrConflict("Only scheduled or postponed matches can be rescheduled").WithDetail("status", match.Status)
+	}
+
+	reschedule, err := m.MatchesRepo.FindOpenPostponement(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find match postponement")
+	}
+	if reschedule == nil {
+		if request.Reason == "" {
+			tx.Rollback()
+			return nil, common.ErrInvalidInput("Reason is required when the match was not postponed").WithDetail("reason", request.Reason)
+		}
+		reschedule = &entity.MatchReschedule{
+			ID:           uuid.New().String(),
+			MatchID:      match.ID,
+			Reason:       request.Reason,
+			OriginalDate: match.MatchDate,
+			OriginalTime: match.MatchTime,
+		}
+	}
+
+	match.MatchDate = common.ConvertStringToDate(request.MatchDate)
+	match.MatchTime = request.MatchTime
+	match.Status = "scheduled"
+
+	if err := m.setKickoff(tx, match, common.LocationFromContext(ctx)); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if match.KickoffAt.Before(time.Now()) {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("Match date cannot be in the past").WithDetail("match_date", fmt.Sprintf("%s %s", request.MatchDate, request.MatchTime))
+	}
+
+	if err := m.checkSchedule(tx, match); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := m.MatchesRepo.Update(tx, match); err != nil {
+		tx.Rollback()
+		m.Log.Errorf("Failed to reschedule match: %v", err)
+		return nil, common.ErrInternalServer("Failed to reschedule match")
+	}
+
+	now := time.Now()
+	reschedule.NewDate = &match.MatchDate
+	reschedule.NewTime = &match.MatchTime
+	reschedule.RescheduledAt = &now
+	if err := m.MatchesRepo.SaveReschedule(tx, reschedule); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to record match reschedule")
+	}
+
+	match.Reschedules, err = m.MatchesRepo.FindReschedulesByMatchID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find match reschedules")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		m.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	event := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Match with ID %s rescheduled to %s %s", match.ID, request.MatchDate, request.MatchTime),
+		Service: "matches",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	if err := m.LogsProducer.Send(event); err != nil {
+		m.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	if err := m.publishMatchEvent("match.rescheduled", match, reschedule); err != nil {
+		return nil, common.ErrInternalServer("Failed to send match event")
+	}
+
+	return converter.ToMatchResponse(match, common.LocationFromContext(ctx)), nil
+}
+
+func (m *matchesUseCaseImpl) FindReschedules(ctx context.Context, request *model.MatchRequestFindByID) ([]model.MatchRescheduleResponse, error) {
+	tx := m.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		m.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := m.MatchesRepo.FindByID(tx, request.ID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
+	}
+
+	reschedules, err := m.MatchesRepo.FindReschedulesByMatchID(tx, request.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find match reschedules")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		m.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.MatchRescheduleResponse{}
+	responses = append(responses, converter.ToMatchRescheduleResponses(reschedules)...)
+
+	return responses, nil
 }
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func TestRoundRobinRounds(t *testing.T) {
+	teams := func(n int) []string {
+		ids := make([]string, n)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("t%d", i)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name       string
+		teams      []string
+		double     bool
+		wantRounds int
+		wantPerRnd int
+	}{
+		{"two teams", teams(2), false, 1, 1},
+		{"two teams double", teams(2), true, 2, 1},
+		{"three teams sit out in turn", teams(3), false, 3, 1},
+		{"four teams", teams(4), false, 3, 2},
+		{"five teams", teams(5), false, 5, 2},
+		{"six teams double", teams(6), true, 10, 3},
+		{"seven teams double", teams(7), true, 14, 3},
+		{"twenty teams", teams(20), false, 19, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rounds := roundRobinRounds(tt.teams, tt.double)
+			if len(rounds) != tt.wantRounds {
+				t.Fatalf("got %d rounds, want %d", len(rounds), tt.wantRounds)
+			}
+
+			fixtures := map[[2]string]int{}
+			for r, pairs := range rounds {
+				if len(pairs) != tt.wantPerRnd {
+					t.Errorf("round %d has %d fixtures, want %d", r+1, len(pairs), tt.wantPerRnd)
+				}
+				playing := map[string]bool{}
+				for _, pair := range pairs {
+					if pair[0] == pair[1] {
+						t.Errorf("round %d pairs %s with itself", r+1, pair[0])
+					}
+					for _, team := range pair {
+						if playing[team] {
+							t.Errorf("round %d has %s twice", r+1, team)
+						}
+						playing[team] = true
+					}
+					fixtures[pair]++
+				}
+			}
+
+			// every ordered pairing once in a double round robin, every
+			// unordered pairing once otherwise
+			for i, home := range tt.teams {
+				for _, away := range tt.teams[i+1:] {
+					there, back := fixtures[[2]string{home, away}], fixtures[[2]string{away, home}]
+					if tt.double && (there != 1 || back != 1) {
+						t.Errorf("%s and %s meet %d and %d times, want once each way", home, away, there, back)
+					}
+					if !tt.double && there+back != 1 {
+						t.Errorf("%s and %s meet %d times, want once", home, away, there+back)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRoundRobinRoundsOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		teams  []string
+		double bool
+		want   [][][2]string
+	}{
+		{
+			name:  "four teams",
+			teams: []string{"a", "b", "c", "d"},
+			want: [][][2]string{
+				{{"a", "d"}, {"b", "c"}},
+				{{"c", "a"}, {"d", "b"}},
+				{{"a", "b"}, {"c", "d"}},
+			},
+		},
+		{
+			name:   "three teams double",
+			teams:  []string{"a", "b", "c"},
+			double: true,
+			want: [][][2]string{
+				{{"b", "c"}},
+				{{"c", "a"}},
+				{{"a", "b"}},
+				{{"c", "b"}},
+				{{"a", "c"}},
+				{{"b", "a"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundRobinRounds(tt.teams, tt.double); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("roundRobinRounds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCompletedMatchUpdate(t *testing.T) {
+	score := func(goals int) *int { return &goals }
+	completed := entity.Match{Status: "completed", HomeTeamID: "home", AwayTeamID: "away", HomeScore: score(2), AwayScore: score(1)}
+	scheduled := entity.Match{Status: "scheduled", HomeTeamID: "home", AwayTeamID: "away"}
+
+	tests := []struct {
+		name      string
+		match     entity.Match
+		request   model.MatchRequestUpdate
+		wantField string
+	}{
+		{"scheduled match can change anything", scheduled, model.MatchRequestUpdate{Status: "canceled", HomeTeamID: "other", HomeScore: score(1)}, ""},
+		{"attendance and venue", completed, model.MatchRequestUpdate{Attendance: score(30000), VenueID: "venue"}, ""},
+		{"same teams and score", completed, model.MatchRequestUpdate{HomeTeamID: "home", AwayTeamID: "away", HomeScore: score(2), AwayScore: score(1)}, ""},
+		{"back to scheduled", completed, model.MatchRequestUpdate{Status: "scheduled"}, "status"},
+		{"canceled", completed, model.MatchRequestUpdate{Status: "canceled"}, "status"},
+		{"home team", completed, model.MatchRequestUpdate{HomeTeamID: "other"}, "home_team_id"},
+		{"away team", completed, model.MatchRequestUpdate{AwayTeamID: "other"}, "away_team_id"},
+		{"home score", completed, model.MatchRequestUpdate{HomeScore: score(3)}, "home_score"},
+		{"away score", completed, model.MatchRequestUpdate{AwayScore: score(0)}, "away_score"},
+		{"score on a match finished without one", entity.Match{Status: "completed", HomeTeamID: "home", AwayTeamID: "away"}, model.MatchRequestUpdate{HomeScore: score(0)}, "home_score"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCompletedMatchUpdate(&tt.match, &tt.request)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("checkCompletedMatchUpdate() = %v, want nil", err)
+				}
+				return
+			}
+			var appErr *common.AppError
+			if !errors.As(err, &appErr) || appErr.Code != "INVALID_INPUT" {
+				t.Fatalf("checkCompletedMatchUpdate() = %v, want an invalid input error", err)
+			}
+			if len(appErr.Details) != 1 || appErr.Details[0].Field != tt.wantField {
+				t.Errorf("details = %v, want field %s", appErr.Details, tt.wantField)
+			}
+		})
+	}
+}
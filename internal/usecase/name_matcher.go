@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"golang.org/x/text/unicode/norm"
+)
+
+// nameMatchThreshold is the lowest similarity at which a name in an import
+// file is taken to mean an existing team or player.
+const nameMatchThreshold = 0.8
+
+// teamNameAliases expands abbreviations common in results files.
+var teamNameAliases = map[string]string{
+	"utd":   "united",
+	"man":   "manchester",
+	"st":    "saint",
+	"nottm": "nottingham",
+	"sheff": "sheffield",
+	"wed":   "wednesday",
+}
+
+// teamNameNoise are words that clubs add or drop from their names freely.
+var teamNameNoise = map[string]bool{
+	"fc": true, "afc": true, "cf": true, "sc": true, "ac": true, "fk": true, "sk": true,
+	"club": true, "football": true, "the": true,
+}
+
+// nameWords folds case, accents and apostrophes and splits a name into words.
+func nameWords(name string) []string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r), r == '\'', r == '’':
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Fields(b.String())
+}
+
+func normalizeTeamName(name string) string {
+	var words []string
+	for _, word := range nameWords(name) {
+		if alias, ok := teamNameAliases[word]; ok {
+			word = alias
+		}
+		if !teamNameNoise[word] {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func normalizePlayerName(name string) string {
+	return strings.Join(nameWords(name), " ")
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// containsWords reports whether every word of short is in long.
+func containsWords(long, short string) bool {
+	words := map[string]bool{}
+	for _, word := range strings.Fields(long) {
+		words[word] = true
+	}
+	for _, word := range strings.Fields(short) {
+		if !words[word] {
+			return false
+		}
+	}
+	return true
+}
+
+// nameSimilarity scores two normalized names from 0 to 1 by edit distance. A
+// name that is the other with words added, such as "persija" and "persija
+// jakarta", scores at least 0.9.
+func nameSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	score := 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+	if containsWords(a, b) || containsWords(b, a) {
+		score = max(score, 0.9)
+	}
+	return score
+}
+
+// bestNameMatch returns the candidate closest to name and its score. The
+// index is -1 when no candidate reaches the threshold or two tie for best.
+func bestNameMatch(name string, candidates []string) (int, float64) {
+	best, bestScore, tied := -1, 0.0, false
+	for i, candidate := range candidates {
+		score := nameSimilarity(name, candidate)
+		switch {
+		case score > bestScore:
+			best, bestScore, tied = i, score, false
+		case score == bestScore && score > 0:
+			tied = true
+		}
+	}
+	if best < 0 || bestScore < nameMatchThreshold || tied {
+		return -1, bestScore
+	}
+	return best, bestScore
+}
+
+// teamMatcher matches team names from an import file against known teams.
+type teamMatcher struct {
+	teams []entity.Team
+	names []string
+}
+
+func newTeamMatcher(teams []entity.Team) *teamMatcher {
+	m := &teamMatcher{}
+	for _, team := range teams {
+		m.add(team)
+	}
+	return m
+}
+
+func (m *teamMatcher) add(team entity.Team) {
+	m.teams = append(m.teams, team)
+	m.names = append(m.names, normalizeTeamName(team.Name))
+}
+
+// match returns the team the name most likely means, or nil.
+func (m *teamMatcher) match(name string) (*entity.Team, float64) {
+	i, score := bestNameMatch(normalizeTeamName(name), m.names)
+	if i < 0 {
+		return nil, score
+	}
+	return &m.teams[i], score
+}
+
+// matchPlayer finds a scorer in a squad by name. Results files often give
+// only the surname, so a surname shared by exactly one player also matches.
+func matchPlayer(name string, squad []entity.Player) *entity.Player {
+	target := normalizePlayerName(name)
+	names := make([]string, len(squad))
+	for i, player := range squad {
+		names[i] = normalizePlayerName(player.Name)
+	}
+	if i, _ := bestNameMatch(target, names); i >= 0 {
+		return &squad[i]
+	}
+
+	words := strings.Fields(target)
+	if len(words) == 0 {
+		return nil
+	}
+	var found *entity.Player
+	for i, candidate := range names {
+		candidateWords := strings.Fields(candidate)
+		if len(candidateWords) > 0 && candidateWords[len(candidateWords)-1] == words[len(words)-1] {
+			if found != nil {
+				return nil
+			}
+			found = &squad[i]
+		}
+	}
+	return found
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+)
+
+func TestTeamMatcher(t *testing.T) {
+	matcher := newTeamMatcher([]entity.Team{
+		{ID: "mun", Name: "Manchester United"},
+		{ID: "mci", Name: "Manchester City"},
+		{ID: "per", Name: "Persija Jakarta"},
+		{ID: "nfo", Name: "Nottingham Forest"},
+		{ID: "atm", Name: "Atlético Madrid"},
+		{ID: "bou", Name: "AFC Bournemouth"},
+		{ID: "shw", Name: "Sheffield Wednesday"},
+		{ID: "shu", Name: "Sheffield United"},
+	})
+	matcher.add(entity.Team{ID: "psm", Name: "PSM Makassar"})
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Manchester United", "mun"},
+		{"manchester united", "mun"},
+		{"Manchester United FC", "mun"},
+		{"Man Utd", "mun"},
+		{"Man City", "mci"},
+		{"Manchester Unted", "mun"},
+		{"Persija", "per"},
+		{"Nottm Forest", "nfo"},
+		{"Atletico Madrid", "atm"},
+		{"Atletico-Madrid", "atm"},
+		{"Bournemouth", "bou"},
+		{"Sheffield Wed", "shw"},
+		{"Sheff Utd", "shu"},
+		{"PSM", "psm"},
+		{"Manchester", ""},
+		{"Sheffield", ""},
+		{"Arsenal", ""},
+		{"FC", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			team, score := matcher.match(tt.name)
+			got := ""
+			if team != nil {
+				got = team.ID
+				if score < nameMatchThreshold {
+					t.Errorf("matched %s with score %f below the threshold", got, score)
+				}
+			}
+			if got != tt.want {
+				t.Errorf("match(%q) = %q (score %f), want %q", tt.name, got, score, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPlayer(t *testing.T) {
+	squad := []entity.Player{
+		{ID: "klok", Name: "Marc Klok"},
+		{ID: "riko", Name: "Riko Simanjuntak"},
+		{ID: "ridho", Name: "Rizky Ridho"},
+		{ID: "febrianto", Name: "Rizky Dwi Febrianto"},
+		{ID: "sananta", Name: "Ramadhan Sananta"},
+		{ID: "witan", Name: "Witan Sulaeman"},
+		{ID: "asnawi", Name: "Asnawi Mangkualam"},
+		{ID: "arhan", Name: "Pratama Arhan"},
+		{ID: "arhan2", Name: "Rizal Arhan"},
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Marc Klok", "klok"},
+		{"MARC KLOK", "klok"},
+		{"Klok", "klok"},
+		{"M. Klok", "klok"},
+		{"Simanjuntak", "riko"},
+		{"Witan Sulaiman", "witan"},
+		{"Rizky Ridho", "ridho"},
+		{"R. Ridho", "ridho"},
+		{"Rizky", ""},
+		{"Arhan", ""},
+		{"Egy Maulana", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if player := matchPlayer(tt.name, squad); player != nil {
+				got = player.ID
+			}
+			if got != tt.want {
+				t.Errorf("matchPlayer(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
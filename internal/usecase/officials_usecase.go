@@ -0,0 +1,523 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type OfficialsUseCase interface {
+	FindAll(ctx context.Context) ([]model.OfficialResponse, error)
+	FindByID(ctx context.Context, request *model.OfficialRequestFindByID) (*model.OfficialResponse, error)
+	Create(ctx context.Context, request *model.OfficialRequestCreate) (*model.OfficialResponse, error)
+	Update(ctx context.Context, request *model.OfficialRequestUpdate) (*model.OfficialResponse, error)
+	SoftDelete(ctx context.Context, request *model.OfficialRequestSoftDelete) (*model.OfficialResponse, error)
+	FindMatches(ctx context.Context, request *model.OfficialRequestFindByID) ([]model.OfficialMatchResponse, error)
+	FindByMatchID(ctx context.Context, request *model.MatchOfficialRequestFindByMatchID) ([]model.MatchOfficialResponse, error)
+	Assign(ctx context.Context, request *model.MatchOfficialRequestAssign) (*model.MatchOfficialResponse, error)
+	Unassign(ctx context.Context, request *model.MatchOfficialRequestUnassign) (*model.MatchOfficialResponse, error)
+}
+
+type officialsUseCaseImpl struct {
+	OfficialsRepo repository.OfficialsRepository
+	MatchesRepo   repository.MatchesRepository
+	TeamsRepo     repository.TeamsRepository
+	LogsProducer  *messaging.LogProducer
+	DB            *gorm.DB
+	Log           *logrus.Logger
+}
+
+func NewOfficialsUseCase(officialsRepo repository.OfficialsRepository, matchesRepo repository.MatchesRepository, teamsRepo repository.TeamsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) OfficialsUseCase {
+	return &officialsUseCaseImpl{
+		OfficialsRepo: officialsRepo,
+		MatchesRepo:   matchesRepo,
+		TeamsRepo:     teamsRepo,
+		LogsProducer:  logsProducer,
+		DB:            db,
+		Log:           log,
+	}
+}
+
+// checkTeams makes sure every affiliated team exists and is listed once.
+func (o *officialsUseCaseImpl) checkTeams(tx *gorm.DB, teamIDs []string) error {
+	seen := map[string]bool{}
+	for _, teamID := range teamIDs {
+		if seen[teamID] {
+			return common.ErrInvalidInput("Team is listed more than once").WithDetail("affiliated_team_ids", teamID)
+		}
+		seen[teamID] = true
+
+		exists, err := o.TeamsRepo.CheckTeamExistsByTeamID(tx, teamID)
+		if err != nil {
+			return common.ErrInternalServer("Failed to check team existence")
+		}
+		if !exists {
+			return common.ErrNotFound("Team not found").WithDetail("affiliated_team_ids", teamID)
+		}
+	}
+	return nil
+}
+
+func (o *officialsUseCaseImpl) FindAll(ctx context.Context) ([]model.OfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	officials, err := o.OfficialsRepo.FindAllWithRelations(tx, "Teams")
+	if err != nil {
+		o.Log.Errorf("Failed to find all officials: %v", err)
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find officials")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.OfficialResponse{}
+	for _, official := range officials {
+		responses = append(responses, *converter.ToOfficialResponse(&official))
+	}
+
+	return responses, nil
+}
+
+func (o *officialsUseCaseImpl) FindByID(ctx context.Context, request *model.OfficialRequestFindByID) (*model.OfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	official, err := o.OfficialsRepo.FindByIDWithRelations(tx, request.ID, "Teams")
+	if err != nil {
+		o.Log.Errorf("Failed to find official by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Official not found").WithDetail("id", request.ID)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToOfficialResponse(official), nil
+}
+
+func (o *officialsUseCaseImpl) Create(ctx context.Context, request *model.OfficialRequestCreate) (*model.OfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	if err := o.checkTeams(tx, request.AffiliatedTeamIDs); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	official := &entity.Official{
+		ID:          uuid.New().String(),
+		Name:        request.Name,
+		Nationality: request.Nationality,
+	}
+
+	if err := o.OfficialsRepo.Create(tx, official); err != nil {
+		tx.Rollback()
+		o.Log.Errorf("Failed to create official: %v", err)
+		return nil, common.ErrInternalServer("Failed to create official")
+	}
+
+	if err := o.OfficialsRepo.ReplaceAffiliations(tx, official.ID, request.AffiliatedTeamIDs); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to save affiliations")
+	}
+
+	official, err := o.OfficialsRepo.FindByIDWithRelations(tx, official.ID, "Teams")
+	if err != nil {
+		tx.Rollback()
+		o.Log.Errorf("Failed to reload official: %v", err)
+		return nil, common.ErrInternalServer("Failed to reload official")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Official %s created successfully", official.Name),
+		Service: "officials",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	o.Log.Infof("Sending log event: %+v", logEvent)
+	if err := o.LogsProducer.Send(logEvent); err != nil {
+		o.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToOfficialResponse(official), nil
+}
+
+func (o *officialsUseCaseImpl) Update(ctx context.Context, request *model.OfficialRequestUpdate) (*model.OfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	official, err := o.OfficialsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		o.Log.Errorf("Failed to find official by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Official not found").WithDetail("id", request.ID)
+	}
+
+	if request.Name != "" {
+		official.Name = request.Name
+	}
+	if request.Nationality != "" {
+		official.Nationality = request.Nationality
+	}
+
+	if err := o.OfficialsRepo.Update(tx, official); err != nil {
+		tx.Rollback()
+		o.Log.Errorf("Failed to update official: %v", err)
+		return nil, common.ErrInternalServer("Failed to update official")
+	}
+
+	if request.AffiliatedTeamIDs != nil {
+		if err := o.checkTeams(tx, *request.AffiliatedTeamIDs); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := o.OfficialsRepo.ReplaceAffiliations(tx, official.ID, *request.AffiliatedTeamIDs); err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to save affiliations")
+		}
+	}
+
+	official, err = o.OfficialsRepo.FindByIDWithRelations(tx, official.ID, "Teams")
+	if err != nil {
+		tx.Rollback()
+		o.Log.Errorf("Failed to reload official: %v", err)
+		return nil, common.ErrInternalServer("Failed to reload official")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Official with ID %s updated successfully", official.ID),
+		Service: "officials",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	o.Log.Infof("Sending log event: %+v", logEvent)
+	if err := o.LogsProducer.Send(logEvent); err != nil {
+		o.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToOfficialResponse(official), nil
+}
+
+func (o *officialsUseCaseImpl) SoftDelete(ctx context.Context, request *model.OfficialRequestSoftDelete) (*model.OfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	official, err := o.OfficialsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		o.Log.Errorf("Failed to find official by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Official not found").WithDetail("id", request.ID)
+	}
+
+	if err := o.OfficialsRepo.SoftDelete(tx, official.ID); err != nil {
+		tx.Rollback()
+		o.Log.Errorf("Failed to soft delete official: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete official")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Official with ID %s soft deleted successfully", official.ID),
+		Service: "officials",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	o.Log.Infof("Sending log event: %+v", logEvent)
+	if err := o.LogsProducer.Send(logEvent); err != nil {
+		o.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToOfficialResponse(official), nil
+}
+
+func (o *officialsUseCaseImpl) FindMatches(ctx context.Context, request *model.OfficialRequestFindByID) ([]model.OfficialMatchResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := o.OfficialsRepo.FindByID(tx, request.ID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Official not found").WithDetail("id", request.ID)
+	}
+
+	assignments, err := o.OfficialsRepo.FindAssignmentsByOfficialID(tx, request.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find official's matches")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.OfficialMatchResponse{}
+	for _, assignment := range assignments {
+		responses = append(responses, *converter.ToOfficialMatchResponse(&assignment, common.LocationFromContext(ctx)))
+	}
+
+	return responses, nil
+}
+
+func (o *officialsUseCaseImpl) FindByMatchID(ctx context.Context, request *model.MatchOfficialRequestFindByMatchID) ([]model.MatchOfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := o.MatchesRepo.FindByID(tx, request.MatchID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.MatchID)
+	}
+
+	assignments, err := o.OfficialsRepo.FindAssignmentsByMatchID(tx, request.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find match officials")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.MatchOfficialResponse{}
+	for _, assignment := range assignments {
+		responses = append(responses, *converter.ToMatchOfficialResponse(&assignment))
+	}
+
+	return responses, nil
+}
+
+func (o *officialsUseCaseImpl) Assign(ctx context.Context, request *model.MatchOfficialRequestAssign) (*model.MatchOfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	match, err := o.MatchesRepo.FindByID(tx, request.MatchID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.MatchID)
+	}
+
+	official, err := o.OfficialsRepo.FindByID(tx, request.OfficialID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Official not found").WithDetail("official_id", request.OfficialID)
+	}
+
+	roleTaken, err := o.OfficialsRepo.CheckRoleAssigned(tx, match.ID, request.Role)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check match officials")
+	}
+	if roleTaken {
+		tx.Rollback()
+		return nil, common.ErrConflict("Role is already assigned for this match").WithDetail("role", request.Role)
+	}
+
+	alreadyAssigned, err := o.OfficialsRepo.CheckOfficialAssigned(tx, match.ID, official.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check match officials")
+	}
+	if alreadyAssigned {
+		tx.Rollback()
+		return nil, common.ErrConflict("Official is already assigned to this match").WithDetail("official_id", official.ID)
+	}
+
+	// an official can only work one match per day
+	booked, err := o.OfficialsRepo.FindAssignmentsOnDate(tx, official.ID, match.MatchDate, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check official availability")
+	}
+	if len(booked) > 0 {
+		tx.Rollback()
+		return nil, common.ErrConflict("Official is already assigned to another match on this date").WithDetail("match_id", booked[0].MatchID)
+	}
+
+	// officials may not work matches of teams they declared an affiliation with
+	affiliated, err := o.OfficialsRepo.FindAffiliatedTeamIDs(tx, official.ID, []string{match.HomeTeamID, match.AwayTeamID})
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check conflicts of interest")
+	}
+	if len(affiliated) > 0 {
+		tx.Rollback()
+		return nil, common.ErrConflict("Official has a declared affiliation with a team in this match").WithDetail("team_id", affiliated[0])
+	}
+
+	assignment := &entity.MatchOfficial{
+		ID:         uuid.New().String(),
+		MatchID:    match.ID,
+		OfficialID: official.ID,
+		Role:       request.Role,
+		Official:   official,
+	}
+
+	if err := o.OfficialsRepo.CreateAssignment(tx, assignment); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to assign official")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Official %s assigned to match %s as %s", official.ID, match.ID, assignment.Role),
+		Service: "officials",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	o.Log.Infof("Sending log event: %+v", logEvent)
+	if err := o.LogsProducer.Send(logEvent); err != nil {
+		o.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToMatchOfficialResponse(assignment), nil
+}
+
+func (o *officialsUseCaseImpl) Unassign(ctx context.Context, request *model.MatchOfficialRequestUnassign) (*model.MatchOfficialResponse, error) {
+	tx := o.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		o.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	assignment, err := o.OfficialsRepo.FindAssignmentByID(tx, request.ID)
+	if err != nil || assignment.MatchID != request.MatchID {
+		o.Log.Errorf("Failed to find official assignment %s for match %s: %v", request.ID, request.MatchID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Official assignment not found").WithDetail("id", request.ID)
+	}
+
+	if err := o.OfficialsRepo.SoftDeleteAssignment(tx, assignment.ID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to remove official assignment")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		o.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Official %s removed from match %s", assignment.OfficialID, assignment.MatchID),
+		Service: "officials",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	o.Log.Infof("Sending log event: %+v", logEvent)
+	if err := o.LogsProducer.Send(logEvent); err != nil {
+		o.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToMatchOfficialResponse(assignment), nil
+}
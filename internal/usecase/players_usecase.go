@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/Fadlihardiyanto/football-api/internal/common"
@@ -18,27 +19,31 @@ import (
 
 type PlayersUseCase interface {
 	FindAll(ctx context.Context) ([]model.PlayerResponse, error)
+	Export(ctx context.Context, w common.ExportWriter) error
 	FindByID(ctx context.Context, request *model.PlayerRequestFindByID) (*model.PlayerResponse, error)
 	Create(ctx context.Context, request *model.PlayerRequestCreate) (*model.PlayerResponse, error)
 	Update(ctx context.Context, request *model.PlayerRequestUpdate) (*model.PlayerResponse, error)
 	SoftDelete(ctx context.Context, request *model.PlayerRequestSoftDelete) (*model.PlayerResponse, error)
+	Compare(ctx context.Context, request *model.PlayerComparisonRequest) (*model.PlayerComparisonResponse, error)
 }
 
 type playersUseCaseImpl struct {
-	PlayersRepo  repository.PlayersRepository
-	TeamsRepo    repository.TeamsRepository
-	LogsProducer *messaging.LogProducer
-	DB           *gorm.DB
-	Log          *logrus.Logger
+	PlayersRepo      repository.PlayersRepository
+	TeamsRepo        repository.TeamsRepository
+	CompetitionsRepo repository.CompetitionsRepository
+	LogsProducer     *messaging.LogProducer
+	DB               *gorm.DB
+	Log              *logrus.Logger
 }
 
-func NewPlayersUseCase(playersRepo repository.PlayersRepository, teamsRepo repository.TeamsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) PlayersUseCase {
+func NewPlayersUseCase(playersRepo repository.PlayersRepository, teamsRepo repository.TeamsRepository, competitionsRepo repository.CompetitionsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) PlayersUseCase {
 	return &playersUseCaseImpl{
-		PlayersRepo:  playersRepo,
-		TeamsRepo:    teamsRepo,
-		LogsProducer: logsProducer,
-		DB:           db,
-		Log:          log,
+		PlayersRepo:      playersRepo,
+		TeamsRepo:        teamsRepo,
+		CompetitionsRepo: competitionsRepo,
+		LogsProducer:     logsProducer,
+		DB:               db,
+		Log:              log,
 	}
 }
 
@@ -70,6 +75,41 @@ func (p *playersUseCaseImpl) FindAll(ctx context.Context) ([]model.PlayerRespons
 	return responses, nil
 }
 
+// Export streams every player to w, reading exportBatchSize rows at a time.
+func (p *playersUseCaseImpl) Export(ctx context.Context, w common.ExportWriter) error {
+	tx := p.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := w.Write(converter.PlayerExportHeader...); err != nil {
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to write players export")
+	}
+	err := p.PlayersRepo.FindAllInBatches(tx, exportBatchSize, func(batch []entity.Player) error {
+		for _, player := range batch {
+			if err := w.Write(converter.ToPlayerExportRow(converter.ToPlayerResponse(&player))...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, "Team")
+	if err != nil {
+		p.Log.Errorf("Failed to export players: %v", err)
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to export players")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		p.Log.Errorf("Failed to commit transaction: %v", err)
+		return common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return nil
+}
+
 func (p *playersUseCaseImpl) FindByID(ctx context.Context, request *model.PlayerRequestFindByID) (*model.PlayerResponse, error) {
 	tx := p.DB.WithContext(ctx).Begin()
 	defer func() {
@@ -119,6 +159,7 @@ func (p *playersUseCaseImpl) Create(ctx context.Context, request *model.PlayerRe
 		Height:       request.Height,
 		Weight:       request.Weight,
 		JerseyNumber: request.JerseyNumber,
+		DateOfBirth:  common.ConvertStringToDatePointer(request.DateOfBirth),
 	}
 
 	// check if team exists
@@ -239,6 +280,9 @@ func (p *playersUseCaseImpl) Update(ctx context.Context, request *model.PlayerRe
 	if request.JerseyNumber != 0 {
 		player.JerseyNumber = request.JerseyNumber
 	}
+	if request.DateOfBirth != "" {
+		player.DateOfBirth = common.ConvertStringToDatePointer(request.DateOfBirth)
+	}
 
 	if err := p.PlayersRepo.Update(tx, player); err != nil {
 		tx.Rollback()
@@ -311,3 +355,191 @@ func (p *playersUseCaseImpl) SoftDelete(ctx context.Context, request *model.Play
 
 	return converter.ToPlayerResponse(player), nil
 }
+
+// per90 scales a total to a rate per 90 minutes, nil without minutes.
+func per90(total, minutes int) *float64 {
+	if minutes == 0 {
+		return nil
+	}
+	rate := math.Round(float64(total)*90/float64(minutes)*100) / 100
+	return &rate
+}
+
+// percentileRank returns the share of values below value, counting ties as
+// half, on a 0-100 scale.
+func percentileRank(value float64, values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var below, equal int
+	for _, v := range values {
+		switch {
+		case v < value:
+			below++
+		case v == value:
+			equal++
+		}
+	}
+	return math.Round((float64(below)+float64(equal)/2)/float64(len(values))*1000) / 10
+}
+
+// ageOn returns the player's age in whole years on the given date.
+func ageOn(dateOfBirth *time.Time, date time.Time) *int {
+	if dateOfBirth == nil {
+		return nil
+	}
+	age := date.Year() - dateOfBirth.Year()
+	if date.Month() < dateOfBirth.Month() || (date.Month() == dateOfBirth.Month() && date.Day() < dateOfBirth.Day()) {
+		age--
+	}
+	return &age
+}
+
+func (p *playersUseCaseImpl) Compare(ctx context.Context, request *model.PlayerComparisonRequest) (*model.PlayerComparisonResponse, error) {
+	tx := p.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		p.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, id := range request.PlayerIDs {
+		if seen[id] {
+			return nil, common.ErrInvalidInput("Player is listed more than once").WithDetail("ids", id)
+		}
+		seen[id] = true
+	}
+
+	filter := repository.PlayerStatsFilter{
+		PlayerIDs:     request.PlayerIDs,
+		CompetitionID: request.CompetitionID,
+		From:          common.ConvertStringToDatePointer(request.From),
+		To:            common.ConvertStringToDatePointer(request.To),
+	}
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, common.ErrInvalidInput("From date must not be after to date").WithDetail("from", request.From)
+	}
+
+	if request.CompetitionID != "" {
+		if _, err := p.CompetitionsRepo.FindByID(tx, request.CompetitionID); err != nil {
+			p.Log.Errorf("Failed to find competition by ID %s: %v", request.CompetitionID, err)
+			tx.Rollback()
+			return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.CompetitionID)
+		}
+	}
+
+	players, err := p.PlayersRepo.FindPlayers(tx, request.PlayerIDs)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find players")
+	}
+	playersByID := map[string]*entity.Player{}
+	for i := range players {
+		playersByID[players[i].ID] = &players[i]
+	}
+	for _, id := range request.PlayerIDs {
+		if playersByID[id] == nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Player not found").WithDetail("id", id)
+		}
+		filter.Positions = append(filter.Positions, playersByID[id].Position)
+	}
+
+	stats, err := p.PlayersRepo.FindStats(tx, filter)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find player statistics")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		p.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	statsByID := map[string]repository.PlayerStats{}
+	groups := map[string][]repository.PlayerStats{}
+	for _, stat := range stats {
+		statsByID[stat.PlayerID] = stat
+		if stat.Appearances > 0 || seen[stat.PlayerID] {
+			groups[stat.Position] = append(groups[stat.Position], stat)
+		}
+	}
+
+	ageDate := time.Now()
+	if filter.To != nil {
+		ageDate = *filter.To
+	}
+
+	response := &model.PlayerComparisonResponse{
+		From:    common.ToDateStringPointer(filter.From),
+		To:      common.ToDateStringPointer(filter.To),
+		Players: []model.PlayerComparison{},
+	}
+	if request.CompetitionID != "" {
+		response.CompetitionID = &request.CompetitionID
+	}
+
+	for _, id := range request.PlayerIDs {
+		player := playersByID[id]
+		stat := statsByID[id]
+		group := groups[player.Position]
+
+		comparison := model.PlayerComparison{
+			PlayerID:      player.ID,
+			Name:          player.Name,
+			Position:      player.Position,
+			Height:        player.Height,
+			Weight:        player.Weight,
+			Age:           ageOn(player.DateOfBirth, ageDate),
+			Appearances:   stat.Appearances,
+			Starts:        stat.Starts,
+			Minutes:       stat.Minutes,
+			Goals:         stat.Goals,
+			Assists:       stat.Assists,
+			YellowCards:   stat.YellowCards,
+			RedCards:      stat.RedCards,
+			GoalsPer90:    per90(stat.Goals, stat.Minutes),
+			AssistsPer90:  per90(stat.Assists, stat.Minutes),
+			CardsPer90:    per90(stat.YellowCards+stat.RedCards, stat.Minutes),
+			PositionGroup: len(group),
+		}
+		if player.Team != nil {
+			comparison.Team = &model.TeamShort{ID: player.Team.ID, Name: player.Team.Name}
+		}
+
+		var minutes, goals, assists, goalsPer90, assistsPer90, cardsPer90 []float64
+		for _, other := range group {
+			minutes = append(minutes, float64(other.Minutes))
+			goals = append(goals, float64(other.Goals))
+			assists = append(assists, float64(other.Assists))
+			if other.Minutes > 0 {
+				goalsPer90 = append(goalsPer90, *per90(other.Goals, other.Minutes))
+				assistsPer90 = append(assistsPer90, *per90(other.Assists, other.Minutes))
+				cardsPer90 = append(cardsPer90, *per90(other.YellowCards+other.RedCards, other.Minutes))
+			}
+		}
+		comparison.Percentiles = model.PlayerPercentiles{
+			Minutes: percentileRank(float64(stat.Minutes), minutes),
+			Goals:   percentileRank(float64(stat.Goals), goals),
+			Assists: percentileRank(float64(stat.Assists), assists),
+		}
+		if stat.Minutes > 0 {
+			goalsRank := percentileRank(*comparison.GoalsPer90, goalsPer90)
+			assistsRank := percentileRank(*comparison.AssistsPer90, assistsPer90)
+			cardsRank := percentileRank(*comparison.CardsPer90, cardsPer90)
+			comparison.Percentiles.GoalsPer90 = &goalsRank
+			comparison.Percentiles.AssistsPer90 = &assistsRank
+			comparison.Percentiles.CardsPer90 = &cardsRank
+		}
+
+		response.Players = append(response.Players, comparison)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import "testing"
+
+func TestPercentileRank(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  float64
+		values []float64
+		want   float64
+	}{
+		{"no values", 3, nil, 0},
+		{"only value", 3, []float64{3}, 50},
+		{"highest", 4, []float64{1, 2, 3, 4}, 87.5},
+		{"lowest", 1, []float64{1, 2, 3, 4}, 12.5},
+		{"above every value", 10, []float64{1, 2, 3, 4}, 100},
+		{"below every value", 0, []float64{1, 2, 3, 4}, 0},
+		{"ties count as half", 2, []float64{1, 2, 2, 3}, 50},
+		{"all equal", 0.5, []float64{0.5, 0.5, 0.5}, 50},
+		{"rounded to one decimal", 2, []float64{1, 2, 3}, 50},
+		{"thirds", 1, []float64{1, 2, 3}, 16.7},
+		{"unsorted", 3, []float64{5, 3, 1, 4, 2}, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentileRank(tt.value, tt.values); got != tt.want {
+				t.Errorf("percentileRank(%v, %v) = %v, want %v", tt.value, tt.values, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+)
+
+const (
+	// poissonMaxGoals bounds the scoreline grid. The probability beyond it is
+	// negligible and the grid is renormalised.
+	poissonMaxGoals   = 10
+	poissonIterations = 100
+	// poissonPriorMatches shrinks teams with few matches towards an average
+	// team, as if each had played that many average matches.
+	poissonPriorMatches = 3.0
+	// poissonDefaultGoals is the goals per team per match used before any match
+	// has been played.
+	poissonDefaultGoals  = 1.35
+	predictionScorelines = 5
+)
+
+// poissonModel scores goals for each side as independent Poisson variables.
+// The home side expects Goals * attack(home) * defence(away) * HomeAdvantage
+// and the away side Goals * attack(away) * defence(home).
+type poissonModel struct {
+	Goals         float64
+	HomeAdvantage float64
+	Attack        map[string]float64
+	Defence       map[string]float64
+	Matches       int
+}
+
+type poissonSample struct {
+	Home, Away           string
+	HomeGoals, AwayGoals float64
+	Weight               float64
+}
+
+// fitPoissonModel fits the team strengths by maximum likelihood, weighting
+// older matches down by the half-life.
+func fitPoissonModel(matches []entity.Match, asOf time.Time, halfLife time.Duration) *poissonModel {
+	model := &poissonModel{
+		Goals:         poissonDefaultGoals,
+		HomeAdvantage: 1,
+		Attack:        map[string]float64{},
+		Defence:       map[string]float64{},
+	}
+
+	var samples []poissonSample
+	var goals, weights float64
+	for _, match := range matches {
+		if match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		weight := 1.0
+		if halfLife > 0 {
+			weight = math.Pow(0.5, asOf.Sub(match.KickoffAt).Hours()/halfLife.Hours())
+		}
+		sample := poissonSample{
+			Home:      match.HomeTeamID,
+			Away:      match.AwayTeamID,
+			HomeGoals: float64(*match.HomeScore),
+			AwayGoals: float64(*match.AwayScore),
+			Weight:    weight,
+		}
+		samples = append(samples, sample)
+		goals += weight * (sample.HomeGoals + sample.AwayGoals)
+		weights += 2 * weight
+		model.Attack[sample.Home], model.Attack[sample.Away] = 1, 1
+		model.Defence[sample.Home], model.Defence[sample.Away] = 1, 1
+	}
+	model.Matches = len(samples)
+	if len(samples) == 0 || goals == 0 {
+		return model
+	}
+	model.Goals = goals / weights
+
+	prior := poissonPriorMatches * model.Goals
+	for i := 0; i < poissonIterations; i++ {
+		scored, scoredExpected := map[string]float64{}, map[string]float64{}
+		for _, s := range samples {
+			scored[s.Home] += s.Weight * s.HomeGoals
+			scored[s.Away] += s.Weight * s.AwayGoals
+			scoredExpected[s.Home] += s.Weight * model.Goals * model.Defence[s.Away] * model.HomeAdvantage
+			scoredExpected[s.Away] += s.Weight * model.Goals * model.Defence[s.Home]
+		}
+		for team := range model.Attack {
+			model.Attack[team] = (scored[team] + prior) / (scoredExpected[team] + prior)
+		}
+
+		conceded, concededExpected := map[string]float64{}, map[string]float64{}
+		for _, s := range samples {
+			conceded[s.Away] += s.Weight * s.HomeGoals
+			conceded[s.Home] += s.Weight * s.AwayGoals
+			concededExpected[s.Away] += s.Weight * model.Goals * model.Attack[s.Home] * model.HomeAdvantage
+			concededExpected[s.Home] += s.Weight * model.Goals * model.Attack[s.Away]
+		}
+		for team := range model.Defence {
+			model.Defence[team] = (conceded[team] + prior) / (concededExpected[team] + prior)
+		}
+
+		var homeGoals, homeExpected float64
+		for _, s := range samples {
+			homeGoals += s.Weight * s.HomeGoals
+			homeExpected += s.Weight * model.Goals * model.Attack[s.Home] * model.Defence[s.Away]
+		}
+		if homeGoals > 0 && homeExpected > 0 {
+			model.HomeAdvantage = homeGoals / homeExpected
+		}
+	}
+
+	return model
+}
+
+func (m *poissonModel) strength(strengths map[string]float64, teamID string) float64 {
+	if value, ok := strengths[teamID]; ok {
+		return value
+	}
+	return 1
+}
+
+// ExpectedGoals returns the goals each side is expected to score.
+func (m *poissonModel) ExpectedGoals(homeTeamID, awayTeamID string) (float64, float64) {
+	home := m.Goals * m.strength(m.Attack, homeTeamID) * m.strength(m.Defence, awayTeamID) * m.HomeAdvantage
+	away := m.Goals * m.strength(m.Attack, awayTeamID) * m.strength(m.Defence, homeTeamID)
+	return home, away
+}
+
+func poissonProbabilities(lambda float64) []float64 {
+	probabilities := make([]float64, poissonMaxGoals+1)
+	probabilities[0] = math.Exp(-lambda)
+	for k := 1; k <= poissonMaxGoals; k++ {
+		probabilities[k] = probabilities[k-1] * lambda / float64(k)
+	}
+	return probabilities
+}
+
+// Predict returns the outcome probabilities and the most likely scorelines.
+func (m *poissonModel) Predict(homeTeamID, awayTeamID string) entity.MatchPrediction {
+	homeExpected, awayExpected := m.ExpectedGoals(homeTeamID, awayTeamID)
+	home, away := poissonProbabilities(homeExpected), poissonProbabilities(awayExpected)
+
+	var total, homeWin, draw, awayWin float64
+	var scorelines entity.Scorelines
+	for h := range home {
+		for a := range away {
+			p := home[h] * away[a]
+			total += p
+			switch {
+			case h > a:
+				homeWin += p
+			case h < a:
+				awayWin += p
+			default:
+				draw += p
+			}
+			scorelines = append(scorelines, entity.Scoreline{Home: h, Away: a, Probability: p})
+		}
+	}
+
+	sort.SliceStable(scorelines, func(i, j int) bool {
+		return scorelines[i].Probability > scorelines[j].Probability
+	})
+	scorelines = scorelines[:predictionScorelines]
+	for i := range scorelines {
+		scorelines[i].Probability /= total
+	}
+
+	return entity.MatchPrediction{
+		HomeExpectedGoals: homeExpected,
+		AwayExpectedGoals: awayExpected,
+		HomeWin:           homeWin / total,
+		Draw:              draw / total,
+		AwayWin:           awayWin / total,
+		Scorelines:        scorelines,
+		TrainingMatches:   m.Matches,
+	}
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+)
+
+func TestFitPoissonModel(t *testing.T) {
+	asOf := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	result := func(home, away string, homeGoals, awayGoals int, daysAgo int) entity.Match {
+		return entity.Match{
+			HomeTeamID: home,
+			AwayTeamID: away,
+			HomeScore:  &homeGoals,
+			AwayScore:  &awayGoals,
+			KickoffAt:  asOf.AddDate(0, 0, -daysAgo),
+		}
+	}
+	repeat := func(n int, matches ...entity.Match) []entity.Match {
+		var all []entity.Match
+		for i := 0; i < n; i++ {
+			all = append(all, matches...)
+		}
+		return all
+	}
+
+	tests := []struct {
+		name        string
+		matches     []entity.Match
+		halfLife    time.Duration
+		wantMatches int
+		wantGoals   float64
+		check       func(t *testing.T, m *poissonModel)
+	}{
+		{
+			name:        "no matches",
+			wantMatches: 0,
+			wantGoals:   poissonDefaultGoals,
+			check: func(t *testing.T, m *poissonModel) {
+				if m.HomeAdvantage != 1 || len(m.Attack) != 0 {
+					t.Errorf("home advantage %f with %d teams, want 1 with none", m.HomeAdvantage, len(m.Attack))
+				}
+			},
+		},
+		{
+			name:        "matches without a score are left out",
+			matches:     []entity.Match{{HomeTeamID: "a", AwayTeamID: "b"}, result("a", "b", 2, 2, 1)},
+			wantMatches: 1,
+			wantGoals:   2,
+		},
+		{
+			name:        "goalless matches keep the default rate",
+			matches:     repeat(3, result("a", "b", 0, 0, 1)),
+			wantMatches: 3,
+			wantGoals:   poissonDefaultGoals,
+			check: func(t *testing.T, m *poissonModel) {
+				if m.Attack["a"] != 1 || m.Defence["b"] != 1 {
+					t.Errorf("strengths moved without goals: %v %v", m.Attack, m.Defence)
+				}
+			},
+		},
+		{
+			name:        "home sides scoring more gives a home advantage",
+			matches:     repeat(10, result("a", "b", 2, 1, 1), result("b", "a", 2, 1, 1)),
+			wantMatches: 20,
+			wantGoals:   1.5,
+			check: func(t *testing.T, m *poissonModel) {
+				// twice the goals at home, less what the prior shrinks away
+				if m.HomeAdvantage < 1.8 || m.HomeAdvantage > 2 {
+					t.Errorf("home advantage = %f, want just under 2", m.HomeAdvantage)
+				}
+				home, away := m.ExpectedGoals("a", "b")
+				if home < 1.8*away {
+					t.Errorf("expected goals %f and %f, want the home side near twice the away side", home, away)
+				}
+				if math.Abs(m.Attack["a"]-m.Attack["b"]) > 1e-9 {
+					t.Errorf("attack %f and %f differ for equal teams", m.Attack["a"], m.Attack["b"])
+				}
+			},
+		},
+		{
+			name:        "a dominant team attacks and defends better",
+			matches:     repeat(5, result("a", "b", 3, 0, 1), result("b", "a", 0, 2, 1), result("a", "c", 2, 0, 1), result("c", "b", 1, 1, 1)),
+			wantMatches: 20,
+			wantGoals:   1.125,
+			check: func(t *testing.T, m *poissonModel) {
+				if !(m.Attack["a"] > m.Attack["c"] && m.Attack["a"] > m.Attack["b"]) {
+					t.Errorf("attack = %v, want a strongest", m.Attack)
+				}
+				if !(m.Defence["a"] < m.Defence["b"] && m.Defence["a"] < m.Defence["c"]) {
+					t.Errorf("defence = %v, want a conceding least", m.Defence)
+				}
+			},
+		},
+		{
+			name:        "few matches are shrunk towards an average team",
+			matches:     []entity.Match{result("a", "b", 5, 0, 1)},
+			wantMatches: 1,
+			wantGoals:   2.5,
+			check: func(t *testing.T, m *poissonModel) {
+				if m.Attack["a"] > 2 || m.Defence["b"] > 2 {
+					t.Errorf("attack %f, defence %f after one match, want them shrunk", m.Attack["a"], m.Defence["b"])
+				}
+			},
+		},
+		{
+			name:        "older matches weigh less",
+			matches:     []entity.Match{result("a", "b", 0, 0, 365), result("a", "b", 3, 3, 0)},
+			halfLife:    365 * 24 * time.Hour,
+			wantMatches: 2,
+			// 6 goals at weight 1 and none at weight 0.5, over 2 * 1.5 team matches
+			wantGoals: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := fitPoissonModel(tt.matches, asOf, tt.halfLife)
+			if m.Matches != tt.wantMatches {
+				t.Errorf("matches = %d, want %d", m.Matches, tt.wantMatches)
+			}
+			if math.Abs(m.Goals-tt.wantGoals) > 1e-9 {
+				t.Errorf("goals = %f, want %f", m.Goals, tt.wantGoals)
+			}
+			if tt.check != nil {
+				tt.check(t, m)
+			}
+		})
+	}
+}
+
+func TestPoissonModelPredict(t *testing.T) {
+	tests := []struct {
+		name         string
+		model        *poissonModel
+		home, away   string
+		wantHome     float64
+		wantAway     float64
+		wantFavoured string
+	}{
+		{
+			name:         "unknown teams are average",
+			model:        &poissonModel{Goals: 1.35, HomeAdvantage: 1},
+			home:         "a",
+			away:         "b",
+			wantHome:     1.35,
+			wantAway:     1.35,
+			wantFavoured: "draw",
+		},
+		{
+			name:         "home advantage",
+			model:        &poissonModel{Goals: 1.2, HomeAdvantage: 1.5},
+			home:         "a",
+			away:         "b",
+			wantHome:     1.8,
+			wantAway:     1.2,
+			wantFavoured: "home",
+		},
+		{
+			name:         "strong away side",
+			model:        &poissonModel{Goals: 1.4, HomeAdvantage: 1.1, Attack: map[string]float64{"b": 2}, Defence: map[string]float64{"a": 1.5, "b": 0.5}},
+			home:         "a",
+			away:         "b",
+			wantHome:     0.77,
+			wantAway:     4.2,
+			wantFavoured: "away",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.model.Predict(tt.home, tt.away)
+			if math.Abs(p.HomeExpectedGoals-tt.wantHome) > 1e-9 || math.Abs(p.AwayExpectedGoals-tt.wantAway) > 1e-9 {
+				t.Errorf("expected goals = %f, %f, want %f, %f", p.HomeExpectedGoals, p.AwayExpectedGoals, tt.wantHome, tt.wantAway)
+			}
+			if sum := p.HomeWin + p.Draw + p.AwayWin; math.Abs(sum-1) > 1e-9 {
+				t.Errorf("outcome probabilities sum to %f, want 1", sum)
+			}
+
+			favoured := "draw"
+			if p.HomeWin > p.AwayWin+1e-9 {
+				favoured = "home"
+			} else if p.AwayWin > p.HomeWin+1e-9 {
+				favoured = "away"
+			}
+			if favoured != tt.wantFavoured {
+				t.Errorf("favoured = %s (%f/%f/%f), want %s", favoured, p.HomeWin, p.Draw, p.AwayWin, tt.wantFavoured)
+			}
+
+			if len(p.Scorelines) != predictionScorelines {
+				t.Fatalf("got %d scorelines, want %d", len(p.Scorelines), predictionScorelines)
+			}
+			if !sort.SliceIsSorted(p.Scorelines, func(i, j int) bool { return p.Scorelines[i].Probability > p.Scorelines[j].Probability }) {
+				t.Errorf("scorelines not most likely first: %v", p.Scorelines)
+			}
+		})
+	}
+}
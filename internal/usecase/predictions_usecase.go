@@ -0,0 +1,311 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// calibrationBuckets is the number of equal-width probability buckets in the
+// calibration report.
+const calibrationBuckets = 10
+
+type PredictionsUseCase interface {
+	FindByMatchID(ctx context.Context, request *model.MatchRequestFindByID) (*model.MatchPredictionResponse, error)
+	RecomputeUpcoming(ctx context.Context) (int, error)
+	PredictUnpredicted(ctx context.Context) (int, error)
+	Calibration(ctx context.Context, request *model.PredictionCalibrationRequest) (*model.PredictionCalibrationResponse, error)
+}
+
+type predictionsUseCaseImpl struct {
+	PredictionsRepo  repository.PredictionsRepository
+	MatchesRepo      repository.MatchesRepository
+	SeasonsRepo      repository.SeasonsRepository
+	PredictionConfig *model.PredictionConfig
+	DB               *gorm.DB
+	Log              *logrus.Logger
+}
+
+func NewPredictionsUseCase(predictionsRepo repository.PredictionsRepository, matchesRepo repository.MatchesRepository, seasonsRepo repository.SeasonsRepository, predictionConfig *model.PredictionConfig, db *gorm.DB, log *logrus.Logger) PredictionsUseCase {
+	return &predictionsUseCaseImpl{
+		PredictionsRepo:  predictionsRepo,
+		MatchesRepo:      matchesRepo,
+		SeasonsRepo:      seasonsRepo,
+		PredictionConfig: predictionConfig,
+		DB:               db,
+		Log:              log,
+	}
+}
+
+func (p *predictionsUseCaseImpl) fitModel(tx *gorm.DB, asOf time.Time) (*poissonModel, error) {
+	matches, err := p.PredictionsRepo.FindTrainingMatches(tx, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return fitPoissonModel(matches, asOf, p.PredictionConfig.HalfLife), nil
+}
+
+func (p *predictionsUseCaseImpl) predict(tx *gorm.DB, fitted *poissonModel, match *entity.Match) (*entity.MatchPrediction, error) {
+	prediction := fitted.Predict(match.HomeTeamID, match.AwayTeamID)
+	prediction.ID = uuid.New().String()
+	prediction.MatchID = match.ID
+	if err := p.PredictionsRepo.Save(tx, &prediction); err != nil {
+		return nil, err
+	}
+	return &prediction, nil
+}
+
+// FindByMatchID returns the stored prediction. Predictions are only made by the
+// worker, so a match it has not reached yet is not found.
+func (p *predictionsUseCaseImpl) FindByMatchID(ctx context.Context, request *model.MatchRequestFindByID) (*model.MatchPredictionResponse, error) {
+	tx := p.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		p.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	match, err := p.MatchesRepo.FindByIDWithRelations(tx, request.ID, "HomeTeam", "AwayTeam")
+	if err != nil {
+		p.Log.Errorf("Failed to find match by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Match not found").WithDetail("id", request.ID)
+	}
+
+	prediction, err := p.PredictionsRepo.FindByMatchID(tx, match.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("No prediction was made for this match").WithDetail("id", match.ID)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		p.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToMatchPredictionResponse(match, prediction, common.LocationFromContext(ctx)), nil
+}
+
+// RecomputeUpcoming refits the model on every completed match and predicts all
+// scheduled matches that have not kicked off again.
+func (p *predictionsUseCaseImpl) RecomputeUpcoming(ctx context.Context) (int, error) {
+	tx := p.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+	fitted, err := p.fitModel(tx, now)
+	if err != nil {
+		tx.Rollback()
+		return 0, common.ErrInternalServer("Failed to fit prediction model")
+	}
+
+	matches, err := p.PredictionsRepo.FindUpcomingMatches(tx, now)
+	if err != nil {
+		tx.Rollback()
+		return 0, common.ErrInternalServer("Failed to find upcoming matches")
+	}
+
+	for _, match := range matches {
+		if _, err := p.predict(tx, fitted, &match); err != nil {
+			tx.Rollback()
+			return 0, common.ErrInternalServer("Failed to save prediction").WithDetail("match_id", match.ID)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		p.Log.Errorf("Failed to commit transaction: %v", err)
+		return 0, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	p.Log.Infof("Predicted %d upcoming matches from %d completed matches", len(matches), fitted.Matches)
+	return len(matches), nil
+}
+
+// PredictUnpredicted predicts the scheduled matches that have no prediction
+// yet and have not kicked off, leaving the others as the last nightly run left
+// them.
+func (p *predictionsUseCaseImpl) PredictUnpredicted(ctx context.Context) (int, error) {
+	tx := p.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+	matches, err := p.PredictionsRepo.FindUnpredictedMatches(tx, now)
+	if err != nil {
+		tx.Rollback()
+		return 0, common.ErrInternalServer("Failed to find unpredicted matches")
+	}
+	if len(matches) == 0 {
+		tx.Rollback()
+		return 0, nil
+	}
+
+	fitted, err := p.fitModel(tx, now)
+	if err != nil {
+		tx.Rollback()
+		return 0, common.ErrInternalServer("Failed to fit prediction model")
+	}
+
+	for _, match := range matches {
+		if _, err := p.predict(tx, fitted, &match); err != nil {
+			tx.Rollback()
+			return 0, common.ErrInternalServer("Failed to save prediction").WithDetail("match_id", match.ID)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		p.Log.Errorf("Failed to commit transaction: %v", err)
+		return 0, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	p.Log.Infof("Predicted %d new matches from %d completed matches", len(matches), fitted.Matches)
+	return len(matches), nil
+}
+
+func (p *predictionsUseCaseImpl) Calibration(ctx context.Context, request *model.PredictionCalibrationRequest) (*model.PredictionCalibrationResponse, error) {
+	tx := p.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		p.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	var seasonID *string
+	if request.SeasonID != "" {
+		if _, err := p.SeasonsRepo.FindByID(tx, request.SeasonID); err != nil {
+			p.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+			tx.Rollback()
+			return nil, common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+		}
+		seasonID = &request.SeasonID
+	}
+
+	predictions, err := p.PredictionsRepo.FindSettled(tx, request.SeasonID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find predictions")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		p.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	response := calibrationReport(predictions)
+	response.SeasonID = seasonID
+	return response, nil
+}
+
+// calibrationReport scores settled predictions against the results: accuracy
+// of the favourite, Brier score and log loss per match, and how often the
+// outcomes in each probability bucket happened.
+func calibrationReport(predictions []entity.MatchPrediction) *model.PredictionCalibrationResponse {
+	response := &model.PredictionCalibrationResponse{}
+
+	type bucket struct {
+		count    int
+		sum      float64
+		occurred int
+	}
+	buckets := make([]bucket, calibrationBuckets)
+
+	var correct int
+	var brier, logLoss float64
+	for _, prediction := range predictions {
+		match := prediction.Match
+		homeGoals, awayGoals := *match.HomeScore, *match.AwayScore
+
+		probabilities := [3]float64{prediction.HomeWin, prediction.Draw, prediction.AwayWin}
+		var outcome int
+		switch {
+		case homeGoals > awayGoals:
+			outcome = 0
+		case homeGoals == awayGoals:
+			outcome = 1
+		default:
+			outcome = 2
+		}
+
+		favourite := 0
+		for i, probability := range probabilities {
+			if probability > probabilities[favourite] {
+				favourite = i
+			}
+			occurred := 0.0
+			if i == outcome {
+				occurred = 1
+			}
+			brier += (probability - occurred) * (probability - occurred)
+
+			b := int(probability * calibrationBuckets)
+			if b >= calibrationBuckets {
+				b = calibrationBuckets - 1
+			}
+			buckets[b].count++
+			buckets[b].sum += probability
+			buckets[b].occurred += int(occurred)
+		}
+		if favourite == outcome {
+			correct++
+		}
+		logLoss -= math.Log(math.Max(probabilities[outcome], 1e-15))
+
+		response.HomeExpectedGoals += prediction.HomeExpectedGoals
+		response.AwayExpectedGoals += prediction.AwayExpectedGoals
+		response.HomeGoals += float64(homeGoals)
+		response.AwayGoals += float64(awayGoals)
+	}
+
+	response.Matches = len(predictions)
+	if n := float64(len(predictions)); n > 0 {
+		response.Accuracy = math.Round(float64(correct)/n*10000) / 10000
+		response.BrierScore = math.Round(brier/n*10000) / 10000
+		response.LogLoss = math.Round(logLoss/n*10000) / 10000
+		response.HomeExpectedGoals = math.Round(response.HomeExpectedGoals/n*100) / 100
+		response.AwayExpectedGoals = math.Round(response.AwayExpectedGoals/n*100) / 100
+		response.HomeGoals = math.Round(response.HomeGoals/n*100) / 100
+		response.AwayGoals = math.Round(response.AwayGoals/n*100) / 100
+	}
+
+	response.Buckets = []model.CalibrationBucket{}
+	for i, b := range buckets {
+		entry := model.CalibrationBucket{
+			From:        float64(i) / calibrationBuckets,
+			To:          float64(i+1) / calibrationBuckets,
+			Predictions: b.count,
+		}
+		if b.count > 0 {
+			entry.MeanProbability = math.Round(b.sum/float64(b.count)*10000) / 10000
+			entry.ObservedFrequency = math.Round(float64(b.occurred)/float64(b.count)*10000) / 10000
+		}
+		response.Buckets = append(response.Buckets, entry)
+	}
+
+	return response
+}
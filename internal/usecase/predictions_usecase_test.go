@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+)
+
+func TestCalibrationReport(t *testing.T) {
+	prediction := func(homeWin, draw, awayWin float64, homeGoals, awayGoals int) entity.MatchPrediction {
+		return entity.MatchPrediction{
+			HomeWin:           homeWin,
+			Draw:              draw,
+			AwayWin:           awayWin,
+			HomeExpectedGoals: 1.5,
+			AwayExpectedGoals: 1,
+			Match:             &entity.Match{HomeScore: &homeGoals, AwayScore: &awayGoals},
+		}
+	}
+	type bucket struct {
+		Predictions       int
+		ObservedFrequency float64
+	}
+
+	tests := []struct {
+		name         string
+		predictions  []entity.MatchPrediction
+		wantAccuracy float64
+		wantBrier    float64
+		wantLogLoss  float64
+		wantBuckets  map[int]bucket
+	}{
+		{
+			name: "no predictions",
+		},
+		{
+			name:         "favourite wins",
+			predictions:  []entity.MatchPrediction{prediction(0.5, 0.3, 0.2, 2, 1)},
+			wantAccuracy: 1,
+			wantBrier:    0.38,
+			wantLogLoss:  0.6931,
+			wantBuckets:  map[int]bucket{2: {1, 0}, 3: {1, 0}, 5: {1, 1}},
+		},
+		{
+			name:         "favourite wins once and draws once",
+			predictions:  []entity.MatchPrediction{prediction(0.5, 0.3, 0.2, 2, 1), prediction(0.5, 0.3, 0.2, 1, 1)},
+			wantAccuracy: 0.5,
+			wantBrier:    0.58,
+			wantLogLoss:  0.9486,
+			wantBuckets:  map[int]bucket{2: {2, 0}, 3: {2, 0.5}, 5: {2, 0.5}},
+		},
+		{
+			name:         "an outcome given no chance is clamped in the log loss",
+			predictions:  []entity.MatchPrediction{prediction(0.6, 0.4, 0, 0, 1)},
+			wantAccuracy: 0,
+			wantBrier:    1.52,
+			wantLogLoss:  34.5388,
+			wantBuckets:  map[int]bucket{0: {1, 1}, 4: {1, 0}, 6: {1, 0}},
+		},
+		{
+			name:         "a certain outcome lands in the last bucket",
+			predictions:  []entity.MatchPrediction{prediction(1, 0, 0, 3, 0)},
+			wantAccuracy: 1,
+			wantBrier:    0,
+			wantLogLoss:  0,
+			wantBuckets:  map[int]bucket{0: {2, 0}, calibrationBuckets - 1: {1, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := calibrationReport(tt.predictions)
+			if report.Matches != len(tt.predictions) {
+				t.Errorf("matches = %d, want %d", report.Matches, len(tt.predictions))
+			}
+			if report.Accuracy != tt.wantAccuracy {
+				t.Errorf("accuracy = %f, want %f", report.Accuracy, tt.wantAccuracy)
+			}
+			if math.Abs(report.BrierScore-tt.wantBrier) > 1e-9 {
+				t.Errorf("brier score = %f, want %f", report.BrierScore, tt.wantBrier)
+			}
+			if math.Abs(report.LogLoss-tt.wantLogLoss) > 1e-9 {
+				t.Errorf("log loss = %f, want %f", report.LogLoss, tt.wantLogLoss)
+			}
+			if len(tt.predictions) > 0 && (report.HomeExpectedGoals != 1.5 || report.AwayExpectedGoals != 1) {
+				t.Errorf("expected goals = %f, %f, want 1.5, 1", report.HomeExpectedGoals, report.AwayExpectedGoals)
+			}
+
+			if len(report.Buckets) != calibrationBuckets {
+				t.Fatalf("got %d buckets, want %d", len(report.Buckets), calibrationBuckets)
+			}
+			for i, b := range report.Buckets {
+				want := tt.wantBuckets[i]
+				if b.Predictions != want.Predictions || b.ObservedFrequency != want.ObservedFrequency {
+					t.Errorf("bucket %d = %d predictions observed %f, want %d observed %f", i, b.Predictions, b.ObservedFrequency, want.Predictions, want.ObservedFrequency)
+				}
+			}
+		})
+	}
+}
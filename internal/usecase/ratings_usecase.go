@@ -0,0 +1,297 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type RatingsUseCase interface {
+	FindAll(ctx context.Context) ([]model.TeamRatingResponse, error)
+	FindHistory(ctx context.Context, request *model.TeamRatingRequest) (*model.TeamRatingHistoryResponse, error)
+	FindSeasonComparison(ctx context.Context, request *model.SeasonRatingsRequest) (*model.SeasonRatingsResponse, error)
+	Recompute(ctx context.Context) (int, error)
+}
+
+type ratingsUseCaseImpl struct {
+	RatingsRepo  repository.RatingsRepository
+	TeamsRepo    repository.TeamsRepository
+	SeasonsRepo  repository.SeasonsRepository
+	RatingConfig *model.RatingConfig
+	DB           *gorm.DB
+	Log          *logrus.Logger
+}
+
+func NewRatingsUseCase(ratingsRepo repository.RatingsRepository, teamsRepo repository.TeamsRepository, seasonsRepo repository.SeasonsRepository, ratingConfig *model.RatingConfig, db *gorm.DB, log *logrus.Logger) RatingsUseCase {
+	return &ratingsUseCaseImpl{
+		RatingsRepo:  ratingsRepo,
+		TeamsRepo:    teamsRepo,
+		SeasonsRepo:  seasonsRepo,
+		RatingConfig: ratingConfig,
+		DB:           db,
+		Log:          log,
+	}
+}
+
+// goalDiffMultiplier scales the K-factor by the winning margin.
+func goalDiffMultiplier(config *model.RatingConfig, goalDiff int) float64 {
+	if len(config.GoalDiffMultipliers) == 0 {
+		return 1
+	}
+	if goalDiff < 0 {
+		goalDiff = -goalDiff
+	}
+	if goalDiff >= len(config.GoalDiffMultipliers) {
+		goalDiff = len(config.GoalDiffMultipliers) - 1
+	}
+	return config.GoalDiffMultipliers[goalDiff]
+}
+
+// eloChange returns the home team's rating change and its expected score. The
+// away team moves by the opposite amount.
+func eloChange(config *model.RatingConfig, home, away float64, homeGoals, awayGoals int) (float64, float64) {
+	expected := 1 / (1 + math.Pow(10, (away-home-config.HomeAdvantage)/400))
+
+	actual := 0.5
+	if homeGoals > awayGoals {
+		actual = 1
+	} else if homeGoals < awayGoals {
+		actual = 0
+	}
+
+	return config.KFactor * goalDiffMultiplier(config, homeGoals-awayGoals) * (actual - expected), expected
+}
+
+func currentRating(tx *gorm.DB, ratingsRepo repository.RatingsRepository, config *model.RatingConfig, teamID string) (float64, error) {
+	rating, err := ratingsRepo.FindTeamRating(tx, teamID)
+	if err != nil {
+		return 0, err
+	}
+	if rating == nil {
+		return config.InitialRating, nil
+	}
+	return *rating, nil
+}
+
+// rateMatch applies a completed match to both teams' ratings and records the
+// change in their history. A match finished without a score is not rated.
+func rateMatch(tx *gorm.DB, ratingsRepo repository.RatingsRepository, config *model.RatingConfig, match *entity.Match) error {
+	if match.HomeScore == nil || match.AwayScore == nil {
+		return nil
+	}
+
+	home, err := currentRating(tx, ratingsRepo, config, match.HomeTeamID)
+	if err != nil {
+		return err
+	}
+	away, err := currentRating(tx, ratingsRepo, config, match.AwayTeamID)
+	if err != nil {
+		return err
+	}
+
+	change, expected := eloChange(config, home, away, *match.HomeScore, *match.AwayScore)
+
+	ratings := []entity.TeamRating{
+		{TeamID: match.HomeTeamID, OpponentID: match.AwayTeamID, RatingBefore: home, RatingAfter: home + change, ExpectedScore: expected},
+		{TeamID: match.AwayTeamID, OpponentID: match.HomeTeamID, RatingBefore: away, RatingAfter: away - change, ExpectedScore: 1 - expected},
+	}
+	for _, rating := range ratings {
+		rating.ID = uuid.New().String()
+		rating.MatchID = match.ID
+		rating.PlayedAt = match.KickoffAt
+		if err := ratingsRepo.Create(tx, &rating); err != nil {
+			return err
+		}
+		if err := ratingsRepo.SetTeamRating(tx, rating.TeamID, rating.RatingAfter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ratingsUseCaseImpl) FindAll(ctx context.Context) ([]model.TeamRatingResponse, error) {
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	teams, err := r.RatingsRepo.FindRatedTeams(tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find team ratings")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		r.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.TeamRatingResponse{}
+	for i, team := range teams {
+		responses = append(responses, model.TeamRatingResponse{
+			Rank:   i + 1,
+			Team:   model.TeamShort{ID: team.ID, Name: team.Name},
+			Rating: converter.RoundRating(*team.Rating),
+		})
+	}
+
+	return responses, nil
+}
+
+func (r *ratingsUseCaseImpl) FindHistory(ctx context.Context, request *model.TeamRatingRequest) (*model.TeamRatingHistoryResponse, error) {
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		r.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	team, err := r.TeamsRepo.FindByID(tx, request.TeamID)
+	if err != nil {
+		r.Log.Errorf("Failed to find team by ID %s: %v", request.TeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	ratings, err := r.RatingsRepo.FindByTeamID(tx, team.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find rating history")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		r.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	current := r.RatingConfig.InitialRating
+	if team.Rating != nil {
+		current = *team.Rating
+	}
+
+	return &model.TeamRatingHistoryResponse{
+		Team:          model.TeamShort{ID: team.ID, Name: team.Name},
+		CurrentRating: converter.RoundRating(current),
+		History:       converter.ToRatingPoints(ratings, common.LocationFromContext(ctx)),
+	}, nil
+}
+
+func (r *ratingsUseCaseImpl) FindSeasonComparison(ctx context.Context, request *model.SeasonRatingsRequest) (*model.SeasonRatingsResponse, error) {
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		r.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	season, err := r.SeasonsRepo.FindByID(tx, request.SeasonID)
+	if err != nil {
+		r.Log.Errorf("Failed to find season by ID %s: %v", request.SeasonID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Season not found").WithDetail("id", request.SeasonID)
+	}
+
+	starts, err := r.RatingsRepo.FindSeasonStartRatings(tx, season.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season start ratings")
+	}
+
+	teams, err := r.RatingsRepo.FindRatedTeams(tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find team ratings")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		r.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	rated := map[string]entity.Team{}
+	for _, team := range teams {
+		rated[team.ID] = team
+	}
+
+	changes := []model.SeasonRatingChange{}
+	for _, start := range starts {
+		team, ok := rated[start.TeamID]
+		if !ok {
+			continue
+		}
+		changes = append(changes, model.SeasonRatingChange{
+			Team:        model.TeamShort{ID: team.ID, Name: team.Name},
+			SeasonStart: converter.RoundRating(start.Rating),
+			Current:     converter.RoundRating(*team.Rating),
+			Change:      converter.RoundRating(*team.Rating - start.Rating),
+		})
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Change > changes[j].Change
+	})
+
+	return &model.SeasonRatingsResponse{
+		SeasonID:   season.ID,
+		SeasonName: season.Name,
+		Teams:      changes,
+	}, nil
+}
+
+// Recompute drops every rating and replays all completed matches in the order
+// they were played. Run it after the rating parameters change.
+func (r *ratingsUseCaseImpl) Recompute(ctx context.Context) (int, error) {
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := r.RatingsRepo.Reset(tx); err != nil {
+		tx.Rollback()
+		return 0, common.ErrInternalServer("Failed to reset team ratings")
+	}
+
+	matches, err := r.RatingsRepo.FindCompletedMatches(tx)
+	if err != nil {
+		tx.Rollback()
+		return 0, common.ErrInternalServer("Failed to find completed matches")
+	}
+
+	for _, match := range matches {
+		if err := rateMatch(tx, r.RatingsRepo, r.RatingConfig, &match); err != nil {
+			r.Log.Errorf("Failed to rate match %s: %v", match.ID, err)
+			tx.Rollback()
+			return 0, common.ErrInternalServer("Failed to rate match").WithDetail("match_id", match.ID)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		r.Log.Errorf("Failed to commit transaction: %v", err)
+		return 0, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	r.Log.Infof("Recomputed team ratings from %d matches", len(matches))
+	return len(matches), nil
+}
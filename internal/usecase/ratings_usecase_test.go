@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+)
+
+func TestEloChange(t *testing.T) {
+	config := func(homeAdvantage float64, multipliers ...float64) *model.RatingConfig {
+		return &model.RatingConfig{InitialRating: 1500, KFactor: 20, HomeAdvantage: homeAdvantage, GoalDiffMultipliers: multipliers}
+	}
+	defaults := []float64{1, 1, 1.5, 1.75}
+
+	tests := []struct {
+		name         string
+		config       *model.RatingConfig
+		home, away   float64
+		homeGoals    int
+		awayGoals    int
+		wantChange   float64
+		wantExpected float64
+	}{
+		{"equal teams draw", config(0, defaults...), 1500, 1500, 1, 1, 0, 0.5},
+		{"equal teams home win", config(0, defaults...), 1500, 1500, 1, 0, 10, 0.5},
+		{"home advantage two goal win", config(100, defaults...), 1500, 1500, 2, 0, 10.79805, 0.640065},
+		{"home advantage draw", config(100, defaults...), 1500, 1500, 0, 0, -2.8013, 0.640065},
+		{"home advantage away win", config(100, defaults...), 1500, 1500, 0, 1, -12.8013, 0.640065},
+		{"favourite loses by three", config(0, defaults...), 1600, 1400, 0, 3, -26.591142, 0.759747},
+		{"margin beyond the multipliers uses the last", config(100, defaults...), 1400, 1600, 5, 0, 22.402275, 0.359935},
+		{"strong favourite draws", config(100, defaults...), 1700, 1300, 1, 1, -8.935196, 0.94676},
+		{"no multipliers", config(0), 1500, 1500, 4, 0, 10, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, expected := eloChange(tt.config, tt.home, tt.away, tt.homeGoals, tt.awayGoals)
+			if math.Abs(change-tt.wantChange) > 1e-5 {
+				t.Errorf("change = %f, want %f", change, tt.wantChange)
+			}
+			if math.Abs(expected-tt.wantExpected) > 1e-5 {
+				t.Errorf("expected score = %f, want %f", expected, tt.wantExpected)
+			}
+
+			// the away team's view of the same match mirrors the home team's
+			mirrored := &model.RatingConfig{KFactor: tt.config.KFactor, HomeAdvantage: -tt.config.HomeAdvantage, GoalDiffMultipliers: tt.config.GoalDiffMultipliers}
+			awayChange, awayExpected := eloChange(mirrored, tt.away, tt.home, tt.awayGoals, tt.homeGoals)
+			if math.Abs(awayChange+change) > 1e-9 || math.Abs(awayExpected+expected-1) > 1e-9 {
+				t.Errorf("away view = %f, %f, want %f, %f", awayChange, awayExpected, -change, 1-expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,386 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	// goalMilestoneEvery is the career goal count step that is recorded, e.g.
+	// a player's 50th, 100th and 150th goal.
+	goalMilestoneEvery = 50
+	// minUnbeatenRun is the shortest unbeaten run worth recording.
+	minUnbeatenRun = 5
+)
+
+type RecordsUseCase interface {
+	FindAll(ctx context.Context, request *model.RecordRequestFindAll) ([]model.RecordResponse, *model.Pagination, error)
+	FindByID(ctx context.Context, request *model.RecordRequestFindByID) (*model.RecordResponse, error)
+}
+
+type recordsUseCaseImpl struct {
+	RecordsRepo repository.RecordsRepository
+	DB          *gorm.DB
+	Log         *logrus.Logger
+}
+
+func NewRecordsUseCase(recordsRepo repository.RecordsRepository, db *gorm.DB, log *logrus.Logger) RecordsUseCase {
+	return &recordsUseCaseImpl{
+		RecordsRepo: recordsRepo,
+		DB:          db,
+		Log:         log,
+	}
+}
+
+// saveRecord stores a detected record, or updates the one already stored under
+// its key. It returns the event to publish, nil when nothing changed.
+func saveRecord(tx *gorm.DB, repo repository.RecordsRepository, record *entity.Record) (*model.RecordEvent, error) {
+	existing, err := repo.FindByKey(tx, record.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := "record.created"
+	if existing == nil {
+		record.ID = uuid.New().String()
+		if err := repo.Create(tx, record); err != nil {
+			return nil, err
+		}
+	} else {
+		if existing.DeletedAt == nil && existing.Value == record.Value {
+			return nil, nil
+		}
+		if existing.DeletedAt == nil {
+			eventType = "record.updated"
+		}
+		record.ID = existing.ID
+		record.CreatedAt = existing.CreatedAt
+		if err := repo.Update(tx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	return &model.RecordEvent{
+		Type:          eventType,
+		RecordID:      record.ID,
+		RecordType:    record.Type,
+		CompetitionID: record.CompetitionID,
+		TeamID:        record.TeamID,
+		PlayerID:      record.PlayerID,
+		MatchID:       record.MatchID,
+		Value:         record.Value,
+		Description:   record.Description,
+		Time:          time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+func hatTrickDescription(playerName string, goals int64) string {
+	if goals > 3 {
+		return fmt.Sprintf("%s scored %d goals in one match", playerName, goals)
+	}
+	return fmt.Sprintf("%s scored a hat-trick", playerName)
+}
+
+// detectGoalRecords checks a newly recorded goal for a hat-trick, a career
+// goal milestone and the competition's fastest goal.
+func detectGoalRecords(tx *gorm.DB, repo repository.RecordsRepository, match *entity.Match, goal *entity.Goal, player *entity.Player) ([]*model.RecordEvent, error) {
+	var records []*entity.Record
+
+	matchGoals, err := repo.CountPlayerGoals(tx, player.ID, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	if matchGoals >= 3 {
+		records = append(records, &entity.Record{
+			Type:          entity.RecordHatTrick,
+			Key:           fmt.Sprintf("%s:%s:%s", entity.RecordHatTrick, match.ID, player.ID),
+			CompetitionID: match.CompetitionID,
+			TeamID:        &player.TeamID,
+			PlayerID:      &player.ID,
+			MatchID:       &match.ID,
+			Value:         int(matchGoals),
+			Description:   hatTrickDescription(player.Name, matchGoals),
+			Context:       entity.RecordContext{"home_team_id": match.HomeTeamID, "away_team_id": match.AwayTeamID, "goal_time": goal.GoalTime},
+			AchievedAt:    match.KickoffAt,
+		})
+	}
+
+	careerGoals, err := repo.CountPlayerGoals(tx, player.ID, "")
+	if err != nil {
+		return nil, err
+	}
+	if careerGoals > 0 && careerGoals%goalMilestoneEvery == 0 {
+		records = append(records, &entity.Record{
+			Type:          entity.RecordGoalMilestone,
+			Key:           fmt.Sprintf("%s:%s:%d", entity.RecordGoalMilestone, player.ID, careerGoals),
+			CompetitionID: match.CompetitionID,
+			TeamID:        &player.TeamID,
+			PlayerID:      &player.ID,
+			MatchID:       &match.ID,
+			GoalID:        &goal.ID,
+			Value:         int(careerGoals),
+			Description:   fmt.Sprintf("%s scored their %dth goal", player.Name, careerGoals),
+			Context:       entity.RecordContext{"goal_time": goal.GoalTime},
+			AchievedAt:    match.KickoffAt,
+		})
+	}
+
+	// the first goal of a competition is trivially its fastest, so only a goal
+	// beating an earlier one counts
+	fastest, err := repo.FindFastestGoalTime(tx, match.CompetitionID, goal.ID)
+	if err != nil {
+		return nil, err
+	}
+	if fastest != nil && goal.GoalTime < *fastest {
+		records = append(records, &entity.Record{
+			Type:          entity.RecordFastestGoal,
+			Key:           fmt.Sprintf("%s:%s", entity.RecordFastestGoal, goal.ID),
+			CompetitionID: match.CompetitionID,
+			TeamID:        &player.TeamID,
+			PlayerID:      &player.ID,
+			MatchID:       &match.ID,
+			GoalID:        &goal.ID,
+			Value:         int(goal.GoalTime),
+			Description:   fmt.Sprintf("%s scored the fastest goal, after %d minutes", player.Name, goal.GoalTime),
+			Context:       entity.RecordContext{"previous_record": *fastest},
+			AchievedAt:    match.KickoffAt,
+		})
+	}
+
+	var events []*model.RecordEvent
+	for _, record := range records {
+		event, err := saveRecord(tx, repo, record)
+		if err != nil {
+			return nil, err
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// withdrawGoalRecords removes the records a deleted goal no longer supports and
+// brings a surviving hat-trick down to the remaining goal count.
+func withdrawGoalRecords(tx *gorm.DB, repo repository.RecordsRepository, goal *entity.Goal) error {
+	matchGoals, err := repo.CountPlayerGoals(tx, goal.PlayerID, goal.MatchID)
+	if err != nil {
+		return err
+	}
+	careerGoals, err := repo.CountPlayerGoals(tx, goal.PlayerID, "")
+	if err != nil {
+		return err
+	}
+	if err := repo.SoftDeleteForGoal(tx, goal, matchGoals, careerGoals); err != nil {
+		return err
+	}
+
+	if matchGoals >= 3 {
+		hatTrick, err := repo.FindByKey(tx, fmt.Sprintf("%s:%s:%s", entity.RecordHatTrick, goal.MatchID, goal.PlayerID))
+		if err != nil {
+			return err
+		}
+		if hatTrick != nil && hatTrick.DeletedAt == nil && hatTrick.Value != int(matchGoals) {
+			hatTrick.Value = int(matchGoals)
+			if hatTrick.Player != nil {
+				hatTrick.Description = hatTrickDescription(hatTrick.Player.Name, matchGoals)
+			}
+			hatTrick.Player = nil
+			return repo.Update(tx, hatTrick)
+		}
+	}
+	return nil
+}
+
+// detectMatchRecords checks both sides of a finished match for their biggest
+// win and longest unbeaten run.
+func detectMatchRecords(tx *gorm.DB, matchesRepo repository.MatchesRepository, recordsRepo repository.RecordsRepository, match *entity.Match) ([]*model.RecordEvent, error) {
+	var events []*model.RecordEvent
+	for _, teamID := range []string{match.HomeTeamID, match.AwayTeamID} {
+		matches, err := matchesRepo.FindCompletedByTeamID(tx, teamID, nil)
+		if err != nil {
+			return nil, err
+		}
+		results := teamResults(teamID, matches)
+
+		index := -1
+		for i, result := range results {
+			if result.Match.ID == match.ID {
+				index = i
+			}
+		}
+		if index < 0 {
+			continue
+		}
+		result := results[index]
+		team, opponent := result.Match.HomeTeam, result.Match.AwayTeam
+		if !result.Home {
+			team, opponent = opponent, team
+		}
+
+		var records []*entity.Record
+
+		// biggest win, beating the team's widest margin in any other result
+		margin := result.GoalsFor - result.GoalsAgainst
+		best := 0
+		for i, other := range results {
+			if i != index && other.GoalsFor-other.GoalsAgainst > best {
+				best = other.GoalsFor - other.GoalsAgainst
+			}
+		}
+		if best > 0 && margin > best {
+			records = append(records, &entity.Record{
+				Type:          entity.RecordBiggestWin,
+				Key:           fmt.Sprintf("%s:%s:%s", entity.RecordBiggestWin, teamID, match.ID),
+				CompetitionID: match.CompetitionID,
+				TeamID:        &team.ID,
+				MatchID:       &match.ID,
+				Value:         margin,
+				Description:   fmt.Sprintf("%s recorded their biggest win, %d-%d against %s", team.Name, result.GoalsFor, result.GoalsAgainst, opponent.Name),
+				Context:       entity.RecordContext{"opponent_id": opponent.ID, "goals_for": result.GoalsFor, "goals_against": result.GoalsAgainst, "previous_record": best},
+				AchievedAt:    match.KickoffAt,
+			})
+		}
+
+		// unbeaten run ending with this match, beating every earlier run
+		start := index
+		for start > 0 && results[start-1].Outcome() != "L" {
+			start--
+		}
+		run := index - start + 1
+		if result.Outcome() == "L" {
+			run = 0
+		}
+		longest, current := 0, 0
+		for _, earlier := range results[:start] {
+			if earlier.Outcome() == "L" {
+				current = 0
+				continue
+			}
+			current++
+			if current > longest {
+				longest = current
+			}
+		}
+		if run >= minUnbeatenRun && run > longest {
+			records = append(records, &entity.Record{
+				Type:          entity.RecordUnbeatenRun,
+				Key:           fmt.Sprintf("%s:%s:%s", entity.RecordUnbeatenRun, teamID, results[start].Match.ID),
+				CompetitionID: match.CompetitionID,
+				TeamID:        &team.ID,
+				MatchID:       &match.ID,
+				Value:         run,
+				Description:   fmt.Sprintf("%s are unbeaten in %d matches", team.Name, run),
+				Context:       entity.RecordContext{"first_match_id": results[start].Match.ID, "previous_record": longest},
+				AchievedAt:    match.KickoffAt,
+			})
+		}
+
+		for _, record := range records {
+			event, err := saveRecord(tx, recordsRepo, record)
+			if err != nil {
+				return nil, err
+			}
+			if event != nil {
+				events = append(events, event)
+			}
+		}
+	}
+	return events, nil
+}
+
+// publishRecordEvents announces records detected in a committed transaction.
+func publishRecordEvents(producer *messaging.RecordEventProducer, log *logrus.Logger, events []*model.RecordEvent) error {
+	for _, event := range events {
+		log.Infof("Sending record event: %+v", event)
+		if err := producer.Send(event); err != nil {
+			log.Errorf("Failed to send record event: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *recordsUseCaseImpl) FindAll(ctx context.Context, request *model.RecordRequestFindAll) ([]model.RecordResponse, *model.Pagination, error) {
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		r.Log.Warnf("Invalid request body: %+v", err)
+		return nil, nil, err
+	}
+
+	filter := repository.RecordFilter{
+		Type:          request.Type,
+		CompetitionID: request.CompetitionID,
+		TeamID:        request.TeamID,
+		PlayerID:      request.PlayerID,
+	}
+	records, total, err := r.RecordsRepo.FindFiltered(tx, filter, request.Page, request.Limit)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, common.ErrInternalServer("Failed to find records")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		r.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.RecordResponse{}
+	for _, record := range records {
+		responses = append(responses, *converter.ToRecordResponse(&record, common.LocationFromContext(ctx)))
+	}
+
+	pagination := &model.Pagination{
+		Page:       request.Page,
+		Limit:      request.Limit,
+		Total:      total,
+		TotalPages: (total + int64(request.Limit) - 1) / int64(request.Limit),
+	}
+
+	return responses, pagination, nil
+}
+
+func (r *recordsUseCaseImpl) FindByID(ctx context.Context, request *model.RecordRequestFindByID) (*model.RecordResponse, error) {
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		r.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	record, err := r.RecordsRepo.FindByIDWithRelations(tx, request.ID, "Competition", "Team", "Player")
+	if err != nil {
+		r.Log.Errorf("Failed to find record by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Record not found").WithDetail("id", request.ID)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		r.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToRecordResponse(record, common.LocationFromContext(ctx)), nil
+}
@@ -0,0 +1,509 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SchedulingUseCase interface {
+	FindRules(ctx context.Context, request *model.SchedulingRuleRequestFindByCompetitionID) (*model.SchedulingRuleResponse, error)
+	UpdateRules(ctx context.Context, request *model.SchedulingRuleRequestUpdate) (*model.SchedulingRuleResponse, error)
+	FindVenueUnavailabilities(ctx context.Context, request *model.VenueUnavailabilityRequestFindByVenueID) ([]model.VenueUnavailabilityResponse, error)
+	CreateVenueUnavailability(ctx context.Context, request *model.VenueUnavailabilityRequestCreate) (*model.VenueUnavailabilityResponse, error)
+	SoftDeleteVenueUnavailability(ctx context.Context, request *model.VenueUnavailabilityRequestSoftDelete) (*model.VenueUnavailabilityResponse, error)
+	GetSeasonConflicts(ctx context.Context, request *model.SeasonRequestFindByID) ([]model.SchedulingConflictResponse, error)
+}
+
+type schedulingUseCaseImpl struct {
+	SchedulingRepo   repository.SchedulingRepository
+	CompetitionsRepo repository.CompetitionsRepository
+	VenuesRepo       repository.VenuesRepository
+	SeasonsRepo      repository.SeasonsRepository
+	MatchesRepo      repository.MatchesRepository
+	TeamsRepo        repository.TeamsRepository
+	SchedulingConfig *model.SchedulingConfig
+	LogsProducer     *messaging.LogProducer
+	DB               *gorm.DB
+	Log              *logrus.Logger
+}
+
+func NewSchedulingUseCase(schedulingRepo repository.SchedulingRepository, competitionsRepo repository.CompetitionsRepository, venuesRepo repository.VenuesRepository, seasonsRepo repository.SeasonsRepository, matchesRepo repository.MatchesRepository, teamsRepo repository.TeamsRepository, schedulingConfig *model.SchedulingConfig, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) SchedulingUseCase {
+	return &schedulingUseCaseImpl{
+		SchedulingRepo:   schedulingRepo,
+		CompetitionsRepo: competitionsRepo,
+		VenuesRepo:       venuesRepo,
+		SeasonsRepo:      seasonsRepo,
+		MatchesRepo:      matchesRepo,
+		TeamsRepo:        teamsRepo,
+		SchedulingConfig: schedulingConfig,
+		LogsProducer:     logsProducer,
+		DB:               db,
+		Log:              log,
+	}
+}
+
+// findSchedulingRule loads the competition's scheduling rules, falling back to the
+// configured defaults.
+func findSchedulingRule(tx *gorm.DB, repo repository.SchedulingRepository, competitionID *string, config *model.SchedulingConfig) (*entity.CompetitionSchedulingRule, error) {
+	if competitionID == nil {
+		return &entity.CompetitionSchedulingRule{MinRestHours: config.DefaultMinRestHours}, nil
+	}
+
+	rule, err := repo.FindRuleByCompetitionID(tx, *competitionID)
+	if err == gorm.ErrRecordNotFound {
+		return &entity.CompetitionSchedulingRule{CompetitionID: *competitionID, MinRestHours: config.DefaultMinRestHours}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// matchVenueID returns the venue the match is played at. Without an override
+// that is the home team's venue, which may not be set.
+func matchVenueID(tx *gorm.DB, teamsRepo repository.TeamsRepository, match *entity.Match) (*string, error) {
+	if match.VenueID != nil {
+		return match.VenueID, nil
+	}
+	homeTeam, err := teamsRepo.FindByID(tx, match.HomeTeamID)
+	if err != nil {
+		return nil, err
+	}
+	return homeTeam.HomeVenueID, nil
+}
+
+// findSchedulingViolations checks a scheduled match against its competition's
+// scheduling rules: both teams must not play on the same day or within the
+// minimum rest period, the date must not be blacked out and the venue must be
+// available and not booked around the kickoff. Other statuses are not checked.
+func findSchedulingViolations(tx *gorm.DB, schedulingRepo repository.SchedulingRepository, matchesRepo repository.MatchesRepository, teamsRepo repository.TeamsRepository, config *model.SchedulingConfig, match *entity.Match) ([]model.SchedulingViolation, error) {
+	violations := []model.SchedulingViolation{}
+	if match.Status != "scheduled" {
+		return violations, nil
+	}
+
+	rule, err := findSchedulingRule(tx, schedulingRepo, match.CompetitionID, config)
+	if err != nil {
+		return nil, err
+	}
+
+	kickoff := match.KickoffAt
+	minRest := time.Duration(rule.MinRestHours) * time.Hour
+	sides := []struct {
+		Label  string
+		TeamID string
+	}{
+		{"Home team", match.HomeTeamID},
+		{"Away team", match.AwayTeamID},
+	}
+	for _, side := range sides {
+		teamID := side.TeamID
+		others, err := matchesRepo.FindTeamMatchesAround(tx, teamID, match.MatchDate, kickoff, minRest, match.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range others {
+			otherID := other.ID
+			otherKickoff := other.KickoffAt
+			if other.MatchDate.Format("2006-01-02") == match.MatchDate.Format("2006-01-02") {
+				violations = append(violations, model.SchedulingViolation{
+					Type:         "same_day",
+					Message:      fmt.Sprintf("%s already plays on %s at %s", side.Label, other.MatchDate.Format("2006-01-02"), other.MatchTime),
+					TeamID:       &teamID,
+					OtherMatchID: &otherID,
+				})
+				continue
+			}
+			rest := math.Abs(kickoff.Sub(otherKickoff).Hours())
+			violations = append(violations, model.SchedulingViolation{
+				Type:         "rest",
+				Message:      fmt.Sprintf("%s has %.0f hours between this match and the one on %s at %s, %d required", side.Label, rest, other.MatchDate.Format("2006-01-02"), other.MatchTime, rule.MinRestHours),
+				TeamID:       &teamID,
+				OtherMatchID: &otherID,
+			})
+		}
+	}
+
+	if match.CompetitionID != nil {
+		blackout, err := schedulingRepo.FindBlackoutDate(tx, *match.CompetitionID, match.MatchDate)
+		if err != nil {
+			return nil, err
+		}
+		if blackout != nil {
+			message := fmt.Sprintf("%s is a blackout date for the competition", match.MatchDate.Format("2006-01-02"))
+			if blackout.Reason != "" {
+				message = fmt.Sprintf("%s: %s", message, blackout.Reason)
+			}
+			violations = append(violations, model.SchedulingViolation{Type: "blackout", Message: message})
+		}
+	}
+
+	venueID, err := matchVenueID(tx, teamsRepo, match)
+	if err != nil {
+		return nil, err
+	}
+	if venueID == nil {
+		return violations, nil
+	}
+
+	unavailabilities, err := schedulingRepo.FindVenueUnavailabilitiesOnDate(tx, *venueID, match.MatchDate, match.CompetitionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, unavailability := range unavailabilities {
+		message := fmt.Sprintf("Venue is unavailable from %s to %s", unavailability.StartDate.Format("2006-01-02"), unavailability.EndDate.Format("2006-01-02"))
+		if unavailability.Reason != "" {
+			message = fmt.Sprintf("%s: %s", message, unavailability.Reason)
+		}
+		violations = append(violations, model.SchedulingViolation{Type: "venue_unavailable", Message: message, VenueID: venueID})
+	}
+
+	booked, err := matchesRepo.FindVenueConflicts(tx, *venueID, kickoff, config.VenueBuffer, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range booked {
+		otherID := other.ID
+		violations = append(violations, model.SchedulingViolation{
+			Type:         "venue_booked",
+			Message:      fmt.Sprintf("Venue is already booked for a match on %s at %s", other.MatchDate.Format("2006-01-02"), other.MatchTime),
+			OtherMatchID: &otherID,
+			VenueID:      venueID,
+		})
+	}
+
+	return violations, nil
+}
+
+// schedulingConflictError turns violations into the error returned when a match
+// cannot be scheduled.
+func schedulingConflictError(violations []model.SchedulingViolation) error {
+	details := make([]common.ErrorDetail, len(violations))
+	for i, violation := range violations {
+		details[i] = common.ErrorDetail{Field: violation.Type, Message: violation.Message}
+	}
+	return common.ErrConflict("Match violates scheduling constraints").WithDetails(details)
+}
+
+func (s *schedulingUseCaseImpl) FindRules(ctx context.Context, request *model.SchedulingRuleRequestFindByCompetitionID) (*model.SchedulingRuleResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.CompetitionsRepo.FindByID(tx, request.CompetitionID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.CompetitionID)
+	}
+
+	rule, err := findSchedulingRule(tx, s.SchedulingRepo, &request.CompetitionID, s.SchedulingConfig)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find scheduling rules")
+	}
+
+	blackoutDates, err := s.SchedulingRepo.FindBlackoutDates(tx, request.CompetitionID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find blackout dates")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToSchedulingRuleResponse(rule, blackoutDates), nil
+}
+
+func (s *schedulingUseCaseImpl) UpdateRules(ctx context.Context, request *model.SchedulingRuleRequestUpdate) (*model.SchedulingRuleResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.CompetitionsRepo.FindByID(tx, request.CompetitionID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Competition not found").WithDetail("id", request.CompetitionID)
+	}
+
+	rule, err := findSchedulingRule(tx, s.SchedulingRepo, &request.CompetitionID, s.SchedulingConfig)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find scheduling rules")
+	}
+
+	if request.MinRestHours != nil {
+		rule.MinRestHours = *request.MinRestHours
+	}
+
+	if err := s.SchedulingRepo.SaveRule(tx, rule); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to save scheduling rules")
+	}
+
+	if request.BlackoutDates != nil {
+		var dates []entity.CompetitionBlackoutDate
+		seen := map[string]bool{}
+		for _, item := range *request.BlackoutDates {
+			if seen[item.Date] {
+				tx.Rollback()
+				return nil, common.ErrInvalidInput("Blackout date is listed more than once").WithDetail("blackout_dates", item.Date)
+			}
+			seen[item.Date] = true
+			dates = append(dates, entity.CompetitionBlackoutDate{
+				CompetitionID: rule.CompetitionID,
+				BlackoutDate:  common.ConvertStringToDate(item.Date),
+				Reason:        item.Reason,
+			})
+		}
+		if err := s.SchedulingRepo.ReplaceBlackoutDates(tx, rule.CompetitionID, dates); err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to save blackout dates")
+		}
+	}
+
+	blackoutDates, err := s.SchedulingRepo.FindBlackoutDates(tx, rule.CompetitionID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find blackout dates")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Scheduling rules for competition %s updated successfully", rule.CompetitionID),
+		Service: "scheduling",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToSchedulingRuleResponse(rule, blackoutDates), nil
+}
+
+func (s *schedulingUseCaseImpl) FindVenueUnavailabilities(ctx context.Context, request *model.VenueUnavailabilityRequestFindByVenueID) ([]model.VenueUnavailabilityResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.VenuesRepo.FindByID(tx, request.VenueID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Venue not found").WithDetail("id", request.VenueID)
+	}
+
+	unavailabilities, err := s.SchedulingRepo.FindVenueUnavailabilities(tx, request.VenueID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find venue unavailability")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.VenueUnavailabilityResponse{}
+	for _, unavailability := range unavailabilities {
+		responses = append(responses, *converter.ToVenueUnavailabilityResponse(&unavailability))
+	}
+
+	return responses, nil
+}
+
+func (s *schedulingUseCaseImpl) CreateVenueUnavailability(ctx context.Context, request *model.VenueUnavailabilityRequestCreate) (*model.VenueUnavailabilityResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.VenuesRepo.FindByID(tx, request.VenueID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Venue not found").WithDetail("id", request.VenueID)
+	}
+
+	unavailability := &entity.VenueUnavailability{
+		ID:        uuid.New().String(),
+		VenueID:   request.VenueID,
+		StartDate: common.ConvertStringToDate(request.StartDate),
+		EndDate:   common.ConvertStringToDate(request.EndDate),
+		Reason:    request.Reason,
+	}
+
+	if unavailability.EndDate.Before(unavailability.StartDate) {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("End date cannot be before the start date").WithDetail("end_date", request.EndDate)
+	}
+
+	if request.CompetitionID != "" {
+		if _, err := s.CompetitionsRepo.FindByID(tx, request.CompetitionID); err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Competition not found").WithDetail("competition_id", request.CompetitionID)
+		}
+		unavailability.CompetitionID = &request.CompetitionID
+	}
+
+	if err := s.SchedulingRepo.CreateVenueUnavailability(tx, unavailability); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to create venue unavailability")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Venue %s marked unavailable from %s to %s", unavailability.VenueID, request.StartDate, request.EndDate),
+		Service: "scheduling",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToVenueUnavailabilityResponse(unavailability), nil
+}
+
+func (s *schedulingUseCaseImpl) SoftDeleteVenueUnavailability(ctx context.Context, request *model.VenueUnavailabilityRequestSoftDelete) (*model.VenueUnavailabilityResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	unavailability, err := s.SchedulingRepo.FindVenueUnavailabilityByID(tx, request.ID)
+	if err != nil || unavailability.VenueID != request.VenueID {
+		s.Log.Errorf("Failed to find unavailability %s for venue %s: %v", request.ID, request.VenueID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Venue unavailability not found").WithDetail("id", request.ID)
+	}
+
+	if err := s.SchedulingRepo.SoftDeleteVenueUnavailability(tx, unavailability.ID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to delete venue unavailability")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Venue unavailability with ID %s soft deleted successfully", unavailability.ID),
+		Service: "scheduling",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToVenueUnavailabilityResponse(unavailability), nil
+}
+
+// GetSeasonConflicts re-checks every scheduled match of the season's
+// competitions and lists the ones that break a constraint. A clash between two
+// matches is reported on both of them.
+func (s *schedulingUseCaseImpl) GetSeasonConflicts(ctx context.Context, request *model.SeasonRequestFindByID) ([]model.SchedulingConflictResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.SeasonsRepo.FindByID(tx, request.ID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Season not found").WithDetail("id", request.ID)
+	}
+
+	matches, err := s.MatchesRepo.FindScheduledBySeasonID(tx, request.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find season matches")
+	}
+
+	responses := []model.SchedulingConflictResponse{}
+	for _, match := range matches {
+		violations, err := findSchedulingViolations(tx, s.SchedulingRepo, s.MatchesRepo, s.TeamsRepo, s.SchedulingConfig, &match)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to check scheduling constraints")
+		}
+		if len(violations) > 0 {
+			responses = append(responses, *converter.ToSchedulingConflictResponse(&match, violations, common.LocationFromContext(ctx)))
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,274 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SeasonsUseCase interface {
+	FindAll(ctx context.Context) ([]model.SeasonResponse, error)
+	FindByID(ctx context.Context, request *model.SeasonRequestFindByID) (*model.SeasonResponse, error)
+	Create(ctx context.Context, request *model.SeasonRequestCreate) (*model.SeasonResponse, error)
+	Update(ctx context.Context, request *model.SeasonRequestUpdate) (*model.SeasonResponse, error)
+	SoftDelete(ctx context.Context, request *model.SeasonRequestSoftDelete) (*model.SeasonResponse, error)
+}
+
+type seasonsUseCaseImpl struct {
+	SeasonsRepo  repository.SeasonsRepository
+	LogsProducer *messaging.LogProducer
+	DB           *gorm.DB
+	Log          *logrus.Logger
+}
+
+func NewSeasonsUseCase(seasonsRepo repository.SeasonsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) SeasonsUseCase {
+	return &seasonsUseCaseImpl{
+		SeasonsRepo:  seasonsRepo,
+		LogsProducer: logsProducer,
+		DB:           db,
+		Log:          log,
+	}
+}
+
+func (s *seasonsUseCaseImpl) FindAll(ctx context.Context) ([]model.SeasonResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	seasons, err := s.SeasonsRepo.FindAll(tx)
+	if err != nil {
+		s.Log.Errorf("Failed to find all seasons: %v", err)
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find seasons")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.SeasonResponse{}
+	for _, season := range seasons {
+		responses = append(responses, *converter.ToSeasonResponse(&season))
+	}
+
+	return responses, nil
+}
+
+func (s *seasonsUseCaseImpl) FindByID(ctx context.Context, request *model.SeasonRequestFindByID) (*model.SeasonResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	season, err := s.SeasonsRepo.FindByIDWithCompetitions(tx, request.ID)
+	if err != nil {
+		s.Log.Errorf("Failed to find season by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Season not found").WithDetail("id", request.ID)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToSeasonResponse(season), nil
+}
+
+func (s *seasonsUseCaseImpl) Create(ctx context.Context, request *model.SeasonRequestCreate) (*model.SeasonResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	season := &entity.Season{
+		ID:        uuid.New().String(),
+		Name:      request.Name,
+		StartDate: common.ConvertStringToDate(request.StartDate),
+		EndDate:   common.ConvertStringToDate(request.EndDate),
+	}
+
+	if season.EndDate.Before(season.StartDate) {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("End date cannot be before the start date").WithDetail("end_date", request.EndDate)
+	}
+
+	exists, err := s.SeasonsRepo.CheckSeasonExistsByName(tx, season.Name)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check season existence")
+	}
+	if exists {
+		tx.Rollback()
+		return nil, common.ErrConflict("Season with this name already exists").WithDetail("name", season.Name)
+	}
+
+	if err := s.SeasonsRepo.Create(tx, season); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to create season: %v", err)
+		return nil, common.ErrInternalServer("Failed to create season")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Season %s created successfully", season.Name),
+		Service: "seasons",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToSeasonResponse(season), nil
+}
+
+func (s *seasonsUseCaseImpl) Update(ctx context.Context, request *model.SeasonRequestUpdate) (*model.SeasonResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	season, err := s.SeasonsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		s.Log.Errorf("Failed to find season by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Season not found").WithDetail("id", request.ID)
+	}
+
+	if request.Name != "" && request.Name != season.Name {
+		exists, err := s.SeasonsRepo.CheckSeasonExistsByName(tx, request.Name)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to check season existence")
+		}
+		if exists {
+			tx.Rollback()
+			return nil, common.ErrConflict("Season with this name already exists").WithDetail("name", request.Name)
+		}
+		season.Name = request.Name
+	}
+	if request.StartDate != "" {
+		season.StartDate = common.ConvertStringToDate(request.StartDate)
+	}
+	if request.EndDate != "" {
+		season.EndDate = common.ConvertStringToDate(request.EndDate)
+	}
+
+	if season.EndDate.Before(season.StartDate) {
+		tx.Rollback()
+		return nil, common.ErrInvalidInput("End date cannot be before the start date").WithDetail("end_date", season.EndDate.Format("2006-01-02"))
+	}
+
+	if err := s.SeasonsRepo.Update(tx, season); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to update season: %v", err)
+		return nil, common.ErrInternalServer("Failed to update season")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Season with ID %s updated successfully", season.ID),
+		Service: "seasons",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToSeasonResponse(season), nil
+}
+
+func (s *seasonsUseCaseImpl) SoftDelete(ctx context.Context, request *model.SeasonRequestSoftDelete) (*model.SeasonResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	season, err := s.SeasonsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		s.Log.Errorf("Failed to find season by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Season not found").WithDetail("id", request.ID)
+	}
+
+	if err := s.SeasonsRepo.SoftDelete(tx, season.ID); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to soft delete season: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete season")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Season with ID %s soft deleted successfully", season.ID),
+		Service: "seasons",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToSeasonResponse(season), nil
+}
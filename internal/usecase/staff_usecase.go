@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type StaffUseCase interface {
+	FindByTeamID(ctx context.Context, request *model.StaffRequestFindByTeamID) ([]model.StaffResponse, error)
+	Create(ctx context.Context, request *model.StaffRequestCreate) (*model.StaffResponse, error)
+	Update(ctx context.Context, request *model.StaffRequestUpdate) (*model.StaffResponse, error)
+	SoftDelete(ctx context.Context, request *model.StaffRequestSoftDelete) (*model.StaffResponse, error)
+}
+
+type staffUseCaseImpl struct {
+	StaffRepo    repository.StaffRepository
+	TeamsRepo    repository.TeamsRepository
+	LogsProducer *messaging.LogProducer
+	DB           *gorm.DB
+	Log          *logrus.Logger
+}
+
+func NewStaffUseCase(staffRepo repository.StaffRepository, teamsRepo repository.TeamsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) StaffUseCase {
+	return &staffUseCaseImpl{
+		StaffRepo:    staffRepo,
+		TeamsRepo:    teamsRepo,
+		LogsProducer: logsProducer,
+		DB:           db,
+		Log:          log,
+	}
+}
+
+// checkTenure validates the tenure dates and makes sure a team never has two
+// head coaches at the same time.
+func (s *staffUseCaseImpl) checkTenure(tx *gorm.DB, staff *entity.StaffMember) error {
+	if staff.EndDate != nil && staff.EndDate.Before(staff.StartDate) {
+		return common.ErrInvalidInput("End date cannot be before the start date").WithDetail("end_date", staff.EndDate.Format("2006-01-02"))
+	}
+
+	if staff.Role != "head_coach" {
+		return nil
+	}
+
+	count, err := s.StaffRepo.CountOverlappingTenures(tx, staff)
+	if err != nil {
+		return common.ErrInternalServer("Failed to check staff tenure")
+	}
+	if count > 0 {
+		return common.ErrConflict("Team already has a head coach during this tenure").WithDetail("start_date", staff.StartDate.Format("2006-01-02"))
+	}
+
+	return nil
+}
+
+func (s *staffUseCaseImpl) FindByTeamID(ctx context.Context, request *model.StaffRequestFindByTeamID) ([]model.StaffResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.TeamsRepo.FindByID(tx, request.TeamID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	staff, err := s.StaffRepo.FindByTeamID(tx, request.TeamID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find staff")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.StaffResponse{}
+	for _, member := range staff {
+		responses = append(responses, *converter.ToStaffResponse(&member))
+	}
+
+	return responses, nil
+}
+
+func (s *staffUseCaseImpl) Create(ctx context.Context, request *model.StaffRequestCreate) (*model.StaffResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.TeamsRepo.FindByID(tx, request.TeamID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.TeamID)
+	}
+
+	staff := &entity.StaffMember{
+		ID:          uuid.New().String(),
+		TeamID:      request.TeamID,
+		Name:        request.Name,
+		Role:        request.Role,
+		Nationality: request.Nationality,
+		StartDate:   common.ConvertStringToDate(request.StartDate),
+		EndDate:     common.ConvertStringToDatePointer(request.EndDate),
+	}
+
+	if err := s.checkTenure(tx, staff); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := s.StaffRepo.Create(tx, staff); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to create staff member: %v", err)
+		return nil, common.ErrInternalServer("Failed to create staff member")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Staff member %s added to team %s as %s", staff.Name, staff.TeamID, staff.Role),
+		Service: "staff",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToStaffResponse(staff), nil
+}
+
+func (s *staffUseCaseImpl) Update(ctx context.Context, request *model.StaffRequestUpdate) (*model.StaffResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	staff, err := s.StaffRepo.FindByID(tx, request.ID)
+	if err != nil || staff.TeamID != request.TeamID {
+		s.Log.Errorf("Failed to find staff member %s in team %s: %v", request.ID, request.TeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Staff member not found").WithDetail("id", request.ID)
+	}
+
+	if request.Name != "" {
+		staff.Name = request.Name
+	}
+	if request.Role != "" {
+		staff.Role = request.Role
+	}
+	if request.Nationality != "" {
+		staff.Nationality = request.Nationality
+	}
+	if request.StartDate != "" {
+		staff.StartDate = common.ConvertStringToDate(request.StartDate)
+	}
+	if request.EndDate != "" {
+		staff.EndDate = common.ConvertStringToDatePointer(request.EndDate)
+	}
+
+	if err := s.checkTenure(tx, staff); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := s.StaffRepo.Update(tx, staff); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to update staff member: %v", err)
+		return nil, common.ErrInternalServer("Failed to update staff member")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Staff member with ID %s updated successfully", staff.ID),
+		Service: "staff",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToStaffResponse(staff), nil
+}
+
+func (s *staffUseCaseImpl) SoftDelete(ctx context.Context, request *model.StaffRequestSoftDelete) (*model.StaffResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	staff, err := s.StaffRepo.FindByID(tx, request.ID)
+	if err != nil || staff.TeamID != request.TeamID {
+		s.Log.Errorf("Failed to find staff member %s in team %s: %v", request.ID, request.TeamID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Staff member not found").WithDetail("id", request.ID)
+	}
+
+	if err := s.StaffRepo.SoftDelete(tx, staff.ID); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to soft delete staff member: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete staff member")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Staff member with ID %s soft deleted successfully", staff.ID),
+		Service: "staff",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToStaffResponse(staff), nil
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SuspensionsUseCase interface {
+	FindByPlayerID(ctx context.Context, request *model.SuspensionRequestFindByPlayerID) ([]model.SuspensionResponse, error)
+	Create(ctx context.Context, request *model.SuspensionRequestCreate) (*model.SuspensionResponse, error)
+	SoftDelete(ctx context.Context, request *model.SuspensionRequestSoftDelete) (*model.SuspensionResponse, error)
+	GetAvailability(ctx context.Context, request *model.AvailabilityRequest) (*model.AvailabilityResponse, error)
+}
+
+type suspensionsUseCaseImpl struct {
+	SuspensionsRepo  repository.SuspensionsRepository
+	InjuriesRepo     repository.InjuriesRepository
+	PlayersRepo      repository.PlayersRepository
+	CompetitionsRepo repository.CompetitionsRepository
+	LogsProducer     *messaging.LogProducer
+	DB               *gorm.DB
+	Log              *logrus.Logger
+}
+
+func NewSuspensionsUseCase(suspensionsRepo repository.SuspensionsRepository, injuriesRepo repository.InjuriesRepository, playersRepo repository.PlayersRepository, competitionsRepo repository.CompetitionsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) SuspensionsUseCase {
+	return &suspensionsUseCaseImpl{
+		SuspensionsRepo:  suspensionsRepo,
+		InjuriesRepo:     injuriesRepo,
+		PlayersRepo:      playersRepo,
+		CompetitionsRepo: competitionsRepo,
+		LogsProducer:     logsProducer,
+		DB:               db,
+		Log:              log,
+	}
+}
+
+func (s *suspensionsUseCaseImpl) FindByPlayerID(ctx context.Context, request *model.SuspensionRequestFindByPlayerID) ([]model.SuspensionResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.PlayersRepo.FindByID(tx, request.PlayerID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Player not found").WithDetail("id", request.PlayerID)
+	}
+
+	suspensions, err := s.SuspensionsRepo.FindByPlayerID(tx, request.PlayerID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find suspensions")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.SuspensionResponse{}
+	for _, suspension := range suspensions {
+		responses = append(responses, *converter.ToSuspensionResponse(&suspension))
+	}
+
+	return responses, nil
+}
+
+func (s *suspensionsUseCaseImpl) Create(ctx context.Context, request *model.SuspensionRequestCreate) (*model.SuspensionResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.PlayersRepo.FindByID(tx, request.PlayerID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Player not found").WithDetail("id", request.PlayerID)
+	}
+
+	suspension := &entity.PlayerSuspension{
+		ID:               uuid.New().String(),
+		PlayerID:         request.PlayerID,
+		Reason:           request.Reason,
+		MatchesRemaining: request.MatchesRemaining,
+	}
+
+	if request.CompetitionID != "" {
+		if _, err := s.CompetitionsRepo.FindByID(tx, request.CompetitionID); err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Competition not found").WithDetail("competition_id", request.CompetitionID)
+		}
+		suspension.CompetitionID = &request.CompetitionID
+	}
+
+	if err := s.SuspensionsRepo.Create(tx, suspension); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to create suspension: %v", err)
+		return nil, common.ErrInternalServer("Failed to create suspension")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Suspension of %d match(es) recorded for player %s", suspension.MatchesRemaining, suspension.PlayerID),
+		Service: "suspensions",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToSuspensionResponse(suspension), nil
+}
+
+func (s *suspensionsUseCaseImpl) SoftDelete(ctx context.Context, request *model.SuspensionRequestSoftDelete) (*model.SuspensionResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	suspension, err := s.SuspensionsRepo.FindByID(tx, request.ID)
+	if err != nil {
+		s.Log.Errorf("Failed to find suspension by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Suspension not found").WithDetail("id", request.ID)
+	}
+
+	if err := s.SuspensionsRepo.SoftDelete(tx, suspension.ID); err != nil {
+		tx.Rollback()
+		s.Log.Errorf("Failed to soft delete suspension: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete suspension")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Suspension with ID %s soft deleted successfully", suspension.ID),
+		Service: "suspensions",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	s.Log.Infof("Sending log event: %+v", logEvent)
+	if err := s.LogsProducer.Send(logEvent); err != nil {
+		s.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToSuspensionResponse(suspension), nil
+}
+
+func (s *suspensionsUseCaseImpl) GetAvailability(ctx context.Context, request *model.AvailabilityRequest) (*model.AvailabilityResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		s.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	if _, err := s.PlayersRepo.FindByID(tx, request.PlayerID); err != nil {
+		tx.Rollback()
+		return nil, common.ErrNotFound("Player not found").WithDetail("id", request.PlayerID)
+	}
+
+	var competitionID *string
+	if request.CompetitionID != "" {
+		competitionID = &request.CompetitionID
+	}
+
+	date := common.ConvertStringToDate(request.Date)
+	reasons, injuries, suspensions, err := checkPlayerAvailability(tx, s.InjuriesRepo, s.SuspensionsRepo, request.PlayerID, date, competitionID)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check player availability")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToAvailabilityResponse(request.PlayerID, date, reasons, injuries, suspensions), nil
+}
@@ -16,8 +16,12 @@ import (
 	"gorm.io/gorm"
 )
 
+// exportBatchSize is how many rows an export reads from the database at once.
+const exportBatchSize = 500
+
 type TeamsUseCase interface {
-	FindAll(ctx context.Context) ([]model.TeamResponse, error)
+	FindAll(ctx context.Context, request *model.TeamRequestFindAll) ([]model.TeamResponse, error)
+	Export(ctx context.Context, w common.ExportWriter) error
 	FindByID(ctx context.Context, request *model.TeamRequestFindByID) (*model.TeamResponse, error)
 	Create(ctx context.Context, request *model.TeamRequestCreate) (*model.TeamResponse, error)
 	Update(ctx context.Context, request *model.TeamRequestUpdate) (*model.TeamResponse, error)
@@ -27,21 +31,25 @@ type TeamsUseCase interface {
 
 type teamsUseCaseImpl struct {
 	TeamsRepo    repository.TeamsRepository
+	StaffRepo    repository.StaffRepository
+	VenuesRepo   repository.VenuesRepository
 	LogsProducer *messaging.LogProducer
 	DB           *gorm.DB
 	Log          *logrus.Logger
 }
 
-func NewTeamsUseCase(teamsRepo repository.TeamsRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) TeamsUseCase {
+func NewTeamsUseCase(teamsRepo repository.TeamsRepository, staffRepo repository.StaffRepository, venuesRepo repository.VenuesRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) TeamsUseCase {
 	return &teamsUseCaseImpl{
 		TeamsRepo:    teamsRepo,
+		StaffRepo:    staffRepo,
+		VenuesRepo:   venuesRepo,
 		LogsProducer: logsProducer,
 		DB:           db,
 		Log:          log,
 	}
 }
 
-func (t *teamsUseCaseImpl) FindAll(ctx context.Context) ([]model.TeamResponse, error) {
+func (t *teamsUseCaseImpl) FindAll(ctx context.Context, request *model.TeamRequestFindAll) ([]model.TeamResponse, error) {
 	tx := t.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -56,6 +64,25 @@ func (t *teamsUseCaseImpl) FindAll(ctx context.Context) ([]model.TeamResponse, e
 		return nil, common.ErrInternalServer("Failed to find teams")
 	}
 
+	if request.IncludeStaff {
+		teamIDs := make([]string, len(teams))
+		for i, team := range teams {
+			teamIDs[i] = team.ID
+		}
+		staff, err := t.StaffRepo.FindByTeamIDs(tx, teamIDs)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to find staff")
+		}
+		staffByTeam := map[string][]entity.StaffMember{}
+		for _, member := range staff {
+			staffByTeam[member.TeamID] = append(staffByTeam[member.TeamID], member)
+		}
+		for i := range teams {
+			teams[i].Staff = staffByTeam[teams[i].ID]
+		}
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		t.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
@@ -69,6 +96,41 @@ func (t *teamsUseCaseImpl) FindAll(ctx context.Context) ([]model.TeamResponse, e
 	return responses, nil
 }
 
+// Export streams every team to w, reading exportBatchSize rows at a time.
+func (t *teamsUseCaseImpl) Export(ctx context.Context, w common.ExportWriter) error {
+	tx := t.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := w.Write(converter.TeamExportHeader...); err != nil {
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to write teams export")
+	}
+	err := t.TeamsRepo.FindAllInBatches(tx, exportBatchSize, func(batch []entity.Team) error {
+		for _, team := range batch {
+			if err := w.Write(converter.ToTeamExportRow(converter.ToTeamResponse(&team))...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Log.Errorf("Failed to export teams: %v", err)
+		tx.Rollback()
+		return common.ErrInternalServer("Failed to export teams")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		t.Log.Errorf("Failed to commit transaction: %v", err)
+		return common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return nil
+}
+
 func (t *teamsUseCaseImpl) FindByID(ctx context.Context, request *model.TeamRequestFindByID) (*model.TeamResponse, error) {
 	tx := t.DB.WithContext(ctx).Begin()
 	defer func() {
@@ -90,6 +152,14 @@ func (t *teamsUseCaseImpl) FindByID(ctx context.Context, request *model.TeamRequ
 		return nil, common.ErrNotFound("Team not found").WithDetail("id", request.ID)
 	}
 
+	if request.IncludeStaff {
+		team.Staff, err = t.StaffRepo.FindByTeamID(tx, team.ID)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to find staff")
+		}
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		t.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
@@ -149,6 +219,14 @@ func (t *teamsUseCaseImpl) Create(ctx context.Context, request *model.TeamReques
 		return nil, common.ErrInvalidInput("Invalid founded year").WithDetail("year", fmt.Sprintf("%d", team.FoundedYear))
 	}
 
+	if request.HomeVenueID != "" {
+		if _, err := t.VenuesRepo.FindByID(tx, request.HomeVenueID); err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Venue not found").WithDetail("home_venue_id", request.HomeVenueID)
+		}
+		team.HomeVenueID = &request.HomeVenueID
+	}
+
 	if err := t.TeamsRepo.Create(tx, team); err != nil {
 		tx.Rollback()
 		t.Log.Errorf("Failed to create team: %v", err)
@@ -259,6 +337,22 @@ func (t *teamsUseCaseImpl) Update(ctx context.Context, request *model.TeamReques
 	if request.HeadquartersCity != "" {
 		team.HeadquartersCity = request.HeadquartersCity
 	}
+	var newHomeVenue *entity.Venue
+	if request.HomeVenueID != "" {
+		venue, err := t.VenuesRepo.FindByID(tx, request.HomeVenueID)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrNotFound("Venue not found").WithDetail("home_venue_id", request.HomeVenueID)
+		}
+		if team.HomeVenueID == nil || *team.HomeVenueID != venue.ID {
+			newHomeVenue = venue
+		}
+		team.HomeVenueID = &request.HomeVenueID
+	}
+	// an imported placeholder becomes a full team once it has what creating one requires
+	if team.Placeholder {
+		team.Placeholder = team.FoundedYear == 0 || team.HeadquartersAddress == "" || team.HeadquartersCity == ""
+	}
 
 	if err := t.TeamsRepo.Update(tx, team); err != nil {
 		tx.Rollback()
@@ -266,6 +360,17 @@ func (t *teamsUseCaseImpl) Update(ctx context.Context, request *model.TeamReques
 		return nil, common.ErrInternalServer("Failed to update team")
 	}
 
+	// home matches without a venue override are played at the new ground:
+	// they keep their local kickoff times in its timezone
+	if newHomeVenue != nil {
+		moved, err := t.VenuesRepo.UpdateHomeMatchTimezones(tx, team.ID, newHomeVenue.Timezone)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to update match kickoffs")
+		}
+		t.Log.Infof("Moved %d home matches of team %s to timezone %s", moved, team.ID, newHomeVenue.Timezone)
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		t.Log.Errorf("Failed to commit transaction: %v", err)
 		return nil, common.ErrInternalServer("Failed to commit transaction")
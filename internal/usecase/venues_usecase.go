@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fadlihardiyanto/football-api/internal/common"
+	"github.com/Fadlihardiyanto/football-api/internal/entity"
+	messaging "github.com/Fadlihardiyanto/football-api/internal/gateway"
+	"github.com/Fadlihardiyanto/football-api/internal/model"
+	"github.com/Fadlihardiyanto/football-api/internal/model/converter"
+	"github.com/Fadlihardiyanto/football-api/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type VenuesUseCase interface {
+	FindAll(ctx context.Context) ([]model.VenueResponse, error)
+	FindByID(ctx context.Context, request *model.VenueRequestFindByID) (*model.VenueResponse, error)
+	Create(ctx context.Context, request *model.VenueRequestCreate) (*model.VenueResponse, error)
+	Update(ctx context.Context, request *model.VenueRequestUpdate) (*model.VenueResponse, error)
+	SoftDelete(ctx context.Context, request *model.VenueRequestSoftDelete) (*model.VenueResponse, error)
+}
+
+type venuesUseCaseImpl struct {
+	VenuesRepo   repository.VenuesRepository
+	LogsProducer *messaging.LogProducer
+	DB           *gorm.DB
+	Log          *logrus.Logger
+}
+
+func NewVenuesUseCase(venuesRepo repository.VenuesRepository, logsProducer *messaging.LogProducer, db *gorm.DB, log *logrus.Logger) VenuesUseCase {
+	return &venuesUseCaseImpl{
+		VenuesRepo:   venuesRepo,
+		LogsProducer: logsProducer,
+		DB:           db,
+		Log:          log,
+	}
+}
+
+func (v *venuesUseCaseImpl) FindAll(ctx context.Context) ([]model.VenueResponse, error) {
+	tx := v.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	venues, err := v.VenuesRepo.FindAll(tx)
+	if err != nil {
+		v.Log.Errorf("Failed to find all venues: %v", err)
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to find venues")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		v.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	responses := []model.VenueResponse{}
+	for _, venue := range venues {
+		responses = append(responses, *converter.ToVenueResponse(&venue))
+	}
+
+	return responses, nil
+}
+
+func (v *venuesUseCaseImpl) FindByID(ctx context.Context, request *model.VenueRequestFindByID) (*model.VenueResponse, error) {
+	tx := v.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		v.Log.Warnf("Invalid request body: %+v", err)
+		return nil, err
+	}
+
+	venue, err := v.VenuesRepo.FindByID(tx, request.ID)
+	if err != nil {
+		v.Log.Errorf("Failed to find venue by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Venue not found").WithDetail("id", request.ID)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		v.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	return converter.ToVenueResponse(venue), nil
+}
+
+func (v *venuesUseCaseImpl) Create(ctx context.Context, request *model.VenueRequestCreate) (*model.VenueResponse, error) {
+	tx := v.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		v.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	venue := &entity.Venue{
+		ID:          uuid.New().String(),
+		Name:        request.Name,
+		City:        request.City,
+		Capacity:    request.Capacity,
+		SurfaceType: request.SurfaceType,
+		Latitude:    request.Latitude,
+		Longitude:   request.Longitude,
+		Timezone:    request.Timezone,
+	}
+
+	exists, err := v.VenuesRepo.CheckVenueExistsByNameAndCity(tx, venue.Name, venue.City)
+	if err != nil {
+		tx.Rollback()
+		return nil, common.ErrInternalServer("Failed to check venue existence")
+	}
+	if exists {
+		tx.Rollback()
+		return nil, common.ErrConflict("Venue with this name already exists in the city").WithDetail("name", venue.Name)
+	}
+
+	if err := v.VenuesRepo.Create(tx, venue); err != nil {
+		tx.Rollback()
+		v.Log.Errorf("Failed to create venue: %v", err)
+		return nil, common.ErrInternalServer("Failed to create venue")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		v.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Venue %s created successfully", venue.Name),
+		Service: "venues",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	v.Log.Infof("Sending log event: %+v", logEvent)
+	if err := v.LogsProducer.Send(logEvent); err != nil {
+		v.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToVenueResponse(venue), nil
+}
+
+func (v *venuesUseCaseImpl) Update(ctx context.Context, request *model.VenueRequestUpdate) (*model.VenueResponse, error) {
+	tx := v.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		v.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	venue, err := v.VenuesRepo.FindByID(tx, request.ID)
+	if err != nil {
+		v.Log.Errorf("Failed to find venue by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Venue not found").WithDetail("id", request.ID)
+	}
+
+	if (request.Name != "" && request.Name != venue.Name) || (request.City != "" && request.City != venue.City) {
+		name, city := venue.Name, venue.City
+		if request.Name != "" {
+			name = request.Name
+		}
+		if request.City != "" {
+			city = request.City
+		}
+		exists, err := v.VenuesRepo.CheckVenueExistsByNameAndCity(tx, name, city)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to check venue existence")
+		}
+		if exists {
+			tx.Rollback()
+			return nil, common.ErrConflict("Venue with this name already exists in the city").WithDetail("name", name)
+		}
+		venue.Name, venue.City = name, city
+	}
+	if request.Capacity != nil {
+		venue.Capacity = *request.Capacity
+	}
+	if request.SurfaceType != "" {
+		venue.SurfaceType = request.SurfaceType
+	}
+	if request.Latitude != nil {
+		venue.Latitude = request.Latitude
+	}
+	if request.Longitude != nil {
+		venue.Longitude = request.Longitude
+	}
+	timezoneChanged := request.Timezone != "" && request.Timezone != venue.Timezone
+	if request.Timezone != "" {
+		venue.Timezone = request.Timezone
+	}
+
+	if err := v.VenuesRepo.Update(tx, venue); err != nil {
+		tx.Rollback()
+		v.Log.Errorf("Failed to update venue: %v", err)
+		return nil, common.ErrInternalServer("Failed to update venue")
+	}
+
+	// a new timezone corrects the venue's zone: its matches keep their local
+	// kickoff times and move to the instants those mean in the new zone
+	if timezoneChanged {
+		moved, err := v.VenuesRepo.UpdateMatchTimezones(tx, venue.ID, venue.Timezone)
+		if err != nil {
+			tx.Rollback()
+			return nil, common.ErrInternalServer("Failed to update match kickoffs")
+		}
+		v.Log.Infof("Moved %d matches at venue %s to timezone %s", moved, venue.ID, venue.Timezone)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		v.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Venue with ID %s updated successfully", venue.ID),
+		Service: "venues",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	v.Log.Infof("Sending log event: %+v", logEvent)
+	if err := v.LogsProducer.Send(logEvent); err != nil {
+		v.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToVenueResponse(venue), nil
+}
+
+func (v *venuesUseCaseImpl) SoftDelete(ctx context.Context, request *model.VenueRequestSoftDelete) (*model.VenueResponse, error) {
+	tx := v.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := common.ValidateStruct(request); err != nil {
+		v.Log.Warnf("Invalid request body : %+v", err)
+		return nil, err
+	}
+
+	venue, err := v.VenuesRepo.FindByID(tx, request.ID)
+	if err != nil {
+		v.Log.Errorf("Failed to find venue by ID %s: %v", request.ID, err)
+		tx.Rollback()
+		return nil, common.ErrNotFound("Venue not found").WithDetail("id", request.ID)
+	}
+
+	if err := v.VenuesRepo.SoftDelete(tx, venue.ID); err != nil {
+		tx.Rollback()
+		v.Log.Errorf("Failed to soft delete venue: %v", err)
+		return nil, common.ErrInternalServer("Failed to soft delete venue")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		v.Log.Errorf("Failed to commit transaction: %v", err)
+		return nil, common.ErrInternalServer("Failed to commit transaction")
+	}
+
+	logEvent := &model.LogEvent{
+		Level:   "info",
+		Message: fmt.Sprintf("Venue with ID %s soft deleted successfully", venue.ID),
+		Service: "venues",
+		Time:    time.Now().Format(time.RFC3339),
+	}
+	v.Log.Infof("Sending log event: %+v", logEvent)
+	if err := v.LogsProducer.Send(logEvent); err != nil {
+		v.Log.Errorf("Failed to send log event: %v", err)
+		return nil, common.ErrInternalServer("Failed to send log event")
+	}
+
+	return converter.ToVenueResponse(venue), nil
+}